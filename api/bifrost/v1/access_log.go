@@ -0,0 +1,30 @@
+package v1
+
+// LogFormat is a `log_format` directive of the http block, the strings of the format are joined.
+type LogFormat struct {
+	Name     string `json:"name"`
+	Escape   string `json:"escape,omitempty"`
+	Format   string `json:"format"`
+	Position string `json:"position,omitempty"`
+}
+
+// AccessLog is an `access_log` directive binding a log file to a log format. The server names are empty if the
+// directive is declared in the http block, which is inherited by the servers without their own ones, and the location
+// is the one declaring the directive in the server.
+type AccessLog struct {
+	Path        string   `json:"path"`
+	Format      string   `json:"format"`
+	ServerNames []string `json:"server-names,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Position    string   `json:"position,omitempty"`
+}
+
+// ErrorLog is an `error_log` directive writing to a log file, the server names and the location are the ones of
+// AccessLog.
+type ErrorLog struct {
+	Path        string   `json:"path"`
+	Level       string   `json:"level,omitempty"`
+	ServerNames []string `json:"server-names,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Position    string   `json:"position,omitempty"`
+}
@@ -0,0 +1,46 @@
+package v1
+
+import "time"
+
+const ( // CertificateWarningLevel
+	CertificateExpiring CertificateWarningLevel = "expiring"
+	CertificateExpired  CertificateWarningLevel = "expired"
+)
+
+type Certificate struct {
+	Path                 string    `json:"path"`
+	Position             string    `json:"position"`
+	ServerNames          []string  `json:"server-names"`
+	Subject              string    `json:"subject,omitempty"`
+	Issuer               string    `json:"issuer,omitempty"`
+	SANs                 []string  `json:"sans,omitempty"`
+	NotBefore            time.Time `json:"not-before"`
+	NotAfter             time.Time `json:"not-after"`
+	ChainLength          int       `json:"chain-length"`
+	CoversServerNames    bool      `json:"covers-server-names"`
+	UncoveredServerNames []string  `json:"uncovered-server-names,omitempty"`
+	Error                string    `json:"error,omitempty"`
+}
+
+type Certificates struct {
+	ServerName *ServerName    `json:"server-name"`
+	List       []*Certificate `json:"list"`
+}
+
+type CertificateWarning struct {
+	ServerName  string                  `json:"server-name"`
+	Level       CertificateWarningLevel `json:"level"`
+	Message     string                  `json:"message"`
+	Certificate *Certificate            `json:"certificate"`
+}
+
+type CertificateWarningLevel string
+
+type WebServerCertificateWarnings struct {
+	Warnings <-chan *CertificateWarning `json:"warnings"`
+}
+
+type WebServerCertificateWatchRequest struct {
+	ServerName    *ServerName   `json:"server-name"`
+	ExpiryWarning time.Duration `json:"expiry-warning"`
+}
@@ -0,0 +1,26 @@
+package v1
+
+import "time"
+
+type ConfigConflictPolicy string
+
+const ( // ConfigConflictPolicy
+	// ConfigConflictDiskWins keeps the changes of the config files, the changes of the api are discarded.
+	ConfigConflictDiskWins ConfigConflictPolicy = "disk-wins"
+	// ConfigConflictAPIWins keeps the changes of the api, the changes of the config files are overwritten.
+	ConfigConflictAPIWins ConfigConflictPolicy = "api-wins"
+	// ConfigConflictMerge merges the changes of both sides, and falls back to ConfigConflictDiskWins if they conflict.
+	ConfigConflictMerge ConfigConflictPolicy = "merge"
+)
+
+// ConfigConflict is the event of the config files and the in-memory configuration are both changed since they were
+// last synchronized. The discarded changes are saved to the snapshot file.
+type ConfigConflict struct {
+	ServerName   string               `json:"server-name"`
+	Time         time.Time            `json:"time"`
+	Policy       ConfigConflictPolicy `json:"policy"`
+	Resolution   ConfigConflictPolicy `json:"resolution"`
+	Code         int                  `json:"code"`
+	Message      string               `json:"message"`
+	SnapshotPath string               `json:"snapshot-path,omitempty"`
+}
@@ -0,0 +1,41 @@
+package v1
+
+import "time"
+
+// ConfigManagerState is the state of the config manager of a web server, which tells whether the configuration is
+// still synchronized with the config files.
+type ConfigManagerState struct {
+	ServerName     string                   `json:"server-name"`
+	Running        bool                     `json:"running"`
+	Loops          []*ConfigManagerLoop     `json:"loops"`
+	LastReloadTime time.Time                `json:"last-reload-time"`
+	LastSaveTime   time.Time                `json:"last-save-time"`
+	LastBackupTime time.Time                `json:"last-backup-time"`
+	LastError      string                   `json:"last-error,omitempty"`
+	LastErrorTime  time.Time                `json:"last-error-time"`
+	InSync         bool                     `json:"in-sync"`
+	Files          []*ConfigFileFingerprint `json:"files"`
+}
+
+// ConfigManagerLoop is the state of a regularly running loop of the config manager, e.g. backup, reload or save.
+type ConfigManagerLoop struct {
+	Name          string        `json:"name"`
+	Running       bool          `json:"running"`
+	Restarts      int           `json:"restarts"`
+	LastError     string        `json:"last-error,omitempty"`
+	LastErrorTime time.Time     `json:"last-error-time"`
+	Backoff       time.Duration `json:"backoff"`
+}
+
+// ConfigFileFingerprint is the sha256 fingerprints of a config file, in the configuration, in the config files when
+// last synchronized, and on the disk now. The fingerprint is empty if the file is absent.
+type ConfigFileFingerprint struct {
+	Path          string `json:"path"`
+	Configuration string `json:"configuration"`
+	Synchronized  string `json:"synchronized"`
+	Disk          string `json:"disk"`
+}
+
+type ConfigManagerStates struct {
+	States []*ConfigManagerState `json:"states"`
+}
@@ -0,0 +1,22 @@
+package v1
+
+// DirectiveValue is a directive value annotated with the node and the config file it came from.
+type DirectiveValue struct {
+	Value     string `json:"value"`
+	Node      string `json:"node"`
+	Position  string `json:"position"`
+	Condition string `json:"condition,omitempty"`
+}
+
+type EffectiveDirective struct {
+	Name   string            `json:"name"`
+	Values []*DirectiveValue `json:"values,omitempty"`
+	// Conditional values take effect instead of Values, only if the conditions of the `if` blocks are met.
+	Conditional []*DirectiveValue `json:"conditional,omitempty"`
+}
+
+type EffectiveConfig struct {
+	Node       string                `json:"node"`
+	Position   string                `json:"position"`
+	Directives []*EffectiveDirective `json:"directives"`
+}
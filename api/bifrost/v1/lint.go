@@ -0,0 +1,46 @@
+package v1
+
+const ( // LintSeverity
+	LintSeverityInfo    LintSeverity = "info"
+	LintSeverityWarning LintSeverity = "warning"
+	LintSeverityError   LintSeverity = "error"
+)
+
+type LintSeverity string
+
+// Rank returns the order of the severity, the more severe the higher. An unknown severity ranks 0.
+func (s LintSeverity) Rank() int {
+	switch s {
+	case LintSeverityInfo:
+		return 1
+	case LintSeverityWarning:
+		return 2
+	case LintSeverityError:
+		return 3
+	default:
+		return 0
+	}
+}
+
+type LintIssue struct {
+	Rule      string       `json:"rule"`
+	Severity  LintSeverity `json:"severity,omitempty"`
+	Message   string       `json:"message"`
+	Positions []string     `json:"positions"`
+}
+
+type LintReport struct {
+	ServerName *ServerName  `json:"server-name"`
+	Issues     []*LintIssue `json:"issues"`
+}
+
+// MaxSeverity returns the most severe severity of the report issues, or an empty severity if there is no issue.
+func (r *LintReport) MaxSeverity() LintSeverity {
+	var max LintSeverity
+	for _, issue := range r.Issues {
+		if issue.Severity.Rank() > max.Rank() {
+			max = issue.Severity
+		}
+	}
+	return max
+}
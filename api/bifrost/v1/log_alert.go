@@ -0,0 +1,27 @@
+package v1
+
+import "time"
+
+// LogAlert is fired by the log alert rule, when the lines of the log matched by the rule reach the threshold in the
+// window. The samples are the last lines matched.
+type LogAlert struct {
+	Rule          string    `json:"rule"`
+	ServerName    string    `json:"server-name"`
+	LogName       string    `json:"log-name"`
+	Time          time.Time `json:"time"`
+	WindowSeconds int64     `json:"window-seconds"`
+	Threshold     int       `json:"threshold"`
+	Count         int       `json:"count"`
+	Samples       []string  `json:"samples"`
+}
+
+// WebServerLogAlertsRequest watches the alerts fired by the log alert rules, the alerts of all the web servers or all
+// the rules are sent if the server name or the rule is empty.
+type WebServerLogAlertsRequest struct {
+	ServerName *ServerName `json:"server-name"`
+	Rule       string      `json:"rule"`
+}
+
+type WebServerLogAlerts struct {
+	Alerts <-chan *LogAlert `json:"alerts"`
+}
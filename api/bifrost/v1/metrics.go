@@ -16,6 +16,9 @@ type Metrics struct {
 	Disk           string           `json:"disk"`
 	StatusList     []*WebServerInfo `json:"status-list"`
 	BifrostVersion string           `json:"bifrost-version"`
+
+	CertificateWarnings []*CertificateWarning `json:"certificate-warnings,omitempty"`
+	ConfigConflicts     []*ConfigConflict     `json:"config-conflicts,omitempty"`
 }
 
 type WebServerInfo struct {
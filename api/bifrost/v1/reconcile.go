@@ -0,0 +1,44 @@
+package v1
+
+type ReconcileAction string
+
+const ( // ReconcileAction
+	ReconcileActionCreate ReconcileAction = "create"
+	ReconcileActionUpdate ReconcileAction = "update"
+	ReconcileActionDelete ReconcileAction = "delete"
+	// ReconcileActionConflict means a block not managed by the reconciler has the name of the desired one, which is
+	// left untouched until it is removed or marked as managed.
+	ReconcileActionConflict ReconcileAction = "conflict"
+)
+
+// ReconcileChange is a change of a server or an upstream block between the live configuration and the site
+// definitions. Drifted means the live block has been edited by hand since it was last applied.
+type ReconcileChange struct {
+	Action   ReconcileAction `json:"action"`
+	Kind     string          `json:"kind"`
+	Name     string          `json:"name"`
+	Drifted  bool            `json:"drifted"`
+	Position string          `json:"position,omitempty"`
+	Diff     string          `json:"diff"`
+}
+
+// ReconcilePlan is the changes to bring the configuration of the web server to the site definitions.
+type ReconcilePlan struct {
+	ServerName string             `json:"server-name"`
+	Changes    []*ReconcileChange `json:"changes"`
+	Applied    bool               `json:"applied"`
+}
+
+// Drifted returns whether any block of the plan has been edited by hand since it was last applied.
+func (p *ReconcilePlan) Drifted() bool {
+	for _, change := range p.Changes {
+		if change.Drifted {
+			return true
+		}
+	}
+	return false
+}
+
+type ReconcilePlans struct {
+	Plans []*ReconcilePlan `json:"plans"`
+}
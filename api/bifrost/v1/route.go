@@ -0,0 +1,21 @@
+package v1
+
+type RouteRequest struct {
+	ServerName *ServerName `json:"server-name"`
+	Host       string      `json:"host"`
+	Port       int         `json:"port,omitempty"`
+	Scheme     string      `json:"scheme,omitempty"`
+	URI        string      `json:"uri"`
+}
+
+// RouteResult is the server and location which handle the simulated request, with the decisions made to select them.
+type RouteResult struct {
+	Server           string          `json:"server,omitempty"`
+	ServerPosition   string          `json:"server-position,omitempty"`
+	Location         string          `json:"location,omitempty"`
+	LocationPosition string          `json:"location-position,omitempty"`
+	ProxyPass        *DirectiveValue `json:"proxy-pass,omitempty"`
+	Root             *DirectiveValue `json:"root,omitempty"`
+	Alias            *DirectiveValue `json:"alias,omitempty"`
+	Trace            []string        `json:"trace"`
+}
@@ -0,0 +1,46 @@
+package v1
+
+import "time"
+
+type TrafficStatsScope string
+
+const ( // TrafficStatsScope
+	TrafficStatsScopeServer     TrafficStatsScope = "server"
+	TrafficStatsScopeServerName TrafficStatsScope = "server-name"
+	TrafficStatsScopeUpstream   TrafficStatsScope = "upstream"
+)
+
+// LatencyPercentiles are the percentiles of the latencies in seconds.
+type LatencyPercentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// TrafficStats is the traffic of a web server, a server name or an upstream address of it, in the rolling window
+// before the time. The latencies are nil if there are no such fields in the access logs.
+type TrafficStats struct {
+	ServerName           string              `json:"server-name"`
+	Scope                TrafficStatsScope   `json:"scope"`
+	Name                 string              `json:"name,omitempty"`
+	Time                 time.Time           `json:"time"`
+	WindowSeconds        int64               `json:"window-seconds"`
+	Requests             int64               `json:"requests"`
+	RequestRate          float64             `json:"request-rate"`
+	StatusClasses        map[string]int64    `json:"status-classes"`
+	Bytes                int64               `json:"bytes"`
+	RequestTime          *LatencyPercentiles `json:"request-time,omitempty"`
+	UpstreamResponseTime *LatencyPercentiles `json:"upstream-response-time,omitempty"`
+}
+
+// WebServerTrafficStatsRequest requests the traffic stats of the web server every interval, the stats of all the web
+// servers are sent if the server name is empty.
+type WebServerTrafficStatsRequest struct {
+	ServerName *ServerName   `json:"server-name"`
+	Interval   time.Duration `json:"interval"`
+}
+
+type WebServerTrafficStats struct {
+	Stats <-chan *TrafficStats `json:"stats"`
+}
@@ -0,0 +1,43 @@
+package v1
+
+type UpstreamMemberState string
+
+const ( // UpstreamMemberState
+	UpstreamMemberUp   UpstreamMemberState = "up"
+	UpstreamMemberDown UpstreamMemberState = "down"
+	// UpstreamMemberDrain stops the new sessions to the member, the `drain` parameter is only supported by NGINX Plus.
+	UpstreamMemberDrain UpstreamMemberState = "drain"
+)
+
+// UpstreamMember is a `server` directive of an upstream block. The zero weight and the nil max fails mean the
+// parameters are not set, and the parameters not parsed are kept in Params.
+type UpstreamMember struct {
+	Address     string              `json:"address"`
+	Weight      int                 `json:"weight,omitempty"`
+	MaxFails    *int                `json:"max-fails,omitempty"`
+	FailTimeout string              `json:"fail-timeout,omitempty"`
+	Backup      bool                `json:"backup,omitempty"`
+	State       UpstreamMemberState `json:"state"`
+	Params      []string            `json:"params,omitempty"`
+	Position    string              `json:"position,omitempty"`
+}
+
+type Upstream struct {
+	Name     string            `json:"name"`
+	Position string            `json:"position"`
+	Members  []*UpstreamMember `json:"members"`
+}
+
+type Upstreams struct {
+	ServerName string      `json:"server-name"`
+	Upstreams  []*Upstream `json:"upstreams"`
+}
+
+// UpstreamMemberRequest changes a member of the upstream of the web server, the web server is reloaded after the
+// change is checked and saved, if Reload is set.
+type UpstreamMemberRequest struct {
+	ServerName string          `json:"server-name"`
+	Upstream   string          `json:"upstream"`
+	Member     *UpstreamMember `json:"member"`
+	Reload     bool            `json:"reload"`
+}
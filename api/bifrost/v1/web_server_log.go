@@ -1,11 +1,115 @@
 package v1
 
+import "time"
+
+// WebServerLog is the stream of the lines of a web server log. Dropped returns the number of the lines dropped since
+// the watching client fell behind, Cursor returns the cursor after the lines received, from which the watching can be
+// resumed, and Gap returns whether the lines after the resumed cursor are lost. They are nil if the lines are not
+// watched, e.g. the ones of the queried logs, and the cursor and the gap are nil if several logs are watched.
 type WebServerLog struct {
-	Lines <-chan []byte `json:"lines"`
+	Lines   <-chan []byte `json:"lines"`
+	Dropped func() uint64 `json:"-"`
+	Cursor  func() string `json:"-"`
+	Gap     func() bool   `json:"-"`
 }
 
+// WebServerLogWatchRequest watches a web server log. The lines are filtered by the regexp rule, and by the field rule
+// like `status>=500 && host=shop` with the fields parsed by the `log_format` of the access log. The lines are sent as
+// the json records of the fields if Structured is set.
+//
+// The error logs are parsed into the fields of the time, level, message and the context of the request, such as the
+// client, server, request and upstream. The lines of the error log below MinSeverity are skipped, the repeated
+// messages within GroupWindow are summarized into one line, and only the upstream failure events, e.g. the failed
+// connections and timeouts of the upstream members, are sent in json if UpstreamEvents is set.
+//
+// The watching is resumed after the Cursor returned by the previous one, the lines are replayed from the log, or its
+// rotated siblings, and at least once. If the lines after the cursor are lost, the log is read from the beginning.
+//
+// Several logs are watched in one stream, if LogNames are set or the log names are patterns like `*.access.log`. The
+// logs listed later and matched by the patterns are watched too, and each line is tagged by the name of its log, as the
+// `log` field of the json line, or the prefix like `[a.access.log] `. The options of the error logs are ignored by the
+// other logs, and the watching of several logs can not be resumed by the cursor.
 type WebServerLogWatchRequest struct {
+	ServerName          *ServerName   `json:"server-name"`
+	LogName             string        `json:"log-path"`
+	LogNames            []string      `json:"log-names"`
+	FilteringRegexpRule string        `json:"filtering-regexp-rule"`
+	FilteringFieldRule  string        `json:"filtering-field-rule"`
+	Structured          bool          `json:"structured"`
+	MinSeverity         string        `json:"min-severity"`
+	GroupWindow         time.Duration `json:"group-window"`
+	UpstreamEvents      bool          `json:"upstream-events"`
+	Cursor              string        `json:"cursor"`
+}
+
+// WebServerLogQueryRequest queries the lines of a web server log and its rotated siblings, such as `access.log.1` and
+// `access.log.2.gz`. The zero Since and Until mean the time range is not bounded, the zero Limit means all the matched
+// lines, and the positive Offset is a byte offset of the log itself, from which the lines are read forwards, or
+// backwards if Reverse is set. The lines are filtered and structured as the ones of WebServerLogWatchRequest.
+type WebServerLogQueryRequest struct {
 	ServerName          *ServerName `json:"server-name"`
 	LogName             string      `json:"log-path"`
+	Since               time.Time   `json:"since"`
+	Until               time.Time   `json:"until"`
+	Offset              int64       `json:"offset"`
+	Limit               int         `json:"limit"`
+	Reverse             bool        `json:"reverse"`
 	FilteringRegexpRule string      `json:"filtering-regexp-rule"`
+	FilteringFieldRule  string      `json:"filtering-field-rule"`
+	Structured          bool        `json:"structured"`
+}
+
+// LogWriter is an `access_log` or `error_log` directive writing to the log, the format is the one of the `access_log`
+// directive, and the level is the one of the `error_log` directive.
+type LogWriter struct {
+	Directive   string   `json:"directive"`
+	ServerNames []string `json:"server-names,omitempty"`
+	Location    string   `json:"location,omitempty"`
+	Format      string   `json:"format,omitempty"`
+	Level       string   `json:"level,omitempty"`
+	Position    string   `json:"position,omitempty"`
+}
+
+// LogFile is a log of the web server, which can be watched and queried by the name. The log in the logs directory is
+// named by the path relative to the directory, and the others are named by the absolute paths. The writers are empty
+// if the log is only found in the logs directory.
+type LogFile struct {
+	Name    string       `json:"name"`
+	Path    string       `json:"path"`
+	Exists  bool         `json:"exists"`
+	Size    int64        `json:"size"`
+	ModTime time.Time    `json:"mod-time"`
+	Writers []*LogWriter `json:"writers"`
+}
+
+type LogFiles struct {
+	ServerName *ServerName `json:"server-name"`
+	List       []*LogFile  `json:"list"`
+}
+
+// WebServerLogDownloadRequest downloads a log of the web server, or a tar.gz archive of the logs and the current config
+// of the web server if Archive is set or several logs are requested. The log names are the names listed by ListLogs,
+// or the names of their rotated siblings, such as `access.log.1` and `access.log.2.gz`.
+type WebServerLogDownloadRequest struct {
+	ServerName *ServerName `json:"server-name"`
+	LogNames   []string    `json:"log-names"`
+	Archive    bool        `json:"archive"`
+}
+
+// WebServerLogDownload is the stream of the chunks of the downloaded file. Checksum returns the checksum of the whole
+// file like `sha256:<hex>`, and Err returns the error stopping the download, they are only valid after the chunks are
+// closed.
+type WebServerLogDownload struct {
+	FileName string        `json:"file-name"`
+	Chunks   <-chan []byte `json:"-"`
+	Checksum func() string `json:"-"`
+	Err      func() error  `json:"-"`
+}
+
+// LogDownload is the file downloaded by the client, the path is the local path of the file saved.
+type LogDownload struct {
+	FileName string `json:"file-name"`
+	Path     string `json:"path,omitempty"`
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"`
 }
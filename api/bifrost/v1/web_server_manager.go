@@ -0,0 +1,27 @@
+package v1
+
+import "time"
+
+// ManagedWebServer is the options of a web server managed by bifrost.
+type ManagedWebServer struct {
+	ServerName     string            `json:"server-name"`
+	ServerType     string            `json:"server-type"`
+	ConfigPath     string            `json:"config-path"`
+	VerifyExecPath string            `json:"verify-exec-path"`
+	LogsDirPath    string            `json:"logs-dir-path"`
+	Prefix         string            `json:"prefix"`
+	ExtraArgs      []string          `json:"extra-args,omitempty"`
+	BackupDir      string            `json:"backup-dir"`
+	BackupCycle    int               `json:"backup-cycle"`
+	BackupSaveTime int               `json:"backup-save-time"`
+	ReloadInterval time.Duration     `json:"reload-interval"`
+	ReloadDebounce time.Duration     `json:"reload-debounce"`
+	SaveInterval   time.Duration     `json:"save-interval"`
+	ConflictPolicy string            `json:"conflict-policy"`
+	FileMode       string            `json:"file-mode"`
+	LintRules      map[string]string `json:"lint-rules,omitempty"`
+}
+
+type ManagedWebServers struct {
+	Servers []*ManagedWebServer `json:"servers"`
+}
@@ -0,0 +1,43 @@
+package v1
+
+// WebServerTemplate is a named config template, which is rendered with the typed parameters into a config snippet,
+// e.g. a server block of a reverse proxy.
+type WebServerTemplate struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Target      string                        `json:"target"`
+	Parameters  []*WebServerTemplateParameter `json:"parameters"`
+	Content     string                        `json:"content"`
+}
+
+// WebServerTemplateParameter is a parameter of the template, the type is one of `string`, `int`, `bool` and `list`.
+type WebServerTemplateParameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Default     string `json:"default,omitempty"`
+	Pattern     string `json:"pattern,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type WebServerTemplates struct {
+	Templates []*WebServerTemplate `json:"templates"`
+}
+
+// WebServerTemplateRequest renders the template with the parameters, and appends the snippet to the target context of
+// the web server configuration, which is queried by the keyword. The target of the template is used if it is empty.
+type WebServerTemplateRequest struct {
+	ServerName   string            `json:"server-name"`
+	TemplateName string            `json:"template-name"`
+	Params       map[string]string `json:"params"`
+	Target       string            `json:"target,omitempty"`
+	DryRun       bool              `json:"dry-run"`
+}
+
+type WebServerTemplateResult struct {
+	ServerName   string `json:"server-name"`
+	TemplateName string `json:"template-name"`
+	Target       string `json:"target"`
+	Snippet      string `json:"snippet"`
+	Applied      bool   `json:"applied"`
+}
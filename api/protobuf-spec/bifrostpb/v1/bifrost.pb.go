@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.13.0
 // source: api/protobuf-spec/bifrostpb/v1/bifrost.proto
 
@@ -8,7 +8,6 @@ package v1
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,10 +24,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type Null struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -221,7 +216,10 @@ type Response struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Msg []byte `protobuf:"bytes,1,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Msg     []byte `protobuf:"bytes,1,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Dropped uint64 `protobuf:"varint,2,opt,name=Dropped,proto3" json:"Dropped,omitempty"`
+	Cursor  string `protobuf:"bytes,3,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Gap     bool   `protobuf:"varint,4,opt,name=Gap,proto3" json:"Gap,omitempty"`
 }
 
 func (x *Response) Reset() {
@@ -263,6 +261,27 @@ func (x *Response) GetMsg() []byte {
 	return nil
 }
 
+func (x *Response) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *Response) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *Response) GetGap() bool {
+	if x != nil {
+		return x.Gap
+	}
+	return false
+}
+
 type Statistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -362,9 +381,16 @@ type LogWatchRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerName string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
-	LogName    string `protobuf:"bytes,2,opt,name=LogName,proto3" json:"LogName,omitempty"`
-	FilterRule string `protobuf:"bytes,3,opt,name=FilterRule,proto3" json:"FilterRule,omitempty"`
+	ServerName         string   `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	LogName            string   `protobuf:"bytes,2,opt,name=LogName,proto3" json:"LogName,omitempty"`
+	FilterRule         string   `protobuf:"bytes,3,opt,name=FilterRule,proto3" json:"FilterRule,omitempty"`
+	FieldFilterRule    string   `protobuf:"bytes,4,opt,name=FieldFilterRule,proto3" json:"FieldFilterRule,omitempty"`
+	Structured         bool     `protobuf:"varint,5,opt,name=Structured,proto3" json:"Structured,omitempty"`
+	MinSeverity        string   `protobuf:"bytes,6,opt,name=MinSeverity,proto3" json:"MinSeverity,omitempty"`
+	GroupWindowSeconds int64    `protobuf:"varint,7,opt,name=GroupWindowSeconds,proto3" json:"GroupWindowSeconds,omitempty"`
+	UpstreamEvents     bool     `protobuf:"varint,8,opt,name=UpstreamEvents,proto3" json:"UpstreamEvents,omitempty"`
+	Cursor             string   `protobuf:"bytes,9,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	LogNames           []string `protobuf:"bytes,10,rep,name=LogNames,proto3" json:"LogNames,omitempty"`
 }
 
 func (x *LogWatchRequest) Reset() {
@@ -420,744 +446,3952 @@ func (x *LogWatchRequest) GetFilterRule() string {
 	return ""
 }
 
-var File_api_protobuf_spec_bifrostpb_v1_bifrost_proto protoreflect.FileDescriptor
-
-var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc = []byte{
-	0x0a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x73,
-	0x70, 0x65, 0x63, 0x2f, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
-	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x22, 0x06, 0x0a, 0x04, 0x4e, 0x75, 0x6c,
-	0x6c, 0x22, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x12, 0x2b, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x20, 0x0a,
-	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x4a, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x1c, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x28, 0x0a, 0x0a, 0x53, 0x74, 0x61,
-	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x25, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x0f, 0x4c, 0x6f,
-	0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x4c, 0x6f, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4c, 0x6f, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x32, 0xc5, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x0f, 0x2e,
-	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a, 0x16,
-	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
-	0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x17, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e,
-	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x32,
-	0x4e, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x37, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e,
-	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x00, 0x30, 0x01, 0x32,
-	0x41, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x62, 0x69, 0x66, 0x72,
-	0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a, 0x12, 0x2e, 0x62, 0x69, 0x66,
-	0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x00,
-	0x30, 0x01, 0x32, 0x53, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x1a, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c,
-	0x6f, 0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x20, 0x5a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x62, 0x69, 0x66,
-	0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *LogWatchRequest) GetFieldFilterRule() string {
+	if x != nil {
+		return x.FieldFilterRule
+	}
+	return ""
 }
 
-var (
-	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescOnce sync.Once
-	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData = file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc
-)
-
-func file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP() []byte {
-	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescOnce.Do(func() {
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData)
-	})
-	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData
+func (x *LogWatchRequest) GetStructured() bool {
+	if x != nil {
+		return x.Structured
+	}
+	return false
 }
 
-var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_goTypes = []interface{}{
-	(*Null)(nil),            // 0: bifrostpb.Null
-	(*ServerNames)(nil),     // 1: bifrostpb.ServerNames
-	(*ServerName)(nil),      // 2: bifrostpb.ServerName
-	(*ServerConfig)(nil),    // 3: bifrostpb.ServerConfig
-	(*Response)(nil),        // 4: bifrostpb.Response
-	(*Statistics)(nil),      // 5: bifrostpb.Statistics
-	(*Metrics)(nil),         // 6: bifrostpb.Metrics
-	(*LogWatchRequest)(nil), // 7: bifrostpb.LogWatchRequest
+func (x *LogWatchRequest) GetMinSeverity() string {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return ""
 }
-var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_depIdxs = []int32{
-	2, // 0: bifrostpb.ServerNames.Names:type_name -> bifrostpb.ServerName
-	0, // 1: bifrostpb.WebServerConfig.GetServerNames:input_type -> bifrostpb.Null
-	2, // 2: bifrostpb.WebServerConfig.Get:input_type -> bifrostpb.ServerName
-	3, // 3: bifrostpb.WebServerConfig.Update:input_type -> bifrostpb.ServerConfig
-	2, // 4: bifrostpb.WebServerStatistics.Get:input_type -> bifrostpb.ServerName
-	0, // 5: bifrostpb.WebServerStatus.Get:input_type -> bifrostpb.Null
-	7, // 6: bifrostpb.WebServerLogWatcher.Watch:input_type -> bifrostpb.LogWatchRequest
-	1, // 7: bifrostpb.WebServerConfig.GetServerNames:output_type -> bifrostpb.ServerNames
-	3, // 8: bifrostpb.WebServerConfig.Get:output_type -> bifrostpb.ServerConfig
-	4, // 9: bifrostpb.WebServerConfig.Update:output_type -> bifrostpb.Response
-	5, // 10: bifrostpb.WebServerStatistics.Get:output_type -> bifrostpb.Statistics
-	6, // 11: bifrostpb.WebServerStatus.Get:output_type -> bifrostpb.Metrics
-	4, // 12: bifrostpb.WebServerLogWatcher.Watch:output_type -> bifrostpb.Response
-	7, // [7:13] is the sub-list for method output_type
-	1, // [1:7] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+func (x *LogWatchRequest) GetGroupWindowSeconds() int64 {
+	if x != nil {
+		return x.GroupWindowSeconds
+	}
+	return 0
 }
 
-func init() { file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_init() }
-func file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_init() {
-	if File_api_protobuf_spec_bifrostpb_v1_bifrost_proto != nil {
-		return
+func (x *LogWatchRequest) GetUpstreamEvents() bool {
+	if x != nil {
+		return x.UpstreamEvents
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Null); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerNames); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerName); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerConfig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Statistics); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metrics); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogWatchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	return false
+}
+
+func (x *LogWatchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
-			NumExtensions: 0,
-			NumServices:   4,
-		},
-		GoTypes:           file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_goTypes,
-		DependencyIndexes: file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_depIdxs,
-		MessageInfos:      file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes,
-	}.Build()
-	File_api_protobuf_spec_bifrostpb_v1_bifrost_proto = out.File
-	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc = nil
-	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_goTypes = nil
-	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_depIdxs = nil
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (x *LogWatchRequest) GetLogNames() []string {
+	if x != nil {
+		return x.LogNames
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+type LogQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// WebServerConfigClient is the client API for WebServerConfig service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WebServerConfigClient interface {
-	GetServerNames(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ServerNames, error)
-	Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerConfig_GetClient, error)
-	Update(ctx context.Context, opts ...grpc.CallOption) (WebServerConfig_UpdateClient, error)
+	ServerName      string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	LogName         string `protobuf:"bytes,2,opt,name=LogName,proto3" json:"LogName,omitempty"`
+	FilterRule      string `protobuf:"bytes,3,opt,name=FilterRule,proto3" json:"FilterRule,omitempty"`
+	Since           int64  `protobuf:"varint,4,opt,name=Since,proto3" json:"Since,omitempty"` // unix nanoseconds, 0 means unbounded
+	Until           int64  `protobuf:"varint,5,opt,name=Until,proto3" json:"Until,omitempty"` // unix nanoseconds, 0 means unbounded
+	Offset          int64  `protobuf:"varint,6,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Limit           int32  `protobuf:"varint,7,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Reverse         bool   `protobuf:"varint,8,opt,name=Reverse,proto3" json:"Reverse,omitempty"`
+	FieldFilterRule string `protobuf:"bytes,9,opt,name=FieldFilterRule,proto3" json:"FieldFilterRule,omitempty"`
+	Structured      bool   `protobuf:"varint,10,opt,name=Structured,proto3" json:"Structured,omitempty"`
 }
 
-type webServerConfigClient struct {
-	cc grpc.ClientConnInterface
+func (x *LogQueryRequest) Reset() {
+	*x = LogQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func NewWebServerConfigClient(cc grpc.ClientConnInterface) WebServerConfigClient {
-	return &webServerConfigClient{cc}
+func (x *LogQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (c *webServerConfigClient) GetServerNames(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ServerNames, error) {
-	out := new(ServerNames)
-	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerConfig/GetServerNames", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+func (*LogQueryRequest) ProtoMessage() {}
 
-func (c *webServerConfigClient) Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerConfig_GetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WebServerConfig_serviceDesc.Streams[0], "/bifrostpb.WebServerConfig/Get", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &webServerConfigGetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (x *LogQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return x, nil
+	return mi.MessageOf(x)
 }
 
-type WebServerConfig_GetClient interface {
-	Recv() (*ServerConfig, error)
-	grpc.ClientStream
+// Deprecated: Use LogQueryRequest.ProtoReflect.Descriptor instead.
+func (*LogQueryRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{8}
 }
 
-type webServerConfigGetClient struct {
-	grpc.ClientStream
+func (x *LogQueryRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
 }
 
-func (x *webServerConfigGetClient) Recv() (*ServerConfig, error) {
-	m := new(ServerConfig)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (x *LogQueryRequest) GetLogName() string {
+	if x != nil {
+		return x.LogName
 	}
-	return m, nil
+	return ""
 }
 
-func (c *webServerConfigClient) Update(ctx context.Context, opts ...grpc.CallOption) (WebServerConfig_UpdateClient, error) {
+func (x *LogQueryRequest) GetFilterRule() string {
+	if x != nil {
+		return x.FilterRule
+	}
+	return ""
+}
+
+func (x *LogQueryRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *LogQueryRequest) GetFieldFilterRule() string {
+	if x != nil {
+		return x.FieldFilterRule
+	}
+	return ""
+}
+
+func (x *LogQueryRequest) GetStructured() bool {
+	if x != nil {
+		return x.Structured
+	}
+	return false
+}
+
+type Certificates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *Certificates) Reset() {
+	*x = Certificates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Certificates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificates) ProtoMessage() {}
+
+func (x *Certificates) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificates.ProtoReflect.Descriptor instead.
+func (*Certificates) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Certificates) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type LogFiles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *LogFiles) Reset() {
+	*x = LogFiles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogFiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFiles) ProtoMessage() {}
+
+func (x *LogFiles) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogFiles.ProtoReflect.Descriptor instead.
+func (*LogFiles) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LogFiles) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type CertificateWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName           string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	ExpiryWarningSeconds int64  `protobuf:"varint,2,opt,name=ExpiryWarningSeconds,proto3" json:"ExpiryWarningSeconds,omitempty"`
+}
+
+func (x *CertificateWatchRequest) Reset() {
+	*x = CertificateWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateWatchRequest) ProtoMessage() {}
+
+func (x *CertificateWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateWatchRequest.ProtoReflect.Descriptor instead.
+func (*CertificateWatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CertificateWatchRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *CertificateWatchRequest) GetExpiryWarningSeconds() int64 {
+	if x != nil {
+		return x.ExpiryWarningSeconds
+	}
+	return 0
+}
+
+type LogDownloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string   `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	LogNames   []string `protobuf:"bytes,2,rep,name=LogNames,proto3" json:"LogNames,omitempty"`
+	Archive    bool     `protobuf:"varint,3,opt,name=Archive,proto3" json:"Archive,omitempty"` // a tar.gz archive of the logs and the config, even if only one log is requested
+}
+
+func (x *LogDownloadRequest) Reset() {
+	*x = LogDownloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogDownloadRequest) ProtoMessage() {}
+
+func (x *LogDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogDownloadRequest.ProtoReflect.Descriptor instead.
+func (*LogDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LogDownloadRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *LogDownloadRequest) GetLogNames() []string {
+	if x != nil {
+		return x.LogNames
+	}
+	return nil
+}
+
+func (x *LogDownloadRequest) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+type LogChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName string `protobuf:"bytes,1,opt,name=FileName,proto3" json:"FileName,omitempty"` // sent with the first chunk
+	Data     []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Checksum string `protobuf:"bytes,3,opt,name=Checksum,proto3" json:"Checksum,omitempty"` // sent with the last message, like `sha256:<hex>`
+}
+
+func (x *LogChunk) Reset() {
+	*x = LogChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogChunk) ProtoMessage() {}
+
+func (x *LogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogChunk.ProtoReflect.Descriptor instead.
+func (*LogChunk) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LogChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *LogChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LogChunk) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type LogAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"` // all the web servers if empty
+	Rule       string `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`             // all the rules if empty
+}
+
+func (x *LogAlertsRequest) Reset() {
+	*x = LogAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogAlertsRequest) ProtoMessage() {}
+
+func (x *LogAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogAlertsRequest.ProtoReflect.Descriptor instead.
+func (*LogAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LogAlertsRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *LogAlertsRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type TrafficStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName      string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`            // all the web servers if empty
+	IntervalSeconds int64  `protobuf:"varint,2,opt,name=IntervalSeconds,proto3" json:"IntervalSeconds,omitempty"` // the interval to send the stats, 0 means the default one
+}
+
+func (x *TrafficStatsRequest) Reset() {
+	*x = TrafficStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficStatsRequest) ProtoMessage() {}
+
+func (x *TrafficStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficStatsRequest.ProtoReflect.Descriptor instead.
+func (*TrafficStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrafficStatsRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *TrafficStatsRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type LintReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *LintReport) Reset() {
+	*x = LintReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintReport) ProtoMessage() {}
+
+func (x *LintReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintReport.ProtoReflect.Descriptor instead.
+func (*LintReport) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LintReport) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type RouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	Host       string `protobuf:"bytes,2,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port       int32  `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	Scheme     string `protobuf:"bytes,4,opt,name=Scheme,proto3" json:"Scheme,omitempty"`
+	URI        string `protobuf:"bytes,5,opt,name=URI,proto3" json:"URI,omitempty"`
+}
+
+func (x *RouteRequest) Reset() {
+	*x = RouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteRequest) ProtoMessage() {}
+
+func (x *RouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteRequest.ProtoReflect.Descriptor instead.
+func (*RouteRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RouteRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *RouteRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RouteRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *RouteRequest) GetURI() string {
+	if x != nil {
+		return x.URI
+	}
+	return ""
+}
+
+type RouteResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *RouteResult) Reset() {
+	*x = RouteResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteResult) ProtoMessage() {}
+
+func (x *RouteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteResult.ProtoReflect.Descriptor instead.
+func (*RouteResult) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RouteResult) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type ManagedWebServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName     string            `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	ServerType     string            `protobuf:"bytes,2,opt,name=ServerType,proto3" json:"ServerType,omitempty"`
+	ConfigPath     string            `protobuf:"bytes,3,opt,name=ConfigPath,proto3" json:"ConfigPath,omitempty"`
+	VerifyExecPath string            `protobuf:"bytes,4,opt,name=VerifyExecPath,proto3" json:"VerifyExecPath,omitempty"`
+	LogsDirPath    string            `protobuf:"bytes,5,opt,name=LogsDirPath,proto3" json:"LogsDirPath,omitempty"`
+	BackupDir      string            `protobuf:"bytes,6,opt,name=BackupDir,proto3" json:"BackupDir,omitempty"`
+	BackupCycle    int32             `protobuf:"varint,7,opt,name=BackupCycle,proto3" json:"BackupCycle,omitempty"`
+	BackupSaveTime int32             `protobuf:"varint,8,opt,name=BackupSaveTime,proto3" json:"BackupSaveTime,omitempty"`
+	LintRules      map[string]string `protobuf:"bytes,9,rep,name=LintRules,proto3" json:"LintRules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReloadInterval int64             `protobuf:"varint,10,opt,name=ReloadInterval,proto3" json:"ReloadInterval,omitempty"` // nanoseconds
+	ReloadDebounce int64             `protobuf:"varint,11,opt,name=ReloadDebounce,proto3" json:"ReloadDebounce,omitempty"` // nanoseconds
+	SaveInterval   int64             `protobuf:"varint,12,opt,name=SaveInterval,proto3" json:"SaveInterval,omitempty"`     // nanoseconds
+	ConflictPolicy string            `protobuf:"bytes,13,opt,name=ConflictPolicy,proto3" json:"ConflictPolicy,omitempty"`
+	FileMode       string            `protobuf:"bytes,14,opt,name=FileMode,proto3" json:"FileMode,omitempty"`
+	Prefix         string            `protobuf:"bytes,15,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	ExtraArgs      []string          `protobuf:"bytes,16,rep,name=ExtraArgs,proto3" json:"ExtraArgs,omitempty"`
+}
+
+func (x *ManagedWebServer) Reset() {
+	*x = ManagedWebServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManagedWebServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagedWebServer) ProtoMessage() {}
+
+func (x *ManagedWebServer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagedWebServer.ProtoReflect.Descriptor instead.
+func (*ManagedWebServer) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ManagedWebServer) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetServerType() string {
+	if x != nil {
+		return x.ServerType
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetVerifyExecPath() string {
+	if x != nil {
+		return x.VerifyExecPath
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetLogsDirPath() string {
+	if x != nil {
+		return x.LogsDirPath
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetBackupDir() string {
+	if x != nil {
+		return x.BackupDir
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetBackupCycle() int32 {
+	if x != nil {
+		return x.BackupCycle
+	}
+	return 0
+}
+
+func (x *ManagedWebServer) GetBackupSaveTime() int32 {
+	if x != nil {
+		return x.BackupSaveTime
+	}
+	return 0
+}
+
+func (x *ManagedWebServer) GetLintRules() map[string]string {
+	if x != nil {
+		return x.LintRules
+	}
+	return nil
+}
+
+func (x *ManagedWebServer) GetReloadInterval() int64 {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return 0
+}
+
+func (x *ManagedWebServer) GetReloadDebounce() int64 {
+	if x != nil {
+		return x.ReloadDebounce
+	}
+	return 0
+}
+
+func (x *ManagedWebServer) GetSaveInterval() int64 {
+	if x != nil {
+		return x.SaveInterval
+	}
+	return 0
+}
+
+func (x *ManagedWebServer) GetConflictPolicy() string {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetFileMode() string {
+	if x != nil {
+		return x.FileMode
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ManagedWebServer) GetExtraArgs() []string {
+	if x != nil {
+		return x.ExtraArgs
+	}
+	return nil
+}
+
+type ManagedWebServers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*ManagedWebServer `protobuf:"bytes,1,rep,name=Servers,proto3" json:"Servers,omitempty"`
+}
+
+func (x *ManagedWebServers) Reset() {
+	*x = ManagedWebServers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManagedWebServers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagedWebServers) ProtoMessage() {}
+
+func (x *ManagedWebServers) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagedWebServers.ProtoReflect.Descriptor instead.
+func (*ManagedWebServers) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ManagedWebServers) GetServers() []*ManagedWebServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type ConfigManagerStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *ConfigManagerStates) Reset() {
+	*x = ConfigManagerStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigManagerStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigManagerStates) ProtoMessage() {}
+
+func (x *ConfigManagerStates) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigManagerStates.ProtoReflect.Descriptor instead.
+func (*ConfigManagerStates) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConfigManagerStates) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type Templates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *Templates) Reset() {
+	*x = Templates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Templates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Templates) ProtoMessage() {}
+
+func (x *Templates) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Templates.ProtoReflect.Descriptor instead.
+func (*Templates) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Templates) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type TemplateApplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName   string            `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	TemplateName string            `protobuf:"bytes,2,opt,name=TemplateName,proto3" json:"TemplateName,omitempty"`
+	Params       map[string]string `protobuf:"bytes,3,rep,name=Params,proto3" json:"Params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Target       string            `protobuf:"bytes,4,opt,name=Target,proto3" json:"Target,omitempty"`
+	DryRun       bool              `protobuf:"varint,5,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+}
+
+func (x *TemplateApplyRequest) Reset() {
+	*x = TemplateApplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateApplyRequest) ProtoMessage() {}
+
+func (x *TemplateApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateApplyRequest.ProtoReflect.Descriptor instead.
+func (*TemplateApplyRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TemplateApplyRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *TemplateApplyRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *TemplateApplyRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *TemplateApplyRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TemplateApplyRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type TemplateApplyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *TemplateApplyResult) Reset() {
+	*x = TemplateApplyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateApplyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateApplyResult) ProtoMessage() {}
+
+func (x *TemplateApplyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateApplyResult.ProtoReflect.Descriptor instead.
+func (*TemplateApplyResult) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TemplateApplyResult) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type ReconcilePlans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *ReconcilePlans) Reset() {
+	*x = ReconcilePlans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePlans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePlans) ProtoMessage() {}
+
+func (x *ReconcilePlans) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePlans.ProtoReflect.Descriptor instead.
+func (*ReconcilePlans) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReconcilePlans) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type Upstreams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonData []byte `protobuf:"bytes,1,opt,name=JsonData,proto3" json:"JsonData,omitempty"`
+}
+
+func (x *Upstreams) Reset() {
+	*x = Upstreams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Upstreams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Upstreams) ProtoMessage() {}
+
+func (x *Upstreams) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Upstreams.ProtoReflect.Descriptor instead.
+func (*Upstreams) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Upstreams) GetJsonData() []byte {
+	if x != nil {
+		return x.JsonData
+	}
+	return nil
+}
+
+type UpstreamMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string `protobuf:"bytes,1,opt,name=ServerName,proto3" json:"ServerName,omitempty"`
+	Upstream   string `protobuf:"bytes,2,opt,name=Upstream,proto3" json:"Upstream,omitempty"`
+	Member     []byte `protobuf:"bytes,3,opt,name=Member,proto3" json:"Member,omitempty"` // json of the member
+	Reload     bool   `protobuf:"varint,4,opt,name=Reload,proto3" json:"Reload,omitempty"`
+}
+
+func (x *UpstreamMemberRequest) Reset() {
+	*x = UpstreamMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamMemberRequest) ProtoMessage() {}
+
+func (x *UpstreamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpstreamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpstreamMemberRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *UpstreamMemberRequest) GetUpstream() string {
+	if x != nil {
+		return x.Upstream
+	}
+	return ""
+}
+
+func (x *UpstreamMemberRequest) GetMember() []byte {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *UpstreamMemberRequest) GetReload() bool {
+	if x != nil {
+		return x.Reload
+	}
+	return false
+}
+
+var File_api_protobuf_spec_bifrostpb_v1_bifrost_proto protoreflect.FileDescriptor
+
+var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x73,
+	0x70, 0x65, 0x63, 0x2f, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x22, 0x06, 0x0a, 0x04, 0x4e, 0x75, 0x6c,
+	0x6c, 0x22, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x20, 0x0a,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x4a, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x44, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x47,
+	0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x47, 0x61, 0x70, 0x22, 0x28, 0x0a,
+	0x0a, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a,
+	0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a,
+	0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x25, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe3,
+	0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4c, 0x6f, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x69, 0x6e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4c, 0x6f, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x22, 0x2a, 0x0a, 0x0c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x08,
+	0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x17, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x32, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x6a, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22,
+	0x56, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x46, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x22,
+	0x5f, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x28, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55,
+	0x52, 0x49, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x49, 0x22, 0x29, 0x0a,
+	0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x05, 0x0a, 0x10, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a,
+	0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x78, 0x65, 0x63, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x78, 0x65,
+	0x63, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x44, 0x69, 0x72,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x6f, 0x67, 0x73,
+	0x44, 0x69, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x44, 0x69, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x44, 0x69, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43,
+	0x79, 0x63, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x53, 0x61, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x61, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x48, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x4c, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x62, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x61, 0x76,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x53, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x4c, 0x69, 0x6e, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x57,
+	0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x22, 0x31, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8a, 0x02,
+	0x0a, 0x14, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x69, 0x66,
+	0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a,
+	0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x13, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a,
+	0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x27, 0x0a, 0x09, 0x55,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x73, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4a, 0x73, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x32, 0xc5, 0x01, 0x0a, 0x0f, 0x57,
+	0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x0f, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c,
+	0x6c, 0x1a, 0x16, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x03, 0x47,
+	0x65, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x17, 0x2e, 0x62, 0x69, 0x66, 0x72,
+	0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x17, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72,
+	0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x28, 0x01, 0x32, 0x4e, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x37, 0x0a, 0x03, 0x47, 0x65, 0x74,
+	0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73,
+	0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x00,
+	0x30, 0x01, 0x32, 0x8a, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e,
+	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a, 0x12,
+	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32,
+	0xd6, 0x02, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1a, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62,
+	0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66,
+	0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x06, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x45, 0x0a, 0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67,
+	0x12, 0x1d, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x32, 0x9d, 0x01, 0x0a, 0x14, 0x57, 0x65, 0x62,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x39, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
+	0x17, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0b,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0x4b, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x04, 0x4c,
+	0x69, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x15, 0x2e, 0x62, 0x69, 0x66,
+	0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x32, 0x5a, 0x0a, 0x17, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x3f, 0x0a, 0x08, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x62,
+	0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x32, 0xca, 0x02, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f,
+	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a,
+	0x1c, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x57,
+	0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x1b,
+	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73,
+	0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a, 0x1e, 0x2e, 0x62,
+	0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x00, 0x32, 0x90,
+	0x01, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x62,
+	0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a, 0x14, 0x2e,
+	0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1f,
+	0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x00, 0x32, 0x82, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x0f, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75,
+	0x6c, 0x6c, 0x1a, 0x19, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0x00, 0x12,
+	0x35, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x0f, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x1a, 0x19, 0x2e, 0x62, 0x69, 0x66, 0x72,
+	0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x22, 0x00, 0x32, 0xe4, 0x02, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x35, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x20, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x66, 0x72,
+	0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x69,
+	0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x20, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x20, 0x5a,
+	0x1e, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2d, 0x73, 0x70,
+	0x65, 0x63, 0x2f, 0x62, 0x69, 0x66, 0x72, 0x6f, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescOnce sync.Once
+	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData = file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc
+)
+
+func file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescGZIP() []byte {
+	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescOnce.Do(func() {
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData)
+	})
+	return file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDescData
+}
+
+var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_goTypes = []interface{}{
+	(*Null)(nil),                    // 0: bifrostpb.Null
+	(*ServerNames)(nil),             // 1: bifrostpb.ServerNames
+	(*ServerName)(nil),              // 2: bifrostpb.ServerName
+	(*ServerConfig)(nil),            // 3: bifrostpb.ServerConfig
+	(*Response)(nil),                // 4: bifrostpb.Response
+	(*Statistics)(nil),              // 5: bifrostpb.Statistics
+	(*Metrics)(nil),                 // 6: bifrostpb.Metrics
+	(*LogWatchRequest)(nil),         // 7: bifrostpb.LogWatchRequest
+	(*LogQueryRequest)(nil),         // 8: bifrostpb.LogQueryRequest
+	(*Certificates)(nil),            // 9: bifrostpb.Certificates
+	(*LogFiles)(nil),                // 10: bifrostpb.LogFiles
+	(*CertificateWatchRequest)(nil), // 11: bifrostpb.CertificateWatchRequest
+	(*LogDownloadRequest)(nil),      // 12: bifrostpb.LogDownloadRequest
+	(*LogChunk)(nil),                // 13: bifrostpb.LogChunk
+	(*LogAlertsRequest)(nil),        // 14: bifrostpb.LogAlertsRequest
+	(*TrafficStatsRequest)(nil),     // 15: bifrostpb.TrafficStatsRequest
+	(*LintReport)(nil),              // 16: bifrostpb.LintReport
+	(*RouteRequest)(nil),            // 17: bifrostpb.RouteRequest
+	(*RouteResult)(nil),             // 18: bifrostpb.RouteResult
+	(*ManagedWebServer)(nil),        // 19: bifrostpb.ManagedWebServer
+	(*ManagedWebServers)(nil),       // 20: bifrostpb.ManagedWebServers
+	(*ConfigManagerStates)(nil),     // 21: bifrostpb.ConfigManagerStates
+	(*Templates)(nil),               // 22: bifrostpb.Templates
+	(*TemplateApplyRequest)(nil),    // 23: bifrostpb.TemplateApplyRequest
+	(*TemplateApplyResult)(nil),     // 24: bifrostpb.TemplateApplyResult
+	(*ReconcilePlans)(nil),          // 25: bifrostpb.ReconcilePlans
+	(*Upstreams)(nil),               // 26: bifrostpb.Upstreams
+	(*UpstreamMemberRequest)(nil),   // 27: bifrostpb.UpstreamMemberRequest
+	nil,                             // 28: bifrostpb.ManagedWebServer.LintRulesEntry
+	nil,                             // 29: bifrostpb.TemplateApplyRequest.ParamsEntry
+}
+var file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_depIdxs = []int32{
+	2,  // 0: bifrostpb.ServerNames.Names:type_name -> bifrostpb.ServerName
+	28, // 1: bifrostpb.ManagedWebServer.LintRules:type_name -> bifrostpb.ManagedWebServer.LintRulesEntry
+	19, // 2: bifrostpb.ManagedWebServers.Servers:type_name -> bifrostpb.ManagedWebServer
+	29, // 3: bifrostpb.TemplateApplyRequest.Params:type_name -> bifrostpb.TemplateApplyRequest.ParamsEntry
+	0,  // 4: bifrostpb.WebServerConfig.GetServerNames:input_type -> bifrostpb.Null
+	2,  // 5: bifrostpb.WebServerConfig.Get:input_type -> bifrostpb.ServerName
+	3,  // 6: bifrostpb.WebServerConfig.Update:input_type -> bifrostpb.ServerConfig
+	2,  // 7: bifrostpb.WebServerStatistics.Get:input_type -> bifrostpb.ServerName
+	0,  // 8: bifrostpb.WebServerStatus.Get:input_type -> bifrostpb.Null
+	15, // 9: bifrostpb.WebServerStatus.TrafficStats:input_type -> bifrostpb.TrafficStatsRequest
+	7,  // 10: bifrostpb.WebServerLogWatcher.Watch:input_type -> bifrostpb.LogWatchRequest
+	8,  // 11: bifrostpb.WebServerLogWatcher.QueryLogs:input_type -> bifrostpb.LogQueryRequest
+	2,  // 12: bifrostpb.WebServerLogWatcher.ListLogs:input_type -> bifrostpb.ServerName
+	14, // 13: bifrostpb.WebServerLogWatcher.Alerts:input_type -> bifrostpb.LogAlertsRequest
+	12, // 14: bifrostpb.WebServerLogWatcher.DownloadLog:input_type -> bifrostpb.LogDownloadRequest
+	2,  // 15: bifrostpb.WebServerCertificate.Get:input_type -> bifrostpb.ServerName
+	11, // 16: bifrostpb.WebServerCertificate.WatchExpiry:input_type -> bifrostpb.CertificateWatchRequest
+	2,  // 17: bifrostpb.WebServerLinter.Lint:input_type -> bifrostpb.ServerName
+	17, // 18: bifrostpb.WebServerRouteSimulator.Simulate:input_type -> bifrostpb.RouteRequest
+	0,  // 19: bifrostpb.WebServerManager.List:input_type -> bifrostpb.Null
+	19, // 20: bifrostpb.WebServerManager.Register:input_type -> bifrostpb.ManagedWebServer
+	19, // 21: bifrostpb.WebServerManager.Reconfigure:input_type -> bifrostpb.ManagedWebServer
+	2,  // 22: bifrostpb.WebServerManager.Unregister:input_type -> bifrostpb.ServerName
+	0,  // 23: bifrostpb.WebServerManager.GetStates:input_type -> bifrostpb.Null
+	0,  // 24: bifrostpb.WebServerTemplate.List:input_type -> bifrostpb.Null
+	23, // 25: bifrostpb.WebServerTemplate.Apply:input_type -> bifrostpb.TemplateApplyRequest
+	0,  // 26: bifrostpb.WebServerReconciler.Plan:input_type -> bifrostpb.Null
+	0,  // 27: bifrostpb.WebServerReconciler.Apply:input_type -> bifrostpb.Null
+	2,  // 28: bifrostpb.WebServerUpstream.List:input_type -> bifrostpb.ServerName
+	27, // 29: bifrostpb.WebServerUpstream.AddMember:input_type -> bifrostpb.UpstreamMemberRequest
+	27, // 30: bifrostpb.WebServerUpstream.RemoveMember:input_type -> bifrostpb.UpstreamMemberRequest
+	27, // 31: bifrostpb.WebServerUpstream.SetWeight:input_type -> bifrostpb.UpstreamMemberRequest
+	27, // 32: bifrostpb.WebServerUpstream.SetState:input_type -> bifrostpb.UpstreamMemberRequest
+	1,  // 33: bifrostpb.WebServerConfig.GetServerNames:output_type -> bifrostpb.ServerNames
+	3,  // 34: bifrostpb.WebServerConfig.Get:output_type -> bifrostpb.ServerConfig
+	4,  // 35: bifrostpb.WebServerConfig.Update:output_type -> bifrostpb.Response
+	5,  // 36: bifrostpb.WebServerStatistics.Get:output_type -> bifrostpb.Statistics
+	6,  // 37: bifrostpb.WebServerStatus.Get:output_type -> bifrostpb.Metrics
+	4,  // 38: bifrostpb.WebServerStatus.TrafficStats:output_type -> bifrostpb.Response
+	4,  // 39: bifrostpb.WebServerLogWatcher.Watch:output_type -> bifrostpb.Response
+	4,  // 40: bifrostpb.WebServerLogWatcher.QueryLogs:output_type -> bifrostpb.Response
+	10, // 41: bifrostpb.WebServerLogWatcher.ListLogs:output_type -> bifrostpb.LogFiles
+	4,  // 42: bifrostpb.WebServerLogWatcher.Alerts:output_type -> bifrostpb.Response
+	13, // 43: bifrostpb.WebServerLogWatcher.DownloadLog:output_type -> bifrostpb.LogChunk
+	9,  // 44: bifrostpb.WebServerCertificate.Get:output_type -> bifrostpb.Certificates
+	4,  // 45: bifrostpb.WebServerCertificate.WatchExpiry:output_type -> bifrostpb.Response
+	16, // 46: bifrostpb.WebServerLinter.Lint:output_type -> bifrostpb.LintReport
+	18, // 47: bifrostpb.WebServerRouteSimulator.Simulate:output_type -> bifrostpb.RouteResult
+	20, // 48: bifrostpb.WebServerManager.List:output_type -> bifrostpb.ManagedWebServers
+	4,  // 49: bifrostpb.WebServerManager.Register:output_type -> bifrostpb.Response
+	4,  // 50: bifrostpb.WebServerManager.Reconfigure:output_type -> bifrostpb.Response
+	4,  // 51: bifrostpb.WebServerManager.Unregister:output_type -> bifrostpb.Response
+	21, // 52: bifrostpb.WebServerManager.GetStates:output_type -> bifrostpb.ConfigManagerStates
+	22, // 53: bifrostpb.WebServerTemplate.List:output_type -> bifrostpb.Templates
+	24, // 54: bifrostpb.WebServerTemplate.Apply:output_type -> bifrostpb.TemplateApplyResult
+	25, // 55: bifrostpb.WebServerReconciler.Plan:output_type -> bifrostpb.ReconcilePlans
+	25, // 56: bifrostpb.WebServerReconciler.Apply:output_type -> bifrostpb.ReconcilePlans
+	26, // 57: bifrostpb.WebServerUpstream.List:output_type -> bifrostpb.Upstreams
+	4,  // 58: bifrostpb.WebServerUpstream.AddMember:output_type -> bifrostpb.Response
+	4,  // 59: bifrostpb.WebServerUpstream.RemoveMember:output_type -> bifrostpb.Response
+	4,  // 60: bifrostpb.WebServerUpstream.SetWeight:output_type -> bifrostpb.Response
+	4,  // 61: bifrostpb.WebServerUpstream.SetState:output_type -> bifrostpb.Response
+	33, // [33:62] is the sub-list for method output_type
+	4,  // [4:33] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_init() }
+func file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_init() {
+	if File_api_protobuf_spec_bifrostpb_v1_bifrost_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Null); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNames); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Certificates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogFiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogDownloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManagedWebServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManagedWebServers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigManagerStates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Templates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateApplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateApplyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcilePlans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Upstreams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   11,
+		},
+		GoTypes:           file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_goTypes,
+		DependencyIndexes: file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_depIdxs,
+		MessageInfos:      file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_msgTypes,
+	}.Build()
+	File_api_protobuf_spec_bifrostpb_v1_bifrost_proto = out.File
+	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_rawDesc = nil
+	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_goTypes = nil
+	file_api_protobuf_spec_bifrostpb_v1_bifrost_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// WebServerConfigClient is the client API for WebServerConfig service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerConfigClient interface {
+	GetServerNames(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ServerNames, error)
+	Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerConfig_GetClient, error)
+	Update(ctx context.Context, opts ...grpc.CallOption) (WebServerConfig_UpdateClient, error)
+}
+
+type webServerConfigClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerConfigClient(cc grpc.ClientConnInterface) WebServerConfigClient {
+	return &webServerConfigClient{cc}
+}
+
+func (c *webServerConfigClient) GetServerNames(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ServerNames, error) {
+	out := new(ServerNames)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerConfig/GetServerNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServerConfigClient) Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerConfig_GetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerConfig_serviceDesc.Streams[0], "/bifrostpb.WebServerConfig/Get", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerConfigGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerConfig_GetClient interface {
+	Recv() (*ServerConfig, error)
+	grpc.ClientStream
+}
+
+type webServerConfigGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerConfigGetClient) Recv() (*ServerConfig, error) {
+	m := new(ServerConfig)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webServerConfigClient) Update(ctx context.Context, opts ...grpc.CallOption) (WebServerConfig_UpdateClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_WebServerConfig_serviceDesc.Streams[1], "/bifrostpb.WebServerConfig/Update", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &webServerConfigUpdateClient{stream}
+	x := &webServerConfigUpdateClient{stream}
+	return x, nil
+}
+
+type WebServerConfig_UpdateClient interface {
+	Send(*ServerConfig) error
+	CloseAndRecv() (*Response, error)
+	grpc.ClientStream
+}
+
+type webServerConfigUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerConfigUpdateClient) Send(m *ServerConfig) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *webServerConfigUpdateClient) CloseAndRecv() (*Response, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebServerConfigServer is the server API for WebServerConfig service.
+type WebServerConfigServer interface {
+	GetServerNames(context.Context, *Null) (*ServerNames, error)
+	Get(*ServerName, WebServerConfig_GetServer) error
+	Update(WebServerConfig_UpdateServer) error
+}
+
+// UnimplementedWebServerConfigServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerConfigServer struct {
+}
+
+func (*UnimplementedWebServerConfigServer) GetServerNames(context.Context, *Null) (*ServerNames, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerNames not implemented")
+}
+func (*UnimplementedWebServerConfigServer) Get(*ServerName, WebServerConfig_GetServer) error {
+	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedWebServerConfigServer) Update(WebServerConfig_UpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+
+func RegisterWebServerConfigServer(s *grpc.Server, srv WebServerConfigServer) {
+	s.RegisterService(&_WebServerConfig_serviceDesc, srv)
+}
+
+func _WebServerConfig_GetServerNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerConfigServer).GetServerNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerConfig/GetServerNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerConfigServer).GetServerNames(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebServerConfig_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerConfigServer).Get(m, &webServerConfigGetServer{stream})
+}
+
+type WebServerConfig_GetServer interface {
+	Send(*ServerConfig) error
+	grpc.ServerStream
+}
+
+type webServerConfigGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerConfigGetServer) Send(m *ServerConfig) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebServerConfig_Update_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WebServerConfigServer).Update(&webServerConfigUpdateServer{stream})
+}
+
+type WebServerConfig_UpdateServer interface {
+	SendAndClose(*Response) error
+	Recv() (*ServerConfig, error)
+	grpc.ServerStream
+}
+
+type webServerConfigUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerConfigUpdateServer) SendAndClose(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *webServerConfigUpdateServer) Recv() (*ServerConfig, error) {
+	m := new(ServerConfig)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _WebServerConfig_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerConfig",
+	HandlerType: (*WebServerConfigServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServerNames",
+			Handler:    _WebServerConfig_GetServerNames_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Get",
+			Handler:       _WebServerConfig_Get_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Update",
+			Handler:       _WebServerConfig_Update_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerStatisticsClient is the client API for WebServerStatistics service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerStatisticsClient interface {
+	Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerStatistics_GetClient, error)
+}
+
+type webServerStatisticsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerStatisticsClient(cc grpc.ClientConnInterface) WebServerStatisticsClient {
+	return &webServerStatisticsClient{cc}
+}
+
+func (c *webServerStatisticsClient) Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerStatistics_GetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerStatistics_serviceDesc.Streams[0], "/bifrostpb.WebServerStatistics/Get", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerStatisticsGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerStatistics_GetClient interface {
+	Recv() (*Statistics, error)
+	grpc.ClientStream
+}
+
+type webServerStatisticsGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerStatisticsGetClient) Recv() (*Statistics, error) {
+	m := new(Statistics)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebServerStatisticsServer is the server API for WebServerStatistics service.
+type WebServerStatisticsServer interface {
+	Get(*ServerName, WebServerStatistics_GetServer) error
+}
+
+// UnimplementedWebServerStatisticsServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerStatisticsServer struct {
+}
+
+func (*UnimplementedWebServerStatisticsServer) Get(*ServerName, WebServerStatistics_GetServer) error {
+	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+
+func RegisterWebServerStatisticsServer(s *grpc.Server, srv WebServerStatisticsServer) {
+	s.RegisterService(&_WebServerStatistics_serviceDesc, srv)
+}
+
+func _WebServerStatistics_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerStatisticsServer).Get(m, &webServerStatisticsGetServer{stream})
+}
+
+type WebServerStatistics_GetServer interface {
+	Send(*Statistics) error
+	grpc.ServerStream
+}
+
+type webServerStatisticsGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerStatisticsGetServer) Send(m *Statistics) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WebServerStatistics_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerStatistics",
+	HandlerType: (*WebServerStatisticsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Get",
+			Handler:       _WebServerStatistics_Get_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerStatusClient is the client API for WebServerStatus service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerStatusClient interface {
+	Get(ctx context.Context, in *Null, opts ...grpc.CallOption) (WebServerStatus_GetClient, error)
+	TrafficStats(ctx context.Context, in *TrafficStatsRequest, opts ...grpc.CallOption) (WebServerStatus_TrafficStatsClient, error)
+}
+
+type webServerStatusClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerStatusClient(cc grpc.ClientConnInterface) WebServerStatusClient {
+	return &webServerStatusClient{cc}
+}
+
+func (c *webServerStatusClient) Get(ctx context.Context, in *Null, opts ...grpc.CallOption) (WebServerStatus_GetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerStatus_serviceDesc.Streams[0], "/bifrostpb.WebServerStatus/Get", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerStatusGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerStatus_GetClient interface {
+	Recv() (*Metrics, error)
+	grpc.ClientStream
+}
+
+type webServerStatusGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerStatusGetClient) Recv() (*Metrics, error) {
+	m := new(Metrics)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webServerStatusClient) TrafficStats(ctx context.Context, in *TrafficStatsRequest, opts ...grpc.CallOption) (WebServerStatus_TrafficStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerStatus_serviceDesc.Streams[1], "/bifrostpb.WebServerStatus/TrafficStats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerStatusTrafficStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerStatus_TrafficStatsClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type webServerStatusTrafficStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerStatusTrafficStatsClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebServerStatusServer is the server API for WebServerStatus service.
+type WebServerStatusServer interface {
+	Get(*Null, WebServerStatus_GetServer) error
+	TrafficStats(*TrafficStatsRequest, WebServerStatus_TrafficStatsServer) error
+}
+
+// UnimplementedWebServerStatusServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerStatusServer struct {
+}
+
+func (*UnimplementedWebServerStatusServer) Get(*Null, WebServerStatus_GetServer) error {
+	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedWebServerStatusServer) TrafficStats(*TrafficStatsRequest, WebServerStatus_TrafficStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TrafficStats not implemented")
+}
+
+func RegisterWebServerStatusServer(s *grpc.Server, srv WebServerStatusServer) {
+	s.RegisterService(&_WebServerStatus_serviceDesc, srv)
+}
+
+func _WebServerStatus_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Null)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerStatusServer).Get(m, &webServerStatusGetServer{stream})
+}
+
+type WebServerStatus_GetServer interface {
+	Send(*Metrics) error
+	grpc.ServerStream
+}
+
+type webServerStatusGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerStatusGetServer) Send(m *Metrics) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebServerStatus_TrafficStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrafficStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerStatusServer).TrafficStats(m, &webServerStatusTrafficStatsServer{stream})
+}
+
+type WebServerStatus_TrafficStatsServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type webServerStatusTrafficStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerStatusTrafficStatsServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WebServerStatus_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerStatus",
+	HandlerType: (*WebServerStatusServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Get",
+			Handler:       _WebServerStatus_Get_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TrafficStats",
+			Handler:       _WebServerStatus_TrafficStats_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerLogWatcherClient is the client API for WebServerLogWatcher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerLogWatcherClient interface {
+	Watch(ctx context.Context, in *LogWatchRequest, opts ...grpc.CallOption) (WebServerLogWatcher_WatchClient, error)
+	QueryLogs(ctx context.Context, in *LogQueryRequest, opts ...grpc.CallOption) (WebServerLogWatcher_QueryLogsClient, error)
+	ListLogs(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (*LogFiles, error)
+	Alerts(ctx context.Context, in *LogAlertsRequest, opts ...grpc.CallOption) (WebServerLogWatcher_AlertsClient, error)
+	DownloadLog(ctx context.Context, in *LogDownloadRequest, opts ...grpc.CallOption) (WebServerLogWatcher_DownloadLogClient, error)
+}
+
+type webServerLogWatcherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerLogWatcherClient(cc grpc.ClientConnInterface) WebServerLogWatcherClient {
+	return &webServerLogWatcherClient{cc}
+}
+
+func (c *webServerLogWatcherClient) Watch(ctx context.Context, in *LogWatchRequest, opts ...grpc.CallOption) (WebServerLogWatcher_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerLogWatcher_serviceDesc.Streams[0], "/bifrostpb.WebServerLogWatcher/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerLogWatcherWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerLogWatcher_WatchClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type webServerLogWatcherWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerLogWatcherWatchClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webServerLogWatcherClient) QueryLogs(ctx context.Context, in *LogQueryRequest, opts ...grpc.CallOption) (WebServerLogWatcher_QueryLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerLogWatcher_serviceDesc.Streams[1], "/bifrostpb.WebServerLogWatcher/QueryLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerLogWatcherQueryLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerLogWatcher_QueryLogsClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type webServerLogWatcherQueryLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerLogWatcherQueryLogsClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webServerLogWatcherClient) ListLogs(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (*LogFiles, error) {
+	out := new(LogFiles)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerLogWatcher/ListLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServerLogWatcherClient) Alerts(ctx context.Context, in *LogAlertsRequest, opts ...grpc.CallOption) (WebServerLogWatcher_AlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerLogWatcher_serviceDesc.Streams[2], "/bifrostpb.WebServerLogWatcher/Alerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerLogWatcherAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerLogWatcher_AlertsClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type webServerLogWatcherAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerLogWatcherAlertsClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webServerLogWatcherClient) DownloadLog(ctx context.Context, in *LogDownloadRequest, opts ...grpc.CallOption) (WebServerLogWatcher_DownloadLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerLogWatcher_serviceDesc.Streams[3], "/bifrostpb.WebServerLogWatcher/DownloadLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerLogWatcherDownloadLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerLogWatcher_DownloadLogClient interface {
+	Recv() (*LogChunk, error)
+	grpc.ClientStream
+}
+
+type webServerLogWatcherDownloadLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerLogWatcherDownloadLogClient) Recv() (*LogChunk, error) {
+	m := new(LogChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebServerLogWatcherServer is the server API for WebServerLogWatcher service.
+type WebServerLogWatcherServer interface {
+	Watch(*LogWatchRequest, WebServerLogWatcher_WatchServer) error
+	QueryLogs(*LogQueryRequest, WebServerLogWatcher_QueryLogsServer) error
+	ListLogs(context.Context, *ServerName) (*LogFiles, error)
+	Alerts(*LogAlertsRequest, WebServerLogWatcher_AlertsServer) error
+	DownloadLog(*LogDownloadRequest, WebServerLogWatcher_DownloadLogServer) error
+}
+
+// UnimplementedWebServerLogWatcherServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerLogWatcherServer struct {
+}
+
+func (*UnimplementedWebServerLogWatcherServer) Watch(*LogWatchRequest, WebServerLogWatcher_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedWebServerLogWatcherServer) QueryLogs(*LogQueryRequest, WebServerLogWatcher_QueryLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryLogs not implemented")
+}
+func (*UnimplementedWebServerLogWatcherServer) ListLogs(context.Context, *ServerName) (*LogFiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogs not implemented")
+}
+func (*UnimplementedWebServerLogWatcherServer) Alerts(*LogAlertsRequest, WebServerLogWatcher_AlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Alerts not implemented")
+}
+func (*UnimplementedWebServerLogWatcherServer) DownloadLog(*LogDownloadRequest, WebServerLogWatcher_DownloadLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadLog not implemented")
+}
+
+func RegisterWebServerLogWatcherServer(s *grpc.Server, srv WebServerLogWatcherServer) {
+	s.RegisterService(&_WebServerLogWatcher_serviceDesc, srv)
+}
+
+func _WebServerLogWatcher_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerLogWatcherServer).Watch(m, &webServerLogWatcherWatchServer{stream})
+}
+
+type WebServerLogWatcher_WatchServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type webServerLogWatcherWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerLogWatcherWatchServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebServerLogWatcher_QueryLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerLogWatcherServer).QueryLogs(m, &webServerLogWatcherQueryLogsServer{stream})
+}
+
+type WebServerLogWatcher_QueryLogsServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type webServerLogWatcherQueryLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerLogWatcherQueryLogsServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebServerLogWatcher_ListLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerLogWatcherServer).ListLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerLogWatcher/ListLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerLogWatcherServer).ListLogs(ctx, req.(*ServerName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebServerLogWatcher_Alerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerLogWatcherServer).Alerts(m, &webServerLogWatcherAlertsServer{stream})
+}
+
+type WebServerLogWatcher_AlertsServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type webServerLogWatcherAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerLogWatcherAlertsServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebServerLogWatcher_DownloadLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerLogWatcherServer).DownloadLog(m, &webServerLogWatcherDownloadLogServer{stream})
+}
+
+type WebServerLogWatcher_DownloadLogServer interface {
+	Send(*LogChunk) error
+	grpc.ServerStream
+}
+
+type webServerLogWatcherDownloadLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerLogWatcherDownloadLogServer) Send(m *LogChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WebServerLogWatcher_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerLogWatcher",
+	HandlerType: (*WebServerLogWatcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLogs",
+			Handler:    _WebServerLogWatcher_ListLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _WebServerLogWatcher_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "QueryLogs",
+			Handler:       _WebServerLogWatcher_QueryLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Alerts",
+			Handler:       _WebServerLogWatcher_Alerts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadLog",
+			Handler:       _WebServerLogWatcher_DownloadLog_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerCertificateClient is the client API for WebServerCertificate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerCertificateClient interface {
+	Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerCertificate_GetClient, error)
+	WatchExpiry(ctx context.Context, in *CertificateWatchRequest, opts ...grpc.CallOption) (WebServerCertificate_WatchExpiryClient, error)
+}
+
+type webServerCertificateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerCertificateClient(cc grpc.ClientConnInterface) WebServerCertificateClient {
+	return &webServerCertificateClient{cc}
+}
+
+func (c *webServerCertificateClient) Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerCertificate_GetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerCertificate_serviceDesc.Streams[0], "/bifrostpb.WebServerCertificate/Get", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerCertificateGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerCertificate_GetClient interface {
+	Recv() (*Certificates, error)
+	grpc.ClientStream
+}
+
+type webServerCertificateGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerCertificateGetClient) Recv() (*Certificates, error) {
+	m := new(Certificates)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *webServerCertificateClient) WatchExpiry(ctx context.Context, in *CertificateWatchRequest, opts ...grpc.CallOption) (WebServerCertificate_WatchExpiryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerCertificate_serviceDesc.Streams[1], "/bifrostpb.WebServerCertificate/WatchExpiry", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerCertificateWatchExpiryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerCertificate_WatchExpiryClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type webServerCertificateWatchExpiryClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerCertificateWatchExpiryClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebServerCertificateServer is the server API for WebServerCertificate service.
+type WebServerCertificateServer interface {
+	Get(*ServerName, WebServerCertificate_GetServer) error
+	WatchExpiry(*CertificateWatchRequest, WebServerCertificate_WatchExpiryServer) error
+}
+
+// UnimplementedWebServerCertificateServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerCertificateServer struct {
+}
+
+func (*UnimplementedWebServerCertificateServer) Get(*ServerName, WebServerCertificate_GetServer) error {
+	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedWebServerCertificateServer) WatchExpiry(*CertificateWatchRequest, WebServerCertificate_WatchExpiryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchExpiry not implemented")
+}
+
+func RegisterWebServerCertificateServer(s *grpc.Server, srv WebServerCertificateServer) {
+	s.RegisterService(&_WebServerCertificate_serviceDesc, srv)
+}
+
+func _WebServerCertificate_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerCertificateServer).Get(m, &webServerCertificateGetServer{stream})
+}
+
+type WebServerCertificate_GetServer interface {
+	Send(*Certificates) error
+	grpc.ServerStream
+}
+
+type webServerCertificateGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerCertificateGetServer) Send(m *Certificates) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WebServerCertificate_WatchExpiry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CertificateWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerCertificateServer).WatchExpiry(m, &webServerCertificateWatchExpiryServer{stream})
+}
+
+type WebServerCertificate_WatchExpiryServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type webServerCertificateWatchExpiryServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerCertificateWatchExpiryServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WebServerCertificate_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerCertificate",
+	HandlerType: (*WebServerCertificateServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Get",
+			Handler:       _WebServerCertificate_Get_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchExpiry",
+			Handler:       _WebServerCertificate_WatchExpiry_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerLinterClient is the client API for WebServerLinter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerLinterClient interface {
+	Lint(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerLinter_LintClient, error)
+}
+
+type webServerLinterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerLinterClient(cc grpc.ClientConnInterface) WebServerLinterClient {
+	return &webServerLinterClient{cc}
+}
+
+func (c *webServerLinterClient) Lint(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerLinter_LintClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerLinter_serviceDesc.Streams[0], "/bifrostpb.WebServerLinter/Lint", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerLinterLintClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WebServerLinter_LintClient interface {
+	Recv() (*LintReport, error)
+	grpc.ClientStream
+}
+
+type webServerLinterLintClient struct {
+	grpc.ClientStream
+}
+
+func (x *webServerLinterLintClient) Recv() (*LintReport, error) {
+	m := new(LintReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WebServerLinterServer is the server API for WebServerLinter service.
+type WebServerLinterServer interface {
+	Lint(*ServerName, WebServerLinter_LintServer) error
+}
+
+// UnimplementedWebServerLinterServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerLinterServer struct {
+}
+
+func (*UnimplementedWebServerLinterServer) Lint(*ServerName, WebServerLinter_LintServer) error {
+	return status.Errorf(codes.Unimplemented, "method Lint not implemented")
+}
+
+func RegisterWebServerLinterServer(s *grpc.Server, srv WebServerLinterServer) {
+	s.RegisterService(&_WebServerLinter_serviceDesc, srv)
+}
+
+func _WebServerLinter_Lint_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ServerName)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerLinterServer).Lint(m, &webServerLinterLintServer{stream})
+}
+
+type WebServerLinter_LintServer interface {
+	Send(*LintReport) error
+	grpc.ServerStream
+}
+
+type webServerLinterLintServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerLinterLintServer) Send(m *LintReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WebServerLinter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerLinter",
+	HandlerType: (*WebServerLinterServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Lint",
+			Handler:       _WebServerLinter_Lint_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerRouteSimulatorClient is the client API for WebServerRouteSimulator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerRouteSimulatorClient interface {
+	Simulate(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (WebServerRouteSimulator_SimulateClient, error)
+}
+
+type webServerRouteSimulatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerRouteSimulatorClient(cc grpc.ClientConnInterface) WebServerRouteSimulatorClient {
+	return &webServerRouteSimulatorClient{cc}
+}
+
+func (c *webServerRouteSimulatorClient) Simulate(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (WebServerRouteSimulator_SimulateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WebServerRouteSimulator_serviceDesc.Streams[0], "/bifrostpb.WebServerRouteSimulator/Simulate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &webServerRouteSimulatorSimulateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
-type WebServerConfig_UpdateClient interface {
-	Send(*ServerConfig) error
-	CloseAndRecv() (*Response, error)
+type WebServerRouteSimulator_SimulateClient interface {
+	Recv() (*RouteResult, error)
 	grpc.ClientStream
 }
 
-type webServerConfigUpdateClient struct {
+type webServerRouteSimulatorSimulateClient struct {
 	grpc.ClientStream
 }
 
-func (x *webServerConfigUpdateClient) Send(m *ServerConfig) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *webServerConfigUpdateClient) CloseAndRecv() (*Response, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(Response)
+func (x *webServerRouteSimulatorSimulateClient) Recv() (*RouteResult, error) {
+	m := new(RouteResult)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-// WebServerConfigServer is the server API for WebServerConfig service.
-type WebServerConfigServer interface {
-	GetServerNames(context.Context, *Null) (*ServerNames, error)
-	Get(*ServerName, WebServerConfig_GetServer) error
-	Update(WebServerConfig_UpdateServer) error
+// WebServerRouteSimulatorServer is the server API for WebServerRouteSimulator service.
+type WebServerRouteSimulatorServer interface {
+	Simulate(*RouteRequest, WebServerRouteSimulator_SimulateServer) error
 }
 
-// UnimplementedWebServerConfigServer can be embedded to have forward compatible implementations.
-type UnimplementedWebServerConfigServer struct {
+// UnimplementedWebServerRouteSimulatorServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerRouteSimulatorServer struct {
 }
 
-func (*UnimplementedWebServerConfigServer) GetServerNames(context.Context, *Null) (*ServerNames, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServerNames not implemented")
+func (*UnimplementedWebServerRouteSimulatorServer) Simulate(*RouteRequest, WebServerRouteSimulator_SimulateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
-func (*UnimplementedWebServerConfigServer) Get(*ServerName, WebServerConfig_GetServer) error {
-	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func RegisterWebServerRouteSimulatorServer(s *grpc.Server, srv WebServerRouteSimulatorServer) {
+	s.RegisterService(&_WebServerRouteSimulator_serviceDesc, srv)
 }
-func (*UnimplementedWebServerConfigServer) Update(WebServerConfig_UpdateServer) error {
-	return status.Errorf(codes.Unimplemented, "method Update not implemented")
+
+func _WebServerRouteSimulator_Simulate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RouteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebServerRouteSimulatorServer).Simulate(m, &webServerRouteSimulatorSimulateServer{stream})
 }
 
-func RegisterWebServerConfigServer(s *grpc.Server, srv WebServerConfigServer) {
-	s.RegisterService(&_WebServerConfig_serviceDesc, srv)
+type WebServerRouteSimulator_SimulateServer interface {
+	Send(*RouteResult) error
+	grpc.ServerStream
 }
 
-func _WebServerConfig_GetServerNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
-	if err := dec(in); err != nil {
+type webServerRouteSimulatorSimulateServer struct {
+	grpc.ServerStream
+}
+
+func (x *webServerRouteSimulatorSimulateServer) Send(m *RouteResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WebServerRouteSimulator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerRouteSimulator",
+	HandlerType: (*WebServerRouteSimulatorServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Simulate",
+			Handler:       _WebServerRouteSimulator_Simulate_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
+}
+
+// WebServerManagerClient is the client API for WebServerManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebServerManagerClient interface {
+	List(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ManagedWebServers, error)
+	Register(ctx context.Context, in *ManagedWebServer, opts ...grpc.CallOption) (*Response, error)
+	Reconfigure(ctx context.Context, in *ManagedWebServer, opts ...grpc.CallOption) (*Response, error)
+	Unregister(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (*Response, error)
+	GetStates(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ConfigManagerStates, error)
+}
+
+type webServerManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebServerManagerClient(cc grpc.ClientConnInterface) WebServerManagerClient {
+	return &webServerManagerClient{cc}
+}
+
+func (c *webServerManagerClient) List(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ManagedWebServers, error) {
+	out := new(ManagedWebServers)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerManager/List", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(WebServerConfigServer).GetServerNames(ctx, in)
+	return out, nil
+}
+
+func (c *webServerManagerClient) Register(ctx context.Context, in *ManagedWebServer, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerManager/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/bifrostpb.WebServerConfig/GetServerNames",
+	return out, nil
+}
+
+func (c *webServerManagerClient) Reconfigure(ctx context.Context, in *ManagedWebServer, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerManager/Reconfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WebServerConfigServer).GetServerNames(ctx, req.(*Null))
+	return out, nil
+}
+
+func (c *webServerManagerClient) Unregister(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerManager/Unregister", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _WebServerConfig_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ServerName)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *webServerManagerClient) GetStates(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ConfigManagerStates, error) {
+	out := new(ConfigManagerStates)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerManager/GetStates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(WebServerConfigServer).Get(m, &webServerConfigGetServer{stream})
+	return out, nil
 }
 
-type WebServerConfig_GetServer interface {
-	Send(*ServerConfig) error
-	grpc.ServerStream
+// WebServerManagerServer is the server API for WebServerManager service.
+type WebServerManagerServer interface {
+	List(context.Context, *Null) (*ManagedWebServers, error)
+	Register(context.Context, *ManagedWebServer) (*Response, error)
+	Reconfigure(context.Context, *ManagedWebServer) (*Response, error)
+	Unregister(context.Context, *ServerName) (*Response, error)
+	GetStates(context.Context, *Null) (*ConfigManagerStates, error)
 }
 
-type webServerConfigGetServer struct {
-	grpc.ServerStream
+// UnimplementedWebServerManagerServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerManagerServer struct {
 }
 
-func (x *webServerConfigGetServer) Send(m *ServerConfig) error {
-	return x.ServerStream.SendMsg(m)
+func (*UnimplementedWebServerManagerServer) List(context.Context, *Null) (*ManagedWebServers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedWebServerManagerServer) Register(context.Context, *ManagedWebServer) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedWebServerManagerServer) Reconfigure(context.Context, *ManagedWebServer) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconfigure not implemented")
+}
+func (*UnimplementedWebServerManagerServer) Unregister(context.Context, *ServerName) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unregister not implemented")
+}
+func (*UnimplementedWebServerManagerServer) GetStates(context.Context, *Null) (*ConfigManagerStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStates not implemented")
 }
 
-func _WebServerConfig_Update_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WebServerConfigServer).Update(&webServerConfigUpdateServer{stream})
+func RegisterWebServerManagerServer(s *grpc.Server, srv WebServerManagerServer) {
+	s.RegisterService(&_WebServerManager_serviceDesc, srv)
 }
 
-type WebServerConfig_UpdateServer interface {
-	SendAndClose(*Response) error
-	Recv() (*ServerConfig, error)
-	grpc.ServerStream
+func _WebServerManager_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerManagerServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerManager/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerManagerServer).List(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type webServerConfigUpdateServer struct {
-	grpc.ServerStream
+func _WebServerManager_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManagedWebServer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerManagerServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerManager/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerManagerServer).Register(ctx, req.(*ManagedWebServer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *webServerConfigUpdateServer) SendAndClose(m *Response) error {
-	return x.ServerStream.SendMsg(m)
+func _WebServerManager_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManagedWebServer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerManagerServer).Reconfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerManager/Reconfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerManagerServer).Reconfigure(ctx, req.(*ManagedWebServer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *webServerConfigUpdateServer) Recv() (*ServerConfig, error) {
-	m := new(ServerConfig)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _WebServerManager_Unregister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerName)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(WebServerManagerServer).Unregister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerManager/Unregister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerManagerServer).Unregister(ctx, req.(*ServerName))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-var _WebServerConfig_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bifrostpb.WebServerConfig",
-	HandlerType: (*WebServerConfigServer)(nil),
+func _WebServerManager_GetStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerManagerServer).GetStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerManager/GetStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerManagerServer).GetStates(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WebServerManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerManager",
+	HandlerType: (*WebServerManagerServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetServerNames",
-			Handler:    _WebServerConfig_GetServerNames_Handler,
+			MethodName: "List",
+			Handler:    _WebServerManager_List_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "Get",
-			Handler:       _WebServerConfig_Get_Handler,
-			ServerStreams: true,
+			MethodName: "Register",
+			Handler:    _WebServerManager_Register_Handler,
 		},
 		{
-			StreamName:    "Update",
-			Handler:       _WebServerConfig_Update_Handler,
-			ClientStreams: true,
+			MethodName: "Reconfigure",
+			Handler:    _WebServerManager_Reconfigure_Handler,
+		},
+		{
+			MethodName: "Unregister",
+			Handler:    _WebServerManager_Unregister_Handler,
+		},
+		{
+			MethodName: "GetStates",
+			Handler:    _WebServerManager_GetStates_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
 }
 
-// WebServerStatisticsClient is the client API for WebServerStatistics service.
+// WebServerTemplateClient is the client API for WebServerTemplate service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WebServerStatisticsClient interface {
-	Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerStatistics_GetClient, error)
+type WebServerTemplateClient interface {
+	List(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Templates, error)
+	Apply(ctx context.Context, in *TemplateApplyRequest, opts ...grpc.CallOption) (*TemplateApplyResult, error)
 }
 
-type webServerStatisticsClient struct {
+type webServerTemplateClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewWebServerStatisticsClient(cc grpc.ClientConnInterface) WebServerStatisticsClient {
-	return &webServerStatisticsClient{cc}
+func NewWebServerTemplateClient(cc grpc.ClientConnInterface) WebServerTemplateClient {
+	return &webServerTemplateClient{cc}
 }
 
-func (c *webServerStatisticsClient) Get(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (WebServerStatistics_GetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WebServerStatistics_serviceDesc.Streams[0], "/bifrostpb.WebServerStatistics/Get", opts...)
+func (c *webServerTemplateClient) List(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Templates, error) {
+	out := new(Templates)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerTemplate/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &webServerStatisticsGetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type WebServerStatistics_GetClient interface {
-	Recv() (*Statistics, error)
-	grpc.ClientStream
-}
-
-type webServerStatisticsGetClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *webServerStatisticsGetClient) Recv() (*Statistics, error) {
-	m := new(Statistics)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *webServerTemplateClient) Apply(ctx context.Context, in *TemplateApplyRequest, opts ...grpc.CallOption) (*TemplateApplyResult, error) {
+	out := new(TemplateApplyResult)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerTemplate/Apply", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-// WebServerStatisticsServer is the server API for WebServerStatistics service.
-type WebServerStatisticsServer interface {
-	Get(*ServerName, WebServerStatistics_GetServer) error
+	return out, nil
 }
 
-// UnimplementedWebServerStatisticsServer can be embedded to have forward compatible implementations.
-type UnimplementedWebServerStatisticsServer struct {
+// WebServerTemplateServer is the server API for WebServerTemplate service.
+type WebServerTemplateServer interface {
+	List(context.Context, *Null) (*Templates, error)
+	Apply(context.Context, *TemplateApplyRequest) (*TemplateApplyResult, error)
 }
 
-func (*UnimplementedWebServerStatisticsServer) Get(*ServerName, WebServerStatistics_GetServer) error {
-	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+// UnimplementedWebServerTemplateServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerTemplateServer struct {
 }
 
-func RegisterWebServerStatisticsServer(s *grpc.Server, srv WebServerStatisticsServer) {
-	s.RegisterService(&_WebServerStatistics_serviceDesc, srv)
+func (*UnimplementedWebServerTemplateServer) List(context.Context, *Null) (*Templates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
-
-func _WebServerStatistics_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ServerName)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WebServerStatisticsServer).Get(m, &webServerStatisticsGetServer{stream})
+func (*UnimplementedWebServerTemplateServer) Apply(context.Context, *TemplateApplyRequest) (*TemplateApplyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
 }
 
-type WebServerStatistics_GetServer interface {
-	Send(*Statistics) error
-	grpc.ServerStream
+func RegisterWebServerTemplateServer(s *grpc.Server, srv WebServerTemplateServer) {
+	s.RegisterService(&_WebServerTemplate_serviceDesc, srv)
 }
 
-type webServerStatisticsGetServer struct {
-	grpc.ServerStream
+func _WebServerTemplate_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerTemplateServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerTemplate/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerTemplateServer).List(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *webServerStatisticsGetServer) Send(m *Statistics) error {
-	return x.ServerStream.SendMsg(m)
+func _WebServerTemplate_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerTemplateServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerTemplate/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerTemplateServer).Apply(ctx, req.(*TemplateApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-var _WebServerStatistics_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bifrostpb.WebServerStatistics",
-	HandlerType: (*WebServerStatisticsServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
+var _WebServerTemplate_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerTemplate",
+	HandlerType: (*WebServerTemplateServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			StreamName:    "Get",
-			Handler:       _WebServerStatistics_Get_Handler,
-			ServerStreams: true,
+			MethodName: "List",
+			Handler:    _WebServerTemplate_List_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _WebServerTemplate_Apply_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
 }
 
-// WebServerStatusClient is the client API for WebServerStatus service.
+// WebServerReconcilerClient is the client API for WebServerReconciler service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WebServerStatusClient interface {
-	Get(ctx context.Context, in *Null, opts ...grpc.CallOption) (WebServerStatus_GetClient, error)
+type WebServerReconcilerClient interface {
+	Plan(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ReconcilePlans, error)
+	Apply(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ReconcilePlans, error)
 }
 
-type webServerStatusClient struct {
+type webServerReconcilerClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewWebServerStatusClient(cc grpc.ClientConnInterface) WebServerStatusClient {
-	return &webServerStatusClient{cc}
+func NewWebServerReconcilerClient(cc grpc.ClientConnInterface) WebServerReconcilerClient {
+	return &webServerReconcilerClient{cc}
 }
 
-func (c *webServerStatusClient) Get(ctx context.Context, in *Null, opts ...grpc.CallOption) (WebServerStatus_GetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WebServerStatus_serviceDesc.Streams[0], "/bifrostpb.WebServerStatus/Get", opts...)
+func (c *webServerReconcilerClient) Plan(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ReconcilePlans, error) {
+	out := new(ReconcilePlans)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerReconciler/Plan", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &webServerStatusGetClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type WebServerStatus_GetClient interface {
-	Recv() (*Metrics, error)
-	grpc.ClientStream
-}
-
-type webServerStatusGetClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *webServerStatusGetClient) Recv() (*Metrics, error) {
-	m := new(Metrics)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *webServerReconcilerClient) Apply(ctx context.Context, in *Null, opts ...grpc.CallOption) (*ReconcilePlans, error) {
+	out := new(ReconcilePlans)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerReconciler/Apply", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-// WebServerStatusServer is the server API for WebServerStatus service.
-type WebServerStatusServer interface {
-	Get(*Null, WebServerStatus_GetServer) error
+	return out, nil
 }
 
-// UnimplementedWebServerStatusServer can be embedded to have forward compatible implementations.
-type UnimplementedWebServerStatusServer struct {
+// WebServerReconcilerServer is the server API for WebServerReconciler service.
+type WebServerReconcilerServer interface {
+	Plan(context.Context, *Null) (*ReconcilePlans, error)
+	Apply(context.Context, *Null) (*ReconcilePlans, error)
 }
 
-func (*UnimplementedWebServerStatusServer) Get(*Null, WebServerStatus_GetServer) error {
-	return status.Errorf(codes.Unimplemented, "method Get not implemented")
+// UnimplementedWebServerReconcilerServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerReconcilerServer struct {
 }
 
-func RegisterWebServerStatusServer(s *grpc.Server, srv WebServerStatusServer) {
-	s.RegisterService(&_WebServerStatus_serviceDesc, srv)
+func (*UnimplementedWebServerReconcilerServer) Plan(context.Context, *Null) (*ReconcilePlans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plan not implemented")
 }
-
-func _WebServerStatus_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Null)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(WebServerStatusServer).Get(m, &webServerStatusGetServer{stream})
+func (*UnimplementedWebServerReconcilerServer) Apply(context.Context, *Null) (*ReconcilePlans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
 }
 
-type WebServerStatus_GetServer interface {
-	Send(*Metrics) error
-	grpc.ServerStream
+func RegisterWebServerReconcilerServer(s *grpc.Server, srv WebServerReconcilerServer) {
+	s.RegisterService(&_WebServerReconciler_serviceDesc, srv)
 }
 
-type webServerStatusGetServer struct {
-	grpc.ServerStream
+func _WebServerReconciler_Plan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerReconcilerServer).Plan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerReconciler/Plan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerReconcilerServer).Plan(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *webServerStatusGetServer) Send(m *Metrics) error {
-	return x.ServerStream.SendMsg(m)
+func _WebServerReconciler_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerReconcilerServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerReconciler/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerReconcilerServer).Apply(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-var _WebServerStatus_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bifrostpb.WebServerStatus",
-	HandlerType: (*WebServerStatusServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
+var _WebServerReconciler_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerReconciler",
+	HandlerType: (*WebServerReconcilerServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			StreamName:    "Get",
-			Handler:       _WebServerStatus_Get_Handler,
-			ServerStreams: true,
+			MethodName: "Plan",
+			Handler:    _WebServerReconciler_Plan_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _WebServerReconciler_Apply_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
 }
 
-// WebServerLogWatcherClient is the client API for WebServerLogWatcher service.
+// WebServerUpstreamClient is the client API for WebServerUpstream service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WebServerLogWatcherClient interface {
-	Watch(ctx context.Context, in *LogWatchRequest, opts ...grpc.CallOption) (WebServerLogWatcher_WatchClient, error)
+type WebServerUpstreamClient interface {
+	List(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (*Upstreams, error)
+	AddMember(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error)
+	RemoveMember(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error)
+	SetWeight(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error)
+	SetState(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
-type webServerLogWatcherClient struct {
+type webServerUpstreamClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewWebServerLogWatcherClient(cc grpc.ClientConnInterface) WebServerLogWatcherClient {
-	return &webServerLogWatcherClient{cc}
+func NewWebServerUpstreamClient(cc grpc.ClientConnInterface) WebServerUpstreamClient {
+	return &webServerUpstreamClient{cc}
 }
 
-func (c *webServerLogWatcherClient) Watch(ctx context.Context, in *LogWatchRequest, opts ...grpc.CallOption) (WebServerLogWatcher_WatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WebServerLogWatcher_serviceDesc.Streams[0], "/bifrostpb.WebServerLogWatcher/Watch", opts...)
+func (c *webServerUpstreamClient) List(ctx context.Context, in *ServerName, opts ...grpc.CallOption) (*Upstreams, error) {
+	out := new(Upstreams)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerUpstream/List", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &webServerLogWatcherWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *webServerUpstreamClient) AddMember(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerUpstream/AddMember", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type WebServerLogWatcher_WatchClient interface {
-	Recv() (*Response, error)
-	grpc.ClientStream
+func (c *webServerUpstreamClient) RemoveMember(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerUpstream/RemoveMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type webServerLogWatcherWatchClient struct {
-	grpc.ClientStream
+func (c *webServerUpstreamClient) SetWeight(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerUpstream/SetWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *webServerLogWatcherWatchClient) Recv() (*Response, error) {
-	m := new(Response)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *webServerUpstreamClient) SetState(ctx context.Context, in *UpstreamMemberRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/bifrostpb.WebServerUpstream/SetState", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// WebServerLogWatcherServer is the server API for WebServerLogWatcher service.
-type WebServerLogWatcherServer interface {
-	Watch(*LogWatchRequest, WebServerLogWatcher_WatchServer) error
+// WebServerUpstreamServer is the server API for WebServerUpstream service.
+type WebServerUpstreamServer interface {
+	List(context.Context, *ServerName) (*Upstreams, error)
+	AddMember(context.Context, *UpstreamMemberRequest) (*Response, error)
+	RemoveMember(context.Context, *UpstreamMemberRequest) (*Response, error)
+	SetWeight(context.Context, *UpstreamMemberRequest) (*Response, error)
+	SetState(context.Context, *UpstreamMemberRequest) (*Response, error)
 }
 
-// UnimplementedWebServerLogWatcherServer can be embedded to have forward compatible implementations.
-type UnimplementedWebServerLogWatcherServer struct {
+// UnimplementedWebServerUpstreamServer can be embedded to have forward compatible implementations.
+type UnimplementedWebServerUpstreamServer struct {
 }
 
-func (*UnimplementedWebServerLogWatcherServer) Watch(*LogWatchRequest, WebServerLogWatcher_WatchServer) error {
-	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+func (*UnimplementedWebServerUpstreamServer) List(context.Context, *ServerName) (*Upstreams, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedWebServerUpstreamServer) AddMember(context.Context, *UpstreamMemberRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (*UnimplementedWebServerUpstreamServer) RemoveMember(context.Context, *UpstreamMemberRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (*UnimplementedWebServerUpstreamServer) SetWeight(context.Context, *UpstreamMemberRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWeight not implemented")
+}
+func (*UnimplementedWebServerUpstreamServer) SetState(context.Context, *UpstreamMemberRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetState not implemented")
 }
 
-func RegisterWebServerLogWatcherServer(s *grpc.Server, srv WebServerLogWatcherServer) {
-	s.RegisterService(&_WebServerLogWatcher_serviceDesc, srv)
+func RegisterWebServerUpstreamServer(s *grpc.Server, srv WebServerUpstreamServer) {
+	s.RegisterService(&_WebServerUpstream_serviceDesc, srv)
 }
 
-func _WebServerLogWatcher_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(LogWatchRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _WebServerUpstream_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerName)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(WebServerLogWatcherServer).Watch(m, &webServerLogWatcherWatchServer{stream})
+	if interceptor == nil {
+		return srv.(WebServerUpstreamServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerUpstream/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerUpstreamServer).List(ctx, req.(*ServerName))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type WebServerLogWatcher_WatchServer interface {
-	Send(*Response) error
-	grpc.ServerStream
+func _WebServerUpstream_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerUpstreamServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerUpstream/AddMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerUpstreamServer).AddMember(ctx, req.(*UpstreamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type webServerLogWatcherWatchServer struct {
-	grpc.ServerStream
+func _WebServerUpstream_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerUpstreamServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerUpstream/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerUpstreamServer).RemoveMember(ctx, req.(*UpstreamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *webServerLogWatcherWatchServer) Send(m *Response) error {
-	return x.ServerStream.SendMsg(m)
+func _WebServerUpstream_SetWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerUpstreamServer).SetWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerUpstream/SetWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerUpstreamServer).SetWeight(ctx, req.(*UpstreamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-var _WebServerLogWatcher_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "bifrostpb.WebServerLogWatcher",
-	HandlerType: (*WebServerLogWatcherServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
+func _WebServerUpstream_SetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServerUpstreamServer).SetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bifrostpb.WebServerUpstream/SetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServerUpstreamServer).SetState(ctx, req.(*UpstreamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WebServerUpstream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "bifrostpb.WebServerUpstream",
+	HandlerType: (*WebServerUpstreamServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			StreamName:    "Watch",
-			Handler:       _WebServerLogWatcher_Watch_Handler,
-			ServerStreams: true,
+			MethodName: "List",
+			Handler:    _WebServerUpstream_List_Handler,
+		},
+		{
+			MethodName: "AddMember",
+			Handler:    _WebServerUpstream_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _WebServerUpstream_RemoveMember_Handler,
+		},
+		{
+			MethodName: "SetWeight",
+			Handler:    _WebServerUpstream_SetWeight_Handler,
+		},
+		{
+			MethodName: "SetState",
+			Handler:    _WebServerUpstream_SetState_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/protobuf-spec/bifrostpb/v1/bifrost.proto",
 }
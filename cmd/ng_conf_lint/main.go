@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	bifrost_cliv1 "github.com/ClessLi/bifrost/pkg/client/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/linter"
+	"google.golang.org/grpc"
+	"os"
+	"strings"
+	"time"
+)
+
+// exit codes
+const (
+	exitOK = iota
+	exitIssuesFound
+	exitFailed
+)
+
+var (
+	serverAddr = flag.String("server", "", "Lint the web server configs managed by the bifrost server `address`, instead of the local config files.")
+	rules      = flag.String("rules", "", "Set the lint rules of the local config files, e.g. `server-tokens-on=off,autoindex-on=error`.")
+	failOn     = flag.String("fail-on", string(v1.LintSeverityError), "Exit with code 1 if any issue is at or above the `severity`, which can be `info`, `warning` or `error`.")
+	format     = flag.String("format", "text", "Set the output `format`, which can be `text` or `json`.")
+	listRules  = flag.Bool("list-rules", false, "List the built-in lint rules.")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <config file name>...\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "       %s [flags] -server <address> <web server name>...\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *listRules {
+		for _, rule := range linter.DefaultRegistry().Rules() {
+			fmt.Printf("%-32s %-8s %s\n", rule.Name(), rule.DefaultSeverity(), rule.Description())
+		}
+		os.Exit(exitOK)
+	}
+
+	threshold := v1.LintSeverity(strings.ToLower(*failOn))
+	if threshold.Rank() == 0 || (*format != "text" && *format != "json") || flag.NArg() == 0 {
+		usage()
+		os.Exit(exitFailed)
+	}
+
+	var reports []*v1.LintReport
+	var err error
+	if *serverAddr != "" {
+		reports, err = lintRemote(*serverAddr, flag.Args())
+	} else {
+		reports, err = lintLocal(flag.Args())
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitFailed)
+	}
+
+	if err = output(reports); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitFailed)
+	}
+
+	for _, report := range reports {
+		if report.MaxSeverity().Rank() >= threshold.Rank() {
+			os.Exit(exitIssuesFound)
+		}
+	}
+}
+
+func lintLocal(paths []string) ([]*v1.LintReport, error) {
+	settings := make(map[string]string)
+	for _, setting := range strings.Split(*rules, ",") {
+		if strings.TrimSpace(setting) == "" {
+			continue
+		}
+		kv := strings.SplitN(setting, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid lint rule setting '%s'", setting)
+		}
+		settings[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	l, err := linter.New(linter.DefaultRegistry(), settings)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*v1.LintReport, 0, len(paths))
+	for _, path := range paths {
+		c, err := configuration.NewConfigurationFromPath(path)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, &v1.LintReport{
+			ServerName: &v1.ServerName{Name: path},
+			Issues:     l.Lint(c),
+		})
+	}
+	return reports, nil
+}
+
+// lintRemote lints with the rules configured for each web server in the bifrost server.
+func lintRemote(addr string, servernames []string) ([]*v1.LintReport, error) {
+	client, err := bifrost_cliv1.New(addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(time.Second*10))
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	reports := make([]*v1.LintReport, 0, len(servernames))
+	for _, servername := range servernames {
+		report, err := client.WebServerLinter().Lint(servername)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func output(reports []*v1.LintReport) error {
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(reports)
+	}
+	for _, report := range reports {
+		fmt.Printf("%s: %d issue(s)\n", report.ServerName.Name, len(report.Issues))
+		for _, issue := range report.Issues {
+			fmt.Printf("  [%s] %s: %s\n", issue.Severity, issue.Rule, issue.Message)
+			for _, position := range issue.Positions {
+				fmt.Printf("      at %s\n", position)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	bifrost_cliv1 "github.com/ClessLi/bifrost/pkg/client/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"google.golang.org/grpc"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// exit codes
+const (
+	exitOK = iota
+	exitChangesFound
+	exitFailed
+)
+
+var (
+	serverAddr = flag.String("server", "", "Reconcile the web servers managed by the bifrost server `address`, with its site definitions.")
+	apply      = flag.Bool("apply", false, "Apply the changes by the bifrost server, instead of only planning them.")
+	dir        = flag.String("dir", "", "Plan the changes of the local config file with the site definitions in the `directory`.")
+	webServer  = flag.String("web-server", "", "Set the web server `name` of the site definitions to plan with the local config file, which can be omitted if only one web server is defined.")
+	format     = flag.String("format", "text", "Set the output `format`, which can be `text` or `json`.")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] -dir <definitions directory> <config file name>\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "       %s [flags] -server <address> [-apply]\n\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *format != "text" && *format != "json" {
+		usage()
+		os.Exit(exitFailed)
+	}
+
+	var plans []*v1.ReconcilePlan
+	var err error
+	switch {
+	case *serverAddr != "" && flag.NArg() == 0:
+		plans, err = reconcileRemote(*serverAddr, *apply)
+	case *serverAddr == "" && *dir != "" && flag.NArg() == 1 && !*apply:
+		plans, err = planLocal(*dir, *webServer, flag.Arg(0))
+	default:
+		usage()
+		os.Exit(exitFailed)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitFailed)
+	}
+
+	if err = output(plans); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitFailed)
+	}
+
+	// the applied changes are not pending any more
+	for _, plan := range plans {
+		if len(plan.Changes) > 0 && !plan.Applied {
+			os.Exit(exitChangesFound)
+		}
+	}
+}
+
+// planLocal plans the changes of the config file, which is never changed.
+func planLocal(dir, servername, path string) ([]*v1.ReconcilePlan, error) {
+	definitions, err := configuration.LoadSiteDefinitions(dir)
+	if err != nil {
+		return nil, err
+	}
+	if servername == "" {
+		if len(definitions) != 1 {
+			names := make([]string, 0, len(definitions))
+			for name := range definitions {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("the web server is ambiguous, it should be set by -web-server in [%s]", strings.Join(names, ", "))
+		}
+		for name := range definitions {
+			servername = name
+		}
+	}
+	defs, has := definitions[servername]
+	if !has {
+		return nil, fmt.Errorf("web server '%s' is not defined in '%s'", servername, dir)
+	}
+
+	c, err := configuration.NewConfigurationFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := configuration.NewReconciler(c, defs).Plan()
+	if err != nil {
+		return nil, err
+	}
+	return []*v1.ReconcilePlan{plan}, nil
+}
+
+// reconcileRemote reconciles the web servers with the site definitions of the bifrost server.
+func reconcileRemote(addr string, apply bool) ([]*v1.ReconcilePlan, error) {
+	client, err := bifrost_cliv1.New(addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(time.Second*10))
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	if apply {
+		return client.WebServerReconciler().Apply()
+	}
+	return client.WebServerReconciler().Plan()
+}
+
+func output(plans []*v1.ReconcilePlan) error {
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(plans)
+	}
+	for _, plan := range plans {
+		state := "planned"
+		if plan.Applied {
+			state = "applied"
+		}
+		fmt.Printf("%s: %d change(s) %s\n", plan.ServerName, len(plan.Changes), state)
+		for _, change := range plan.Changes {
+			fmt.Printf("  %s %s %s", change.Action, change.Kind, change.Name)
+			if change.Position != "" {
+				fmt.Printf(" at %s", change.Position)
+			}
+			if change.Drifted {
+				fmt.Print(" (drifted from the last applied)")
+			}
+			fmt.Println()
+			for _, line := range strings.Split(change.Diff, "\n") {
+				fmt.Printf("      %s\n", line)
+			}
+		}
+	}
+	return nil
+}
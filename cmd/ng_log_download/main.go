@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	bifrost_cliv1 "github.com/ClessLi/bifrost/pkg/client/bifrost/v1"
+	"google.golang.org/grpc"
+	"os"
+	"time"
+)
+
+// exit codes
+const (
+	exitOK = iota
+	exitFailed
+)
+
+var (
+	serverAddr = flag.String("server", "", "Download the logs of the web server managed by the bifrost server `address`.")
+	outputDir  = flag.String("o", ".", "Save the downloaded file in the `dir`.")
+	archive    = flag.Bool("archive", false, "Download a tar.gz archive of the logs and the current config, even if only one log is given.")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] -server <address> <web server name> <log name>...\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "       %s -server <address> <web server name>\n\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "The logs of the web server are listed if no log name is given. The log names are the listed names,\n")
+	fmt.Fprintf(flag.CommandLine.Output(), "their rotated siblings like `access.log.2.gz`, or patterns like `access.log*` in the archive.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *serverAddr == "" || flag.NArg() == 0 {
+		usage()
+		os.Exit(exitFailed)
+	}
+
+	client, err := bifrost_cliv1.New(*serverAddr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(time.Second*10))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(exitFailed)
+	}
+	defer client.Close()
+
+	servername := flag.Arg(0)
+	if flag.NArg() == 1 {
+		err = listLogs(client, servername)
+	} else {
+		err = download(client, servername, flag.Args()[1:])
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		client.Close()
+		os.Exit(exitFailed)
+	}
+}
+
+func listLogs(client *bifrost_cliv1.Client, servername string) error {
+	logs, err := client.WebServerLogWatcher().ListLogs(servername)
+	if err != nil {
+		return err
+	}
+	for _, file := range logs {
+		if file.Exists {
+			fmt.Printf("%-48s %12d %s\n", file.Name, file.Size, file.ModTime.Format(time.RFC3339))
+		} else {
+			fmt.Printf("%-48s %12s\n", file.Name, "-")
+		}
+	}
+	return nil
+}
+
+// download saves the downloaded file in the output dir, which is only saved if the checksum is verified.
+func download(client *bifrost_cliv1.Client, servername string, logNames []string) error {
+	saved, err := client.WebServerLogWatcher().SaveLog(&v1.WebServerLogDownloadRequest{
+		ServerName: &v1.ServerName{Name: servername},
+		LogNames:   logNames,
+		Archive:    *archive,
+	}, *outputDir)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s\t%d bytes\t%s\n", saved.Path, saved.Size, saved.Checksum)
+	return nil
+}
@@ -1,10 +1,17 @@
 package v1
 
 import (
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_certificate"
 	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_config"
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_linter"
 	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_log_watcher"
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_manager"
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_reconciler"
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_route_simulator"
 	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_statistics"
 	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_status"
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_template"
+	"github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1/web_server_upstream"
 	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
 )
 
@@ -13,6 +20,13 @@ type EndpointsFactory interface {
 	WebServerStatistics() WebServerStatisticsEndpoints
 	WebServerStatus() WebServerStatusEndpoints
 	WebServerLogWatcher() WebServerLogWatcherEndpoints
+	WebServerCertificate() WebServerCertificateEndpoints
+	WebServerLinter() WebServerLinterEndpoints
+	WebServerRouteSimulator() WebServerRouteSimulatorEndpoints
+	WebServerManager() WebServerManagerEndpoints
+	WebServerTemplate() WebServerTemplateEndpoints
+	WebServerReconciler() WebServerReconcilerEndpoints
+	WebServerUpstream() WebServerUpstreamEndpoints
 }
 
 var _ EndpointsFactory = &endpoints{}
@@ -39,3 +53,31 @@ func (e *endpoints) WebServerStatus() WebServerStatusEndpoints {
 func (e *endpoints) WebServerLogWatcher() WebServerLogWatcherEndpoints {
 	return web_server_log_watcher.NewWebServerLogWatcherEndpoints(e.svc)
 }
+
+func (e *endpoints) WebServerCertificate() WebServerCertificateEndpoints {
+	return web_server_certificate.NewWebServerCertificateEndpoints(e.svc)
+}
+
+func (e *endpoints) WebServerLinter() WebServerLinterEndpoints {
+	return web_server_linter.NewWebServerLinterEndpoints(e.svc)
+}
+
+func (e *endpoints) WebServerRouteSimulator() WebServerRouteSimulatorEndpoints {
+	return web_server_route_simulator.NewWebServerRouteSimulatorEndpoints(e.svc)
+}
+
+func (e *endpoints) WebServerManager() WebServerManagerEndpoints {
+	return web_server_manager.NewWebServerManagerEndpoints(e.svc)
+}
+
+func (e *endpoints) WebServerTemplate() WebServerTemplateEndpoints {
+	return web_server_template.NewWebServerTemplateEndpoints(e.svc)
+}
+
+func (e *endpoints) WebServerReconciler() WebServerReconcilerEndpoints {
+	return web_server_reconciler.NewWebServerReconcilerEndpoints(e.svc)
+}
+
+func (e *endpoints) WebServerUpstream() WebServerUpstreamEndpoints {
+	return web_server_upstream.NewWebServerUpstreamEndpoints(e.svc)
+}
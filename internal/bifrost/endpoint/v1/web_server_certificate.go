@@ -0,0 +1,8 @@
+package v1
+
+import "github.com/go-kit/kit/endpoint"
+
+type WebServerCertificateEndpoints interface {
+	EndpointGet() endpoint.Endpoint
+	EndpointWatchExpiry() endpoint.Endpoint
+}
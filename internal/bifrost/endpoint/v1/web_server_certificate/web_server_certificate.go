@@ -0,0 +1,13 @@
+package web_server_certificate
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerCertificateEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerCertificateEndpoints(svc svcv1.ServiceFactory) *webServerCertificateEndpoints {
+	return &webServerCertificateEndpoints{svc: svc}
+}
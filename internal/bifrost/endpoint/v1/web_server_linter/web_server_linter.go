@@ -0,0 +1,13 @@
+package web_server_linter
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerLinterEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerLinterEndpoints(svc svcv1.ServiceFactory) *webServerLinterEndpoints {
+	return &webServerLinterEndpoints{svc: svc}
+}
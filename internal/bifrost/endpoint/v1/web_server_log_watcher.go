@@ -4,4 +4,8 @@ import "github.com/go-kit/kit/endpoint"
 
 type WebServerLogWatcherEndpoints interface {
 	EndpointWatch() endpoint.Endpoint
+	EndpointQueryLogs() endpoint.Endpoint
+	EndpointListLogs() endpoint.Endpoint
+	EndpointAlerts() endpoint.Endpoint
+	EndpointDownloadLog() endpoint.Endpoint
 }
@@ -0,0 +1,17 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerLogWatcherEndpoints) EndpointAlerts() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.WebServerLogAlertsRequest); ok {
+			return w.svc.WebServerLogWatcher().Alerts(ctx, req)
+		}
+		return nil, errors.Errorf("invalid log alerts request, need *v1.WebServerLogAlertsRequest, not %T", request)
+	}
+}
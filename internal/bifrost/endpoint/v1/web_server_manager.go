@@ -0,0 +1,11 @@
+package v1
+
+import "github.com/go-kit/kit/endpoint"
+
+type WebServerManagerEndpoints interface {
+	EndpointList() endpoint.Endpoint
+	EndpointRegister() endpoint.Endpoint
+	EndpointReconfigure() endpoint.Endpoint
+	EndpointUnregister() endpoint.Endpoint
+	EndpointGetStates() endpoint.Endpoint
+}
@@ -0,0 +1,34 @@
+package web_server_manager
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerManagerEndpoints) EndpointRegister() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.ManagedWebServer); ok {
+			err = w.svc.WebServerManager().Register(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "register success"}, nil
+		}
+		return nil, errors.Errorf("invalid register request, need *v1.ManagedWebServer, not %T", request)
+	}
+}
+
+func (w *webServerManagerEndpoints) EndpointReconfigure() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.ManagedWebServer); ok {
+			err = w.svc.WebServerManager().Reconfigure(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "reconfigure success"}, nil
+		}
+		return nil, errors.Errorf("invalid reconfigure request, need *v1.ManagedWebServer, not %T", request)
+	}
+}
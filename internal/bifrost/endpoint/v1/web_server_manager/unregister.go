@@ -0,0 +1,21 @@
+package web_server_manager
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerManagerEndpoints) EndpointUnregister() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.ServerName); ok {
+			err = w.svc.WebServerManager().Unregister(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "unregister success"}, nil
+		}
+		return nil, errors.Errorf("invalid unregister request, need *v1.ServerName, not %T", request)
+	}
+}
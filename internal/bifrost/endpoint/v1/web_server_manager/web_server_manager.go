@@ -0,0 +1,13 @@
+package web_server_manager
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerManagerEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerManagerEndpoints(svc svcv1.ServiceFactory) *webServerManagerEndpoints {
+	return &webServerManagerEndpoints{svc: svc}
+}
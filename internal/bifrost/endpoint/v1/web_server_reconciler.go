@@ -0,0 +1,8 @@
+package v1
+
+import "github.com/go-kit/kit/endpoint"
+
+type WebServerReconcilerEndpoints interface {
+	EndpointPlan() endpoint.Endpoint
+	EndpointApply() endpoint.Endpoint
+}
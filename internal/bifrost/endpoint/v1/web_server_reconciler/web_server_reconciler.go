@@ -0,0 +1,13 @@
+package web_server_reconciler
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerReconcilerEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerReconcilerEndpoints(svc svcv1.ServiceFactory) *webServerReconcilerEndpoints {
+	return &webServerReconcilerEndpoints{svc: svc}
+}
@@ -0,0 +1,7 @@
+package v1
+
+import "github.com/go-kit/kit/endpoint"
+
+type WebServerRouteSimulatorEndpoints interface {
+	EndpointSimulate() endpoint.Endpoint
+}
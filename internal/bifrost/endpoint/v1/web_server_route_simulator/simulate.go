@@ -0,0 +1,17 @@
+package web_server_route_simulator
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerRouteSimulatorEndpoints) EndpointSimulate() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.RouteRequest); ok {
+			return w.svc.WebServerRouteSimulator().Simulate(ctx, req)
+		}
+		return nil, errors.Errorf("invalid simulate request, need *v1.RouteRequest, not %T", request)
+	}
+}
@@ -0,0 +1,13 @@
+package web_server_route_simulator
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerRouteSimulatorEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerRouteSimulatorEndpoints(svc svcv1.ServiceFactory) *webServerRouteSimulatorEndpoints {
+	return &webServerRouteSimulatorEndpoints{svc: svc}
+}
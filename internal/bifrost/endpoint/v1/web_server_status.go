@@ -4,4 +4,5 @@ import "github.com/go-kit/kit/endpoint"
 
 type WebServerStatusEndpoints interface {
 	EndpointGet() endpoint.Endpoint
+	EndpointTrafficStats() endpoint.Endpoint
 }
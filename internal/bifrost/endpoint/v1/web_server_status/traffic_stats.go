@@ -0,0 +1,17 @@
+package web_server_status
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerStatusEndpoints) EndpointTrafficStats() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.WebServerTrafficStatsRequest); ok {
+			return w.svc.WebServerStatus().TrafficStats(ctx, req)
+		}
+		return nil, errors.Errorf("invalid traffic stats request, need *v1.WebServerTrafficStatsRequest, not %T", request)
+	}
+}
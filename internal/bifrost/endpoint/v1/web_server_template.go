@@ -0,0 +1,8 @@
+package v1
+
+import "github.com/go-kit/kit/endpoint"
+
+type WebServerTemplateEndpoints interface {
+	EndpointList() endpoint.Endpoint
+	EndpointApply() endpoint.Endpoint
+}
@@ -0,0 +1,17 @@
+package web_server_template
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerTemplateEndpoints) EndpointApply() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.WebServerTemplateRequest); ok {
+			return w.svc.WebServerTemplate().Apply(ctx, req)
+		}
+		return nil, errors.Errorf("invalid apply request, need *v1.WebServerTemplateRequest, not %T", request)
+	}
+}
@@ -0,0 +1,13 @@
+package web_server_template
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerTemplateEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerTemplateEndpoints(svc svcv1.ServiceFactory) *webServerTemplateEndpoints {
+	return &webServerTemplateEndpoints{svc: svc}
+}
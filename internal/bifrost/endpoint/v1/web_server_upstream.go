@@ -0,0 +1,11 @@
+package v1
+
+import "github.com/go-kit/kit/endpoint"
+
+type WebServerUpstreamEndpoints interface {
+	EndpointList() endpoint.Endpoint
+	EndpointAddMember() endpoint.Endpoint
+	EndpointRemoveMember() endpoint.Endpoint
+	EndpointSetWeight() endpoint.Endpoint
+	EndpointSetState() endpoint.Endpoint
+}
@@ -0,0 +1,60 @@
+package web_server_upstream
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/marmotedu/errors"
+)
+
+func (w *webServerUpstreamEndpoints) EndpointAddMember() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.UpstreamMemberRequest); ok {
+			err = w.svc.WebServerUpstream().AddMember(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "add member success"}, nil
+		}
+		return nil, errors.Errorf("invalid add member request, need *v1.UpstreamMemberRequest, not %T", request)
+	}
+}
+
+func (w *webServerUpstreamEndpoints) EndpointRemoveMember() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.UpstreamMemberRequest); ok {
+			err = w.svc.WebServerUpstream().RemoveMember(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "remove member success"}, nil
+		}
+		return nil, errors.Errorf("invalid remove member request, need *v1.UpstreamMemberRequest, not %T", request)
+	}
+}
+
+func (w *webServerUpstreamEndpoints) EndpointSetWeight() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.UpstreamMemberRequest); ok {
+			err = w.svc.WebServerUpstream().SetWeight(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "set weight success"}, nil
+		}
+		return nil, errors.Errorf("invalid set weight request, need *v1.UpstreamMemberRequest, not %T", request)
+	}
+}
+
+func (w *webServerUpstreamEndpoints) EndpointSetState() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		if req, ok := request.(*v1.UpstreamMemberRequest); ok {
+			err = w.svc.WebServerUpstream().SetState(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return &v1.Response{Message: "set state success"}, nil
+		}
+		return nil, errors.Errorf("invalid set state request, need *v1.UpstreamMemberRequest, not %T", request)
+	}
+}
@@ -0,0 +1,13 @@
+package web_server_upstream
+
+import (
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+)
+
+type webServerUpstreamEndpoints struct {
+	svc svcv1.ServiceFactory
+}
+
+func NewWebServerUpstreamEndpoints(svc svcv1.ServiceFactory) *webServerUpstreamEndpoints {
+	return &webServerUpstreamEndpoints{svc: svc}
+}
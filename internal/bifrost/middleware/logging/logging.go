@@ -31,6 +31,34 @@ func (l *loggingService) WebServerLogWatcher() svcv1.WebServerLogWatcherService
 	return newWebServerLogWatcherMiddleware(l.svc)
 }
 
+func (l *loggingService) WebServerCertificate() svcv1.WebServerCertificateService {
+	return newWebServerCertificateMiddleware(l.svc)
+}
+
+func (l *loggingService) WebServerLinter() svcv1.WebServerLinterService {
+	return newWebServerLinterMiddleware(l.svc)
+}
+
+func (l *loggingService) WebServerRouteSimulator() svcv1.WebServerRouteSimulatorService {
+	return newWebServerRouteSimulatorMiddleware(l.svc)
+}
+
+func (l *loggingService) WebServerManager() svcv1.WebServerManagerService {
+	return newWebServerManagerMiddleware(l.svc)
+}
+
+func (l *loggingService) WebServerTemplate() svcv1.WebServerTemplateService {
+	return newWebServerTemplateMiddleware(l.svc)
+}
+
+func (l *loggingService) WebServerReconciler() svcv1.WebServerReconcilerService {
+	return newWebServerReconcilerMiddleware(l.svc)
+}
+
+func (l *loggingService) WebServerUpstream() svcv1.WebServerUpstreamService {
+	return newWebServerUpstreamMiddleware(l.svc)
+}
+
 func New(svc svcv1.ServiceFactory) svcv1.ServiceFactory {
 	once.Do(func() {
 		logger = log.K()
@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerCertificateService struct {
+	svc svcv1.WebServerCertificateService
+}
+
+func (l *loggingWebServerCertificateService) Get(ctx context.Context, servername *v1.ServerName) (certs *v1.Certificates, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Get)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", servername.Name,
+		)
+		if certs != nil {
+			logF.SetResult(fmt.Sprintf("%d certificate(s) inspected", len(certs.List)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Get(ctx, servername)
+}
+
+func (l *loggingWebServerCertificateService) WatchExpiry(ctx context.Context, request *v1.WebServerCertificateWatchRequest) (warnings *v1.WebServerCertificateWarnings, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.WatchExpiry)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if request.ServerName != nil {
+			logF.AddInfos(
+				"request server name", request.ServerName.Name,
+			)
+		}
+		if warnings != nil {
+			logF.SetResult("Watching web server certificate expiry...")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.WatchExpiry(ctx, request)
+}
+
+func newWebServerCertificateMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerCertificateService {
+	return &loggingWebServerCertificateService{svc: svc.WebServerCertificate()}
+}
@@ -0,0 +1,33 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerLinterService struct {
+	svc svcv1.WebServerLinterService
+}
+
+func (l *loggingWebServerLinterService) Lint(ctx context.Context, servername *v1.ServerName) (report *v1.LintReport, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Lint)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", servername.Name,
+		)
+		if report != nil {
+			logF.SetResult(fmt.Sprintf("%d lint issue(s) found", len(report.Issues)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Lint(ctx, servername)
+}
+
+func newWebServerLinterMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerLinterService {
+	return &loggingWebServerLinterService{svc: svc.WebServerLinter()}
+}
@@ -2,6 +2,8 @@ package logging
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
 	"time"
@@ -24,6 +26,79 @@ func (l *loggingWebServerLogWatcherService) Watch(ctx context.Context, request *
 	return l.svc.Watch(ctx, request)
 }
 
+func (l *loggingWebServerLogWatcherService) QueryLogs(ctx context.Context, request *v1.WebServerLogQueryRequest) (wslog *v1.WebServerLog, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.QueryLogs)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if wslog != nil {
+			logF.SetResult("Querying web server log...")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.QueryLogs(ctx, request)
+}
+
+func (l *loggingWebServerLogWatcherService) ListLogs(ctx context.Context, servername *v1.ServerName) (logs *v1.LogFiles, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.ListLogs)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", servername.Name,
+		)
+		if logs != nil {
+			result, _ := json.Marshal(logs)
+			logF.SetResult(getLimitResult(result))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.ListLogs(ctx, servername)
+}
+
+func (l *loggingWebServerLogWatcherService) Alerts(ctx context.Context, request *v1.WebServerLogAlertsRequest) (alerts *v1.WebServerLogAlerts, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Alerts)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if request.ServerName != nil {
+			logF.AddInfos(
+				"request server name", request.ServerName.Name,
+			)
+		}
+		logF.AddInfos(
+			"request rule", request.Rule,
+		)
+		if alerts != nil {
+			logF.SetResult("Watching web server log alerts...")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Alerts(ctx, request)
+}
+
+func (l *loggingWebServerLogWatcherService) DownloadLog(ctx context.Context, request *v1.WebServerLogDownloadRequest) (download *v1.WebServerLogDownload, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.DownloadLog)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if request.ServerName != nil {
+			logF.AddInfos(
+				"request server name", request.ServerName.Name,
+			)
+		}
+		logF.AddInfos(
+			"request log names", request.LogNames,
+			"request archive", request.Archive,
+		)
+		if download != nil {
+			logF.SetResult(fmt.Sprintf("Downloading web server log file '%s'...", download.FileName))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.DownloadLog(ctx, request)
+}
+
 func newWebServerLogWatcherMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerLogWatcherService {
 	return &loggingWebServerLogWatcherService{svc: svc.WebServerLogWatcher()}
 }
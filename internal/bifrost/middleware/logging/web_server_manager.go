@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerManagerService struct {
+	svc svcv1.WebServerManagerService
+}
+
+func (l *loggingWebServerManagerService) List(ctx context.Context) (servers *v1.ManagedWebServers, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.List)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if servers != nil {
+			logF.SetResult(fmt.Sprintf("%d web server(s) managed", len(servers.Servers)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.List(ctx)
+}
+
+func (l *loggingWebServerManagerService) Register(ctx context.Context, server *v1.ManagedWebServer) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Register)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", server.ServerName,
+			"request config path", server.ConfigPath,
+		)
+		if err == nil {
+			logF.SetResult("register web server succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Register(ctx, server)
+}
+
+func (l *loggingWebServerManagerService) Reconfigure(ctx context.Context, server *v1.ManagedWebServer) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Reconfigure)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", server.ServerName,
+			"request config path", server.ConfigPath,
+		)
+		if err == nil {
+			logF.SetResult("reconfigure web server succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Reconfigure(ctx, server)
+}
+
+func (l *loggingWebServerManagerService) Unregister(ctx context.Context, servername *v1.ServerName) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Unregister)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", servername.Name,
+		)
+		if err == nil {
+			logF.SetResult("unregister web server succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Unregister(ctx, servername)
+}
+
+func (l *loggingWebServerManagerService) GetStates(ctx context.Context) (states *v1.ConfigManagerStates, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.GetStates)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if states != nil {
+			logF.SetResult(fmt.Sprintf("%d config manager state(s) got", len(states.States)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.GetStates(ctx)
+}
+
+func newWebServerManagerMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerManagerService {
+	return &loggingWebServerManagerService{svc: svc.WebServerManager()}
+}
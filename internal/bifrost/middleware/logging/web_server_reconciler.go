@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerReconcilerService struct {
+	svc svcv1.WebServerReconcilerService
+}
+
+func (l *loggingWebServerReconcilerService) Plan(ctx context.Context) (plans *v1.ReconcilePlans, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Plan)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if plans != nil {
+			logF.SetResult(fmt.Sprintf("%d change(s) planned", countReconcileChanges(plans)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Plan(ctx)
+}
+
+func (l *loggingWebServerReconcilerService) Apply(ctx context.Context) (plans *v1.ReconcilePlans, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Apply)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if plans != nil {
+			logF.SetResult(fmt.Sprintf("%d change(s) applied", countReconcileChanges(plans)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Apply(ctx)
+}
+
+func countReconcileChanges(plans *v1.ReconcilePlans) int {
+	count := 0
+	for _, plan := range plans.Plans {
+		count += len(plan.Changes)
+	}
+	return count
+}
+
+func newWebServerReconcilerMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerReconcilerService {
+	return &loggingWebServerReconcilerService{svc: svc.WebServerReconciler()}
+}
@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerRouteSimulatorService struct {
+	svc svcv1.WebServerRouteSimulatorService
+}
+
+func (l *loggingWebServerRouteSimulatorService) Simulate(ctx context.Context, request *v1.RouteRequest) (result *v1.RouteResult, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Simulate)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", request.ServerName.Name,
+			"request host", request.Host,
+			"request port", request.Port,
+			"request scheme", request.Scheme,
+			"request uri", request.URI,
+		)
+		if result != nil && result.Server != "" {
+			logF.SetResult(fmt.Sprintf("handled by %s %s", result.Server, result.Location))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Simulate(ctx, request)
+}
+
+func newWebServerRouteSimulatorMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerRouteSimulatorService {
+	return &loggingWebServerRouteSimulatorService{svc: svc.WebServerRouteSimulator()}
+}
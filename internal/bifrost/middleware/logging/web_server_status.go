@@ -26,6 +26,24 @@ func (l *loggingWebServerStatusService) Get(ctx context.Context) (metrics *v1.Me
 	return l.svc.Get(ctx)
 }
 
+func (l *loggingWebServerStatusService) TrafficStats(ctx context.Context, request *v1.WebServerTrafficStatsRequest) (stats *v1.WebServerTrafficStats, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.TrafficStats)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if request.ServerName != nil {
+			logF.AddInfos(
+				"request server name", request.ServerName.Name,
+			)
+		}
+		if stats != nil {
+			logF.SetResult("Watching web server traffic stats...")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.TrafficStats(ctx, request)
+}
+
 func newWebServerStatusMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerStatusService {
 	return &loggingWebServerStatusService{svc: svc.WebServerStatus()}
 }
@@ -0,0 +1,49 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerTemplateService struct {
+	svc svcv1.WebServerTemplateService
+}
+
+func (l *loggingWebServerTemplateService) List(ctx context.Context) (templates *v1.WebServerTemplates, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.List)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		if templates != nil {
+			logF.SetResult(fmt.Sprintf("%d template(s) listed", len(templates.Templates)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.List(ctx)
+}
+
+func (l *loggingWebServerTemplateService) Apply(ctx context.Context, request *v1.WebServerTemplateRequest) (result *v1.WebServerTemplateResult, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.Apply)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", request.ServerName,
+			"request template name", request.TemplateName,
+			"request target", request.Target,
+			"dry run", request.DryRun,
+		)
+		if result != nil {
+			logF.SetResult(fmt.Sprintf("template applied: %v", result.Applied))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.Apply(ctx, request)
+}
+
+func newWebServerTemplateMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerTemplateService {
+	return &loggingWebServerTemplateService{svc: svc.WebServerTemplate()}
+}
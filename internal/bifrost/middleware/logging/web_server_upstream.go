@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	svcv1 "github.com/ClessLi/bifrost/internal/bifrost/service/v1"
+	"time"
+)
+
+type loggingWebServerUpstreamService struct {
+	svc svcv1.WebServerUpstreamService
+}
+
+func (l *loggingWebServerUpstreamService) List(ctx context.Context, servername *v1.ServerName) (upstreams *v1.Upstreams, err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.List)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logF.AddInfos(
+			"request server name", servername.Name,
+		)
+		if upstreams != nil {
+			logF.SetResult(fmt.Sprintf("%d upstream(s) listed", len(upstreams.Upstreams)))
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.List(ctx, servername)
+}
+
+func (l *loggingWebServerUpstreamService) AddMember(ctx context.Context, request *v1.UpstreamMemberRequest) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.AddMember)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logUpstreamMemberRequest(logF, request)
+		if err == nil {
+			logF.SetResult("add member of upstream succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.AddMember(ctx, request)
+}
+
+func (l *loggingWebServerUpstreamService) RemoveMember(ctx context.Context, request *v1.UpstreamMemberRequest) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.RemoveMember)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logUpstreamMemberRequest(logF, request)
+		if err == nil {
+			logF.SetResult("remove member of upstream succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.RemoveMember(ctx, request)
+}
+
+func (l *loggingWebServerUpstreamService) SetWeight(ctx context.Context, request *v1.UpstreamMemberRequest) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.SetWeight)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logUpstreamMemberRequest(logF, request)
+		if err == nil {
+			logF.SetResult("set weight of upstream succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.SetWeight(ctx, request)
+}
+
+func (l *loggingWebServerUpstreamService) SetState(ctx context.Context, request *v1.UpstreamMemberRequest) (err error) {
+	defer func(begin time.Time) {
+		logF := newLogFormatter(ctx, l.svc.SetState)
+		logF.SetBeginTime(begin)
+		defer logF.Result()
+		logUpstreamMemberRequest(logF, request)
+		if err == nil {
+			logF.SetResult("set state of upstream succeeded")
+		}
+		logF.SetErr(err)
+	}(time.Now().Local())
+	return l.svc.SetState(ctx, request)
+}
+
+func logUpstreamMemberRequest(logF *logFormatter, request *v1.UpstreamMemberRequest) {
+	logF.AddInfos(
+		"request server name", request.ServerName,
+		"request upstream", request.Upstream,
+		"reload", request.Reload,
+	)
+	if request.Member != nil {
+		logF.AddInfos(
+			"request member address", request.Member.Address,
+			"request member weight", request.Member.Weight,
+			"request member state", request.Member.State,
+		)
+	}
+}
+
+func newWebServerUpstreamMiddleware(svc svcv1.ServiceFactory) svcv1.WebServerUpstreamService {
+	return &loggingWebServerUpstreamService{svc: svc.WebServerUpstream()}
+}
@@ -9,28 +9,38 @@ import (
 )
 
 type Options struct {
-	GenericServerRunOptions    *genericoptions.ServerRunOptions           `json:"server" mapstructure:"server"`
-	SecureServing              *genericoptions.SecureServingOptions       `json:"secure" mapstructure:"secure"`
-	InsecureServing            *genericoptions.InsecureServingOptions     `json:"insecure" mapstructure:"insecure"`
-	RAOptions                  *genericoptions.RAOptions                  `json:"ra" mapstructure:"ra"`
-	GRPCServing                *genericoptions.GRPCServerOptions          `json:"grpc" mapstructure:"grpc"`
-	WebServerConfigsOptions    *genericoptions.WebServerConfigsOptions    `json:"web-server-configs" mapstructure:"web-server-configs"`
-	MonitorOptions             *genericoptions.MonitorOptions             `json:"monitor" mapstructure:"monitor"`
-	WebServerLogWatcherOptions *genericoptions.WebServerLogWatcherOptions `json:"web-server-log-watcher" mapstructure:"web-server-log-watcher"`
-	Log                        *log.Options                               `json:"log" mapstructure:"log"`
+	GenericServerRunOptions     *genericoptions.ServerRunOptions            `json:"server" mapstructure:"server"`
+	SecureServing               *genericoptions.SecureServingOptions        `json:"secure" mapstructure:"secure"`
+	InsecureServing             *genericoptions.InsecureServingOptions      `json:"insecure" mapstructure:"insecure"`
+	RAOptions                   *genericoptions.RAOptions                   `json:"ra" mapstructure:"ra"`
+	GRPCServing                 *genericoptions.GRPCServerOptions           `json:"grpc" mapstructure:"grpc"`
+	WebServerConfigsOptions     *genericoptions.WebServerConfigsOptions     `json:"web-server-configs" mapstructure:"web-server-configs"`
+	MonitorOptions              *genericoptions.MonitorOptions              `json:"monitor" mapstructure:"monitor"`
+	WebServerLogWatcherOptions  *genericoptions.WebServerLogWatcherOptions  `json:"web-server-log-watcher" mapstructure:"web-server-log-watcher"`
+	WebServerCertificateOptions *genericoptions.WebServerCertificateOptions `json:"web-server-certificate" mapstructure:"web-server-certificate"`
+	WebServerTemplateOptions    *genericoptions.WebServerTemplateOptions    `json:"web-server-template" mapstructure:"web-server-template"`
+	WebServerReconcilerOptions  *genericoptions.WebServerReconcilerOptions  `json:"web-server-reconciler" mapstructure:"web-server-reconciler"`
+	WebServerTrafficOptions     *genericoptions.WebServerTrafficOptions     `json:"web-server-traffic" mapstructure:"web-server-traffic"`
+	WebServerLogAlertOptions    *genericoptions.WebServerLogAlertOptions    `json:"web-server-log-alert" mapstructure:"web-server-log-alert"`
+	Log                         *log.Options                                `json:"log" mapstructure:"log"`
 }
 
 func NewOptions() *Options {
 	return &Options{
-		GenericServerRunOptions:    genericoptions.NewServerRunOptions(),
-		SecureServing:              genericoptions.NewSecureServingOptions(),
-		InsecureServing:            genericoptions.NewInsecureServingOptions(),
-		RAOptions:                  genericoptions.NewRAOptions(),
-		GRPCServing:                genericoptions.NewGRPCServerOptions(),
-		WebServerConfigsOptions:    genericoptions.NewWebServerConfigsOptions(),
-		MonitorOptions:             genericoptions.NewMonitorOptions(),
-		WebServerLogWatcherOptions: genericoptions.NewWebServerLogWatcherOptions(),
-		Log:                        log.NewOptions(),
+		GenericServerRunOptions:     genericoptions.NewServerRunOptions(),
+		SecureServing:               genericoptions.NewSecureServingOptions(),
+		InsecureServing:             genericoptions.NewInsecureServingOptions(),
+		RAOptions:                   genericoptions.NewRAOptions(),
+		GRPCServing:                 genericoptions.NewGRPCServerOptions(),
+		WebServerConfigsOptions:     genericoptions.NewWebServerConfigsOptions(),
+		MonitorOptions:              genericoptions.NewMonitorOptions(),
+		WebServerLogWatcherOptions:  genericoptions.NewWebServerLogWatcherOptions(),
+		WebServerCertificateOptions: genericoptions.NewWebServerCertificateOptions(),
+		WebServerTemplateOptions:    genericoptions.NewWebServerTemplateOptions(),
+		WebServerReconcilerOptions:  genericoptions.NewWebServerReconcilerOptions(),
+		WebServerTrafficOptions:     genericoptions.NewWebServerTrafficOptions(),
+		WebServerLogAlertOptions:    genericoptions.NewWebServerLogAlertOptions(),
+		Log:                         log.NewOptions(),
 	}
 }
 
@@ -40,8 +50,14 @@ func (o *Options) Flags() (fss cliflag.NamedFlagSets) {
 	o.InsecureServing.AddFlags(fss.FlagSet("insecure serving"))
 	o.RAOptions.AddFlags(fss.FlagSet("RA options"))
 	o.GRPCServing.AddFlags(fss.FlagSet("gRPC serving"))
+	o.WebServerConfigsOptions.AddFlags(fss.FlagSet("web server configs"))
 	o.MonitorOptions.AddFlags(fss.FlagSet("monitor"))
 	o.WebServerLogWatcherOptions.AddFlags(fss.FlagSet("log watcher"))
+	o.WebServerCertificateOptions.AddFlags(fss.FlagSet("certificate"))
+	o.WebServerTemplateOptions.AddFlags(fss.FlagSet("template"))
+	o.WebServerReconcilerOptions.AddFlags(fss.FlagSet("reconciler"))
+	o.WebServerTrafficOptions.AddFlags(fss.FlagSet("traffic"))
+	o.WebServerLogAlertOptions.AddFlags(fss.FlagSet("log alert"))
 	o.Log.AddFlags(fss.FlagSet("log"))
 	return fss
 }
@@ -10,6 +10,11 @@ func (o *Options) Validate() []error {
 	errors = append(errors, o.WebServerConfigsOptions.Validate()...)
 	errors = append(errors, o.MonitorOptions.Validate()...)
 	errors = append(errors, o.WebServerLogWatcherOptions.Validate()...)
+	errors = append(errors, o.WebServerCertificateOptions.Validate()...)
+	errors = append(errors, o.WebServerTemplateOptions.Validate()...)
+	errors = append(errors, o.WebServerReconcilerOptions.Validate()...)
+	errors = append(errors, o.WebServerTrafficOptions.Validate()...)
+	errors = append(errors, o.WebServerLogAlertOptions.Validate()...)
 	errors = append(errors, o.Log.Validate()...)
 
 	return errors
@@ -0,0 +1,128 @@
+package bifrost
+
+import (
+	"github.com/ClessLi/bifrost/internal/bifrost/options"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	genericgrpcserver "github.com/ClessLi/bifrost/internal/pkg/server"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/marmotedu/errors"
+	"github.com/spf13/viper"
+	"reflect"
+	"strings"
+)
+
+// optionsReloader reloads the configuration file on SIGHUP. The changes of the web server configs, monitor and log
+// watcher options are applied to the running store, the other changed options are reported as they need a restart.
+// The changes of the web server configs are ignored and reported, if the web servers are persisted in the state file.
+type optionsReloader struct {
+	running *options.Options
+}
+
+func (r *optionsReloader) run(stop <-chan struct{}) {
+	reload := genericgrpcserver.SetupReloadSignalHandler(stop)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-reload:
+			if err := r.reload(); err != nil {
+				log.Errorf("failed to reload the configuration file `%s`, err: %+v", viper.ConfigFileUsed(), err)
+			}
+		}
+	}
+}
+
+func (r *optionsReloader) reload() error {
+	log.Infof("reloading the configuration file `%s`...", viper.ConfigFileUsed())
+	if err := viper.ReadInConfig(); err != nil {
+		return err
+	}
+	opts := options.NewOptions()
+	if err := viper.Unmarshal(opts); err != nil {
+		return err
+	}
+	if err := opts.Complete(); err != nil {
+		return err
+	}
+	if errs := opts.Validate(); len(errs) != 0 {
+		return errors.NewAggregate(errs)
+	}
+
+	for _, name := range restartRequiredOptions(r.running, opts) {
+		log.Warnf("the option `%s` is changed, which takes effect after restarting bifrost", name)
+	}
+
+	storeIns := storev1.Client()
+	var errs []error
+	if strings.TrimSpace(r.running.WebServerConfigsOptions.StateFile) != "" {
+		// the web servers are only changed by the web server manager, when they are persisted in the state file
+		added, changed, removed := r.running.WebServerConfigsOptions.Diff(opts.WebServerConfigsOptions)
+		for _, servername := range append(append(added, changed...), removed...) {
+			log.Warnf("the change of web server '%s' in `web-server-configs.items` is ignored, since the web servers are persisted in the state file '%s', use the web server manager instead",
+				servername, r.running.WebServerConfigsOptions.StateFile)
+		}
+		r.running.WebServerConfigsOptions.WebServerConfigs = opts.WebServerConfigsOptions.WebServerConfigs
+	} else if !reflect.DeepEqual(r.running.WebServerConfigsOptions.WebServerConfigs, opts.WebServerConfigsOptions.WebServerConfigs) {
+		// the web servers failed to be applied are retried by the next reload
+		if err := storeIns.ReloadWebServerConfigs(r.running.WebServerConfigsOptions, opts.WebServerConfigsOptions); err != nil {
+			errs = append(errs, err)
+		} else {
+			r.running.WebServerConfigsOptions.WebServerConfigs = opts.WebServerConfigsOptions.WebServerConfigs
+			log.Info("the option `web-server-configs.items` is reloaded")
+		}
+	}
+	if !reflect.DeepEqual(r.running.MonitorOptions, opts.MonitorOptions) {
+		if err := storeIns.ReloadMonitor(opts.MonitorOptions); err != nil {
+			errs = append(errs, err)
+		} else {
+			r.running.MonitorOptions = opts.MonitorOptions
+			log.Info("the option `monitor` is reloaded")
+		}
+	}
+	if !reflect.DeepEqual(r.running.WebServerLogWatcherOptions, opts.WebServerLogWatcherOptions) {
+		if err := storeIns.ReloadWebServerLogWatcher(opts.WebServerLogWatcherOptions); err != nil {
+			errs = append(errs, err)
+		} else {
+			r.running.WebServerLogWatcherOptions = opts.WebServerLogWatcherOptions
+			log.Info("the option `web-server-log-watcher` is reloaded")
+		}
+	}
+
+	return errors.NewAggregate(errs)
+}
+
+func newOptionsReloader(opts *options.Options) *optionsReloader {
+	// copy the options reloaded at runtime, so that the running options of the store are not changed by reloading
+	running := *opts
+	webSvrConfigsOpts := *opts.WebServerConfigsOptions
+	running.WebServerConfigsOptions = &webSvrConfigsOpts
+	return &optionsReloader{running: &running}
+}
+
+// restartRequiredOptions returns the names of the changed options, which can not be applied without a restart.
+func restartRequiredOptions(running, reloaded *options.Options) []string {
+	candidates := []struct {
+		name              string
+		running, reloaded interface{}
+	}{
+		{"server", running.GenericServerRunOptions, reloaded.GenericServerRunOptions},
+		{"secure", running.SecureServing, reloaded.SecureServing},
+		{"insecure", running.InsecureServing, reloaded.InsecureServing},
+		{"ra", running.RAOptions, reloaded.RAOptions},
+		{"grpc", running.GRPCServing, reloaded.GRPCServing},
+		{"web-server-configs.state-file", running.WebServerConfigsOptions.StateFile, reloaded.WebServerConfigsOptions.StateFile},
+		{"web-server-certificate", running.WebServerCertificateOptions, reloaded.WebServerCertificateOptions},
+		{"web-server-template", running.WebServerTemplateOptions, reloaded.WebServerTemplateOptions},
+		{"web-server-reconciler", running.WebServerReconcilerOptions, reloaded.WebServerReconcilerOptions},
+		{"web-server-traffic", running.WebServerTrafficOptions, reloaded.WebServerTrafficOptions},
+		{"web-server-log-alert", running.WebServerLogAlertOptions, reloaded.WebServerLogAlertOptions},
+		{"log", running.Log, reloaded.Log},
+	}
+	changed := make([]string, 0)
+	for _, candidate := range candidates {
+		if !reflect.DeepEqual(candidate.running, candidate.reloaded) {
+			changed = append(changed, candidate.name)
+		}
+	}
+	return changed
+}
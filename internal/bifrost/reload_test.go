@@ -0,0 +1,59 @@
+package bifrost
+
+import (
+	"github.com/ClessLi/bifrost/internal/bifrost/options"
+	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRestartRequiredOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(opts *options.Options)
+		want   []string
+	}{
+		{
+			name:   "not changed",
+			change: func(opts *options.Options) {},
+			want:   []string{},
+		},
+		{
+			name: "reloadable options",
+			change: func(opts *options.Options) {
+				opts.WebServerConfigsOptions.WebServerConfigs = append(opts.WebServerConfigsOptions.WebServerConfigs, genericoptions.NewWebServerConfigOptions())
+				opts.MonitorOptions.SyncInterval = time.Minute
+				opts.WebServerLogWatcherOptions.MaxConnections++
+			},
+			want: []string{},
+		},
+		{
+			name: "restart required options",
+			change: func(opts *options.Options) {
+				opts.GRPCServing.ChunkSize++
+				opts.WebServerConfigsOptions.StateFile = "state.json"
+				opts.WebServerTrafficOptions.Enabled = !opts.WebServerTrafficOptions.Enabled
+				opts.Log.Level = "debug"
+			},
+			want: []string{"grpc", "web-server-configs.state-file", "web-server-traffic", "log"},
+		},
+		{
+			name: "restart required and reloadable options",
+			change: func(opts *options.Options) {
+				opts.MonitorOptions.SyncInterval = time.Minute
+				opts.WebServerTemplateOptions.Dir = "templates"
+			},
+			want: []string{"web-server-template"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			running, reloaded := options.NewOptions(), options.NewOptions()
+			tt.change(reloaded)
+			if got := restartRequiredOptions(running, reloaded); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("restartRequiredOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
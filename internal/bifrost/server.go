@@ -18,6 +18,12 @@ type bifrostServer struct {
 	webSvrConfigsOpts    *genericoptions.WebServerConfigsOptions
 	monitorOpts          *genericoptions.MonitorOptions
 	webSvrLogWatcherOpts *genericoptions.WebServerLogWatcherOptions
+	webSvrCertOpts       *genericoptions.WebServerCertificateOptions
+	webSvrTemplateOpts   *genericoptions.WebServerTemplateOptions
+	webSvrReconcilerOpts *genericoptions.WebServerReconcilerOptions
+	webSvrTrafficOpts    *genericoptions.WebServerTrafficOptions
+	webSvrLogAlertOpts   *genericoptions.WebServerLogAlertOptions
+	reloader             *optionsReloader
 }
 
 type preparedBifrostServer struct {
@@ -45,6 +51,12 @@ func createBifrostServer(cfg *config.Config) (*bifrostServer, error) {
 		webSvrConfigsOpts:    cfg.WebServerConfigsOptions,
 		monitorOpts:          cfg.MonitorOptions,
 		webSvrLogWatcherOpts: cfg.WebServerLogWatcherOptions,
+		webSvrCertOpts:       cfg.WebServerCertificateOptions,
+		webSvrTemplateOpts:   cfg.WebServerTemplateOptions,
+		webSvrReconcilerOpts: cfg.WebServerReconcilerOptions,
+		webSvrTrafficOpts:    cfg.WebServerTrafficOptions,
+		webSvrLogAlertOpts:   cfg.WebServerLogAlertOptions,
+		reloader:             newOptionsReloader(cfg.Options),
 	}
 
 	return server, nil
@@ -55,7 +67,10 @@ func (b *bifrostServer) PrepareRun() preparedBifrostServer {
 	log.Debug("prepare run...")
 	b.initStore()
 	initRouter(b.genericGRPCServer)
+	stopReload := make(chan struct{})
+	go b.reloader.run(stopReload)
 	b.gs.AddShutdownCallback(shutdown.ShutdownFunc(func(string) error {
+		close(stopReload)
 		var err error
 		storeIns := storev1.Client()
 		if storeIns != nil {
@@ -79,7 +94,7 @@ func (p preparedBifrostServer) Run() error {
 
 func (b *bifrostServer) initStore() {
 	log.Debug("bifrost server init store...")
-	storeIns, err := storev1nginx.GetNginxStoreFactory(b.webSvrConfigsOpts, b.monitorOpts, b.webSvrLogWatcherOpts)
+	storeIns, err := storev1nginx.GetNginxStoreFactory(b.webSvrConfigsOpts, b.monitorOpts, b.webSvrLogWatcherOpts, b.webSvrCertOpts, b.webSvrTemplateOpts, b.webSvrReconcilerOpts, b.webSvrTrafficOpts, b.webSvrLogAlertOpts)
 	if err != nil {
 		log.Fatalf("init nginx store failed: %+v", err)
 	}
@@ -1,10 +1,17 @@
 package v1
 
 import (
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_certificate"
 	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_config"
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_linter"
 	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_log_watcher"
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_manager"
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_reconciler"
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_route_simulator"
 	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_statistics"
 	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_status"
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_template"
+	"github.com/ClessLi/bifrost/internal/bifrost/service/v1/web_server_upstream"
 	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
 )
 
@@ -13,6 +20,13 @@ type ServiceFactory interface {
 	WebServerStatistics() WebServerStatisticsService
 	WebServerStatus() WebServerStatusService
 	WebServerLogWatcher() WebServerLogWatcherService
+	WebServerCertificate() WebServerCertificateService
+	WebServerLinter() WebServerLinterService
+	WebServerRouteSimulator() WebServerRouteSimulatorService
+	WebServerManager() WebServerManagerService
+	WebServerTemplate() WebServerTemplateService
+	WebServerReconciler() WebServerReconcilerService
+	WebServerUpstream() WebServerUpstreamService
 }
 
 var _ ServiceFactory = &serviceFactory{}
@@ -37,6 +51,34 @@ func (s *serviceFactory) WebServerLogWatcher() WebServerLogWatcherService {
 	return web_server_log_watcher.NewWebServerLogWatcherService(s.store)
 }
 
+func (s *serviceFactory) WebServerCertificate() WebServerCertificateService {
+	return web_server_certificate.NewWebServerCertificateService(s.store)
+}
+
+func (s *serviceFactory) WebServerLinter() WebServerLinterService {
+	return web_server_linter.NewWebServerLinterService(s.store)
+}
+
+func (s *serviceFactory) WebServerRouteSimulator() WebServerRouteSimulatorService {
+	return web_server_route_simulator.NewWebServerRouteSimulatorService(s.store)
+}
+
+func (s *serviceFactory) WebServerManager() WebServerManagerService {
+	return web_server_manager.NewWebServerManagerService(s.store)
+}
+
+func (s *serviceFactory) WebServerTemplate() WebServerTemplateService {
+	return web_server_template.NewWebServerTemplateService(s.store)
+}
+
+func (s *serviceFactory) WebServerReconciler() WebServerReconcilerService {
+	return web_server_reconciler.NewWebServerReconcilerService(s.store)
+}
+
+func (s *serviceFactory) WebServerUpstream() WebServerUpstreamService {
+	return web_server_upstream.NewWebServerUpstreamService(s.store)
+}
+
 func NewServiceFactory(store storev1.StoreFactory) ServiceFactory {
 	return &serviceFactory{store: store}
 }
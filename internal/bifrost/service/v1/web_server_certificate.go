@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerCertificateService interface {
+	Get(ctx context.Context, servername *v1.ServerName) (*v1.Certificates, error)
+	WatchExpiry(ctx context.Context, request *v1.WebServerCertificateWatchRequest) (*v1.WebServerCertificateWarnings, error)
+}
@@ -0,0 +1,10 @@
+package web_server_certificate
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerCertificateService) Get(ctx context.Context, servername *v1.ServerName) (*v1.Certificates, error) {
+	return w.store.WebServerCertificate().Get(ctx, servername)
+}
@@ -0,0 +1,10 @@
+package web_server_certificate
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerCertificateService) WatchExpiry(ctx context.Context, request *v1.WebServerCertificateWatchRequest) (*v1.WebServerCertificateWarnings, error) {
+	return w.store.WebServerCertificate().WatchExpiry(ctx, request)
+}
@@ -0,0 +1,11 @@
+package web_server_certificate
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerCertificateService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerCertificateService(store storev1.StoreFactory) *webServerCertificateService {
+	return &webServerCertificateService{store: store}
+}
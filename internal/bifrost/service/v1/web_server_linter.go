@@ -0,0 +1,10 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerLinterService interface {
+	Lint(ctx context.Context, servername *v1.ServerName) (*v1.LintReport, error)
+}
@@ -0,0 +1,10 @@
+package web_server_linter
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerLinterService) Lint(ctx context.Context, servername *v1.ServerName) (*v1.LintReport, error) {
+	return w.store.WebServerLinter().Lint(ctx, servername)
+}
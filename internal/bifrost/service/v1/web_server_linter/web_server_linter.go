@@ -0,0 +1,11 @@
+package web_server_linter
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerLinterService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerLinterService(store storev1.StoreFactory) *webServerLinterService {
+	return &webServerLinterService{store: store}
+}
@@ -7,4 +7,8 @@ import (
 
 type WebServerLogWatcherService interface {
 	Watch(ctx context.Context, request *v1.WebServerLogWatchRequest) (*v1.WebServerLog, error)
+	QueryLogs(ctx context.Context, request *v1.WebServerLogQueryRequest) (*v1.WebServerLog, error)
+	ListLogs(ctx context.Context, servername *v1.ServerName) (*v1.LogFiles, error)
+	Alerts(ctx context.Context, request *v1.WebServerLogAlertsRequest) (*v1.WebServerLogAlerts, error)
+	DownloadLog(ctx context.Context, request *v1.WebServerLogDownloadRequest) (*v1.WebServerLogDownload, error)
 }
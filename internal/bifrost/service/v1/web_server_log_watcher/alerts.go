@@ -0,0 +1,10 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerLogWatcherService) Alerts(ctx context.Context, request *v1.WebServerLogAlertsRequest) (*v1.WebServerLogAlerts, error) {
+	return w.store.WebServerLogWatcher().Alerts(ctx, request)
+}
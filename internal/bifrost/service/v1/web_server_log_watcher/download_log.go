@@ -0,0 +1,10 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerLogWatcherService) DownloadLog(ctx context.Context, request *v1.WebServerLogDownloadRequest) (*v1.WebServerLogDownload, error) {
+	return w.store.WebServerLogWatcher().DownloadLog(ctx, request)
+}
@@ -0,0 +1,10 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerLogWatcherService) ListLogs(ctx context.Context, servername *v1.ServerName) (*v1.LogFiles, error) {
+	return w.store.WebServerLogWatcher().ListLogs(ctx, servername)
+}
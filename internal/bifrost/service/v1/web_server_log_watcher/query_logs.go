@@ -0,0 +1,10 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerLogWatcherService) QueryLogs(ctx context.Context, request *v1.WebServerLogQueryRequest) (*v1.WebServerLog, error) {
+	return w.store.WebServerLogWatcher().QueryLogs(ctx, request)
+}
@@ -0,0 +1,10 @@
+package web_server_manager
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerManagerService) GetStates(ctx context.Context) (*v1.ConfigManagerStates, error) {
+	return w.store.WebServerManager().GetStates(ctx)
+}
@@ -0,0 +1,14 @@
+package web_server_manager
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerManagerService) Register(ctx context.Context, server *v1.ManagedWebServer) error {
+	return w.store.WebServerManager().Register(ctx, server)
+}
+
+func (w *webServerManagerService) Reconfigure(ctx context.Context, server *v1.ManagedWebServer) error {
+	return w.store.WebServerManager().Reconfigure(ctx, server)
+}
@@ -0,0 +1,10 @@
+package web_server_manager
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerManagerService) Unregister(ctx context.Context, servername *v1.ServerName) error {
+	return w.store.WebServerManager().Unregister(ctx, servername)
+}
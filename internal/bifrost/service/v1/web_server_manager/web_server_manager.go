@@ -0,0 +1,11 @@
+package web_server_manager
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerManagerService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerManagerService(store storev1.StoreFactory) *webServerManagerService {
+	return &webServerManagerService{store: store}
+}
@@ -0,0 +1,10 @@
+package web_server_reconciler
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerReconcilerService) Plan(ctx context.Context) (*v1.ReconcilePlans, error) {
+	return w.store.WebServerReconciler().Plan(ctx)
+}
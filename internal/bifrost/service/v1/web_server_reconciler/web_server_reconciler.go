@@ -0,0 +1,11 @@
+package web_server_reconciler
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerReconcilerService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerReconcilerService(store storev1.StoreFactory) *webServerReconcilerService {
+	return &webServerReconcilerService{store: store}
+}
@@ -0,0 +1,10 @@
+package web_server_route_simulator
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerRouteSimulatorService) Simulate(ctx context.Context, request *v1.RouteRequest) (*v1.RouteResult, error) {
+	return w.store.WebServerRouteSimulator().Simulate(ctx, request)
+}
@@ -0,0 +1,11 @@
+package web_server_route_simulator
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerRouteSimulatorService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerRouteSimulatorService(store storev1.StoreFactory) *webServerRouteSimulatorService {
+	return &webServerRouteSimulatorService{store: store}
+}
@@ -0,0 +1,10 @@
+package web_server_status
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerStatusService) TrafficStats(ctx context.Context, request *v1.WebServerTrafficStatsRequest) (*v1.WebServerTrafficStats, error) {
+	return w.store.WebServerStatus().TrafficStats(ctx, request)
+}
@@ -0,0 +1,10 @@
+package web_server_template
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerTemplateService) Apply(ctx context.Context, request *v1.WebServerTemplateRequest) (*v1.WebServerTemplateResult, error) {
+	return w.store.WebServerTemplate().Apply(ctx, request)
+}
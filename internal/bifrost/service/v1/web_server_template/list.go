@@ -0,0 +1,10 @@
+package web_server_template
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerTemplateService) List(ctx context.Context) (*v1.WebServerTemplates, error) {
+	return w.store.WebServerTemplate().List(ctx)
+}
@@ -0,0 +1,11 @@
+package web_server_template
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerTemplateService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerTemplateService(store storev1.StoreFactory) *webServerTemplateService {
+	return &webServerTemplateService{store: store}
+}
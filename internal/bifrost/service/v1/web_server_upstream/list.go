@@ -0,0 +1,10 @@
+package web_server_upstream
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerUpstreamService) List(ctx context.Context, servername *v1.ServerName) (*v1.Upstreams, error) {
+	return w.store.WebServerUpstream().List(ctx, servername)
+}
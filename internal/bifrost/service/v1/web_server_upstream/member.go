@@ -0,0 +1,22 @@
+package web_server_upstream
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+func (w *webServerUpstreamService) AddMember(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.store.WebServerUpstream().AddMember(ctx, request)
+}
+
+func (w *webServerUpstreamService) RemoveMember(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.store.WebServerUpstream().RemoveMember(ctx, request)
+}
+
+func (w *webServerUpstreamService) SetWeight(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.store.WebServerUpstream().SetWeight(ctx, request)
+}
+
+func (w *webServerUpstreamService) SetState(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.store.WebServerUpstream().SetState(ctx, request)
+}
@@ -0,0 +1,11 @@
+package web_server_upstream
+
+import storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+
+type webServerUpstreamService struct {
+	store storev1.StoreFactory
+}
+
+func NewWebServerUpstreamService(store storev1.StoreFactory) *webServerUpstreamService {
+	return &webServerUpstreamService{store: store}
+}
@@ -1,14 +1,19 @@
 package nginx
 
 import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
 	"github.com/ClessLi/bifrost/internal/pkg/file_watcher"
 	"github.com/ClessLi/bifrost/internal/pkg/monitor"
 	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
 	log "github.com/ClessLi/bifrost/pkg/log/v1"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/linter"
 	"github.com/marmotedu/errors"
 	"sync"
+	"time"
 )
 
 const (
@@ -16,10 +21,28 @@ const (
 )
 
 type webServerStore struct {
-	cms      nginx.ConfigsManager
-	m        monitor.Monitor
-	wm       *file_watcher.WatcherManager
-	logsDirs map[string]string
+	cms nginx.ConfigsManager
+	m   monitor.Monitor
+	wm  *file_watcher.WatcherManager
+
+	// the servers options, logs dirs and linters are replaced as a whole when a server is registered, reconfigured or
+	// unregistered at runtime, so that the maps got by the sub stores are never changed.
+	rwLocker   *sync.RWMutex
+	serverOpts map[string]*genericoptions.WebServerConfigOptions
+	logsDirs   map[string]string
+	linters    map[string]linter.Linter
+	stateFile  string
+
+	certExpiryWarning time.Duration
+	certCheckInterval time.Duration
+	templateDir       string
+	reconcilerDir     string
+	// stopReconciling stops the regular reconciling, if it is enabled
+	stopReconciling context.CancelFunc
+	// traffic collects the traffic stats from the access logs, it is nil if the traffic stats are disabled
+	traffic *trafficCollector
+	// alerter evaluates the log alert rules on the logs, it is nil if no log alert rule is defined
+	alerter *logAlerter
 }
 
 func (w *webServerStore) WebServerStatus() storev1.WebServerStatusStore {
@@ -38,10 +61,94 @@ func (w *webServerStore) WebServerLogWatcher() storev1.WebServerLogWatcher {
 	return newWebServerLogWatcherStore(w)
 }
 
+func (w *webServerStore) WebServerCertificate() storev1.WebServerCertificateStore {
+	return newWebServerCertificateStore(w)
+}
+
+func (w *webServerStore) WebServerLinter() storev1.WebServerLinterStore {
+	return newWebServerLinterStore(w)
+}
+
+func (w *webServerStore) WebServerRouteSimulator() storev1.WebServerRouteSimulatorStore {
+	return newWebServerRouteSimulatorStore(w)
+}
+
+func (w *webServerStore) WebServerManager() storev1.WebServerManagerStore {
+	return newWebServerManagerStore(w)
+}
+
+func (w *webServerStore) WebServerTemplate() storev1.WebServerTemplateStore {
+	return newWebServerTemplateStore(w)
+}
+
+func (w *webServerStore) WebServerReconciler() storev1.WebServerReconcilerStore {
+	return newWebServerReconcilerStore(w)
+}
+
+func (w *webServerStore) WebServerUpstream() storev1.WebServerUpstreamStore {
+	return newWebServerUpstreamStore(w)
+}
+
+func (w *webServerStore) serverOptions() map[string]*genericoptions.WebServerConfigOptions {
+	w.rwLocker.RLock()
+	defer w.rwLocker.RUnlock()
+	return w.serverOpts
+}
+
+func (w *webServerStore) serverLogsDirs() map[string]string {
+	w.rwLocker.RLock()
+	defer w.rwLocker.RUnlock()
+	return w.logsDirs
+}
+
+func (w *webServerStore) serverLinters() map[string]linter.Linter {
+	w.rwLocker.RLock()
+	defer w.rwLocker.RUnlock()
+	return w.linters
+}
+
+func (w *webServerStore) monitor() monitor.Monitor {
+	w.rwLocker.RLock()
+	defer w.rwLocker.RUnlock()
+	return w.m
+}
+
+func (w *webServerStore) systemInfo() monitor.SystemInfo {
+	return w.monitor().Report()
+}
+
+func (w *webServerStore) certificateInspectors() map[string]configuration.CertificateInspector {
+	inspectors := make(map[string]configuration.CertificateInspector)
+	for servername, config := range w.cms.GetConfigs() {
+		inspectors[servername] = configuration.NewCertificateInspector(config)
+	}
+	return inspectors
+}
+
+func (w *webServerStore) certificateWarnings() []*v1.CertificateWarning {
+	var warnings []*v1.CertificateWarning
+	for servername, inspector := range w.certificateInspectors() {
+		for _, warning := range inspector.ExpiryWarnings(w.certExpiryWarning) {
+			warning.ServerName = servername
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
 func (w *webServerStore) Close() error {
+	if w.stopReconciling != nil {
+		w.stopReconciling()
+	}
+	if w.traffic != nil {
+		w.traffic.stop()
+	}
+	if w.alerter != nil {
+		w.alerter.stop()
+	}
 	return errors.NewAggregate([]error{
 		w.cms.Stop(),
-		w.m.Stop(),
+		w.monitor().Stop(),
 		w.wm.StopAll(),
 	})
 }
@@ -53,7 +160,7 @@ var (
 	once              sync.Once
 )
 
-func GetNginxStoreFactory(webSvrConfOpts *genericoptions.WebServerConfigsOptions, monitorOpts *genericoptions.MonitorOptions, webSvrLogWatcherOpts *genericoptions.WebServerLogWatcherOptions) (storev1.StoreFactory, error) {
+func GetNginxStoreFactory(webSvrConfOpts *genericoptions.WebServerConfigsOptions, monitorOpts *genericoptions.MonitorOptions, webSvrLogWatcherOpts *genericoptions.WebServerLogWatcherOptions, webSvrCertOpts *genericoptions.WebServerCertificateOptions, webSvrTemplateOpts *genericoptions.WebServerTemplateOptions, webSvrReconcilerOpts *genericoptions.WebServerReconcilerOptions, webSvrTrafficOpts *genericoptions.WebServerTrafficOptions, webSvrLogAlertOpts *genericoptions.WebServerLogAlertOptions) (storev1.StoreFactory, error) {
 	if webSvrConfOpts == nil && nginxStoreFactory == nil {
 		return nil, errors.New("failed to get nginx store factory")
 	}
@@ -62,21 +169,41 @@ func GetNginxStoreFactory(webSvrConfOpts *genericoptions.WebServerConfigsOptions
 	var cms nginx.ConfigsManager
 	var m monitor.Monitor
 	once.Do(func() {
+		// the state file is the only source of the web servers if it is enabled, which is initialized with the configured
+		// ones, and the configured ones are ignored afterwards
+		items := webSvrConfOpts.WebServerConfigs
+		var persisted []*genericoptions.WebServerConfigOptions
+		var loaded bool
+		persisted, loaded, err = loadWebServerState(webSvrConfOpts.StateFile)
+		if err != nil {
+			return
+		}
+		if loaded {
+			log.Infof("load %d web server(s) from the state file '%s'", len(persisted), webSvrConfOpts.StateFile)
+			items = persisted
+			warnIgnoredWebServerConfigs(webSvrConfOpts, persisted)
+		} else {
+			err = saveWebServerState(webSvrConfOpts.StateFile, items)
+			if err != nil {
+				return
+			}
+		}
+
 		// init and start config managers and log watcher manager
 		cmsOpts := nginx.ConfigsManagerOptions{Options: make([]nginx.ConfigManagerOptions, 0)}
+		svrOpts := make(map[string]*genericoptions.WebServerConfigOptions)
 		svrLogsDirs := make(map[string]string)
-		for _, itemOpts := range webSvrConfOpts.WebServerConfigs {
+		svrLinters := make(map[string]linter.Linter)
+		for _, itemOpts := range items {
 			if itemOpts.ServerType == nginxServer {
-				cmsOpts.Options = append(cmsOpts.Options, nginx.ConfigManagerOptions{
-					ServerName:     itemOpts.ServerName,
-					MainConfigPath: itemOpts.ConfigPath,
-					ServerBinPath:  itemOpts.VerifyExecPath,
-					BackupDir:      itemOpts.BackupDir,
-					BackupCycle:    itemOpts.BackupCycle,
-					BackupSaveTime: itemOpts.BackupSaveTime,
-				})
+				cmsOpts.Options = append(cmsOpts.Options, newConfigManagerOptions(itemOpts))
+			}
+			svrOpts[itemOpts.ServerName] = itemOpts
+			svrLogsDirs[itemOpts.ServerName] = itemOpts.GetLogsDirPath()
+			svrLinters[itemOpts.ServerName], err = linter.New(linter.DefaultRegistry(), itemOpts.LintRules)
+			if err != nil {
+				return
 			}
-			svrLogsDirs[itemOpts.ServerName] = itemOpts.LogsDirPath
 		}
 		cms, err = nginx.New(cmsOpts)
 		if err != nil {
@@ -96,37 +223,89 @@ func GetNginxStoreFactory(webSvrConfOpts *genericoptions.WebServerConfigsOptions
 		wm := file_watcher.NewWatcherManager(wmconf)
 
 		// init and start monitor
-		mconf := &monitor.Config{
-			MonitoringSyncInterval:      monitorOpts.SyncInterval,
-			MonitoringCycle:             monitorOpts.CycleTime,
-			MonitoringFrequencyPerCycle: monitorOpts.FrequencyPerCycle,
-		}
-		m, err = mconf.Complete().NewMonitor()
+		m, err = startMonitor(monitorOpts)
 		if err != nil {
 			return
 		}
 
-		go func() {
-			err := m.Start()
-			if err != nil {
-				log.Fatal(err.Error())
+		// build nginx store factory
+		store := &webServerStore{
+			cms:               cms,
+			m:                 m,
+			wm:                wm,
+			rwLocker:          new(sync.RWMutex),
+			serverOpts:        svrOpts,
+			logsDirs:          svrLogsDirs,
+			linters:           svrLinters,
+			stateFile:         webSvrConfOpts.StateFile,
+			certExpiryWarning: webSvrCertOpts.ExpiryWarning,
+			certCheckInterval: webSvrCertOpts.CheckInterval,
+			templateDir:       webSvrTemplateOpts.Dir,
+			reconcilerDir:     webSvrReconcilerOpts.Dir,
+		}
+
+		// start the regular reconciling
+		if store.reconcilerDir != "" && webSvrReconcilerOpts.Interval > 0 {
+			var ctx context.Context
+			ctx, store.stopReconciling = context.WithCancel(context.Background())
+			go newWebServerReconcilerStore(store).regularlyReconcile(ctx, webSvrReconcilerOpts.Interval, webSvrReconcilerOpts.PlanOnly)
+		}
 
+		// start collecting the traffic stats
+		if webSvrTrafficOpts.Enabled {
+			store.traffic = newTrafficCollector(store, webSvrTrafficOpts.Window)
+			go store.traffic.run(webSvrTrafficOpts.ResyncInterval)
+		}
+
+		// start evaluating the log alert rules
+		if webSvrLogAlertOpts.Enabled() {
+			store.alerter, err = newLogAlerter(store, webSvrLogAlertOpts)
+			if err != nil {
 				return
 			}
-		}()
-
-		// build nginx store factory
-		nginxStoreFactory = &webServerStore{
-			cms:      cms,
-			m:        m,
-			wm:       wm,
-			logsDirs: svrLogsDirs,
+			go store.alerter.run(webSvrLogAlertOpts.ResyncInterval)
 		}
+		nginxStoreFactory = store
 	})
 
 	if nginxStoreFactory == nil || err != nil {
-		return nil, errors.Errorf("failed to get nginx store factory, nginx store factory: %+v, err: %w", nginxStoreFactory, err)
+		return nil, errors.Errorf("failed to get nginx store factory, nginx store factory: %+v, err: %v", nginxStoreFactory, err)
 	}
 
 	return nginxStoreFactory, nil
 }
+
+// warnIgnoredWebServerConfigs warns the configured web servers, which are ignored as they are different from the ones
+// persisted in the state file.
+func warnIgnoredWebServerConfigs(webSvrConfOpts *genericoptions.WebServerConfigsOptions, persisted []*genericoptions.WebServerConfigOptions) {
+	added, changed, removed := (&genericoptions.WebServerConfigsOptions{WebServerConfigs: persisted}).Diff(webSvrConfOpts)
+	for _, servername := range append(added, changed...) {
+		log.Warnf("web server '%s' of `web-server-configs.items` is ignored, since the web servers are loaded from the state file '%s'", servername, webSvrConfOpts.StateFile)
+	}
+	for _, servername := range removed {
+		log.Warnf("web server '%s' is not in `web-server-configs.items`, but it is kept as it is persisted in the state file '%s'", servername, webSvrConfOpts.StateFile)
+	}
+}
+
+func startMonitor(monitorOpts *genericoptions.MonitorOptions) (monitor.Monitor, error) {
+	mconf := &monitor.Config{
+		MonitoringSyncInterval:      monitorOpts.SyncInterval,
+		MonitoringCycle:             monitorOpts.CycleTime,
+		MonitoringFrequencyPerCycle: monitorOpts.FrequencyPerCycle,
+	}
+	m, err := mconf.Complete().NewMonitor()
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := m.Start()
+		// the monitor stopped by reloading or closing the store returns `context.Canceled`
+		if err != nil && err != context.Canceled {
+			log.Fatal(err.Error())
+
+			return
+		}
+	}()
+	return m, nil
+}
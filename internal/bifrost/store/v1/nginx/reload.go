@@ -0,0 +1,77 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/internal/pkg/file_watcher"
+	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/marmotedu/errors"
+)
+
+// ReloadWebServerConfigs registers the added, reconfigures the changed and unregisters the removed web servers of the
+// new options compared with the old ones. The web servers registered or changed at runtime, which are not changed in
+// the options, are kept. The changes of the configuration of a changed web server, which are not saved yet, are saved
+// before it is reconfigured, and the web server is not reconfigured if they fail to be saved.
+func (w *webServerStore) ReloadWebServerConfigs(oldOpts, newOpts *genericoptions.WebServerConfigsOptions) error {
+	added, changed, removed := oldOpts.Diff(newOpts)
+	manager := newWebServerManagerStore(w)
+	ctx := context.Background()
+	var errs []error
+
+	for _, servername := range removed {
+		err := manager.Unregister(ctx, &v1.ServerName{Name: servername})
+		if errors.IsCode(err, code.ErrConfigurationNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		log.Infof("web server '%s' is unregistered by reloading", servername)
+	}
+
+	for _, servername := range append(added, changed...) {
+		server := newManagedWebServer(newOpts.Item(servername))
+		err := manager.Register(ctx, server)
+		if errors.IsCode(err, code.ErrWebServerAlreadyExists) {
+			err = manager.Reconfigure(ctx, server)
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		log.Infof("web server '%s' is configured by reloading", servername)
+	}
+
+	return errors.NewAggregate(errs)
+}
+
+// ReloadMonitor starts a monitor with the new options, and stops the running one after the new one takes its place.
+func (w *webServerStore) ReloadMonitor(opts *genericoptions.MonitorOptions) error {
+	m, err := startMonitor(opts)
+	if err != nil {
+		return err
+	}
+	w.rwLocker.Lock()
+	old := w.m
+	w.m = m
+	w.rwLocker.Unlock()
+
+	if err = old.Stop(); err != nil && err != context.Canceled {
+		log.Warnf("failed to stop the replaced monitor, err: %v", err)
+	}
+	return nil
+}
+
+// ReloadWebServerLogWatcher applies the new options to the log watchers created afterwards, the running watching
+// streams are kept.
+func (w *webServerStore) ReloadWebServerLogWatcher(opts *genericoptions.WebServerLogWatcherOptions) error {
+	wmconf := file_watcher.NewConfig()
+	if err := opts.ApplyTo(wmconf); err != nil {
+		return err
+	}
+	w.wm.SetConfig(wmconf)
+	return nil
+}
@@ -0,0 +1,186 @@
+package nginx
+
+import (
+	"context"
+	"github.com/ClessLi/bifrost/internal/pkg/file_watcher"
+	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/linter"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestWebServerConfig(t *testing.T, dir, servername string) *genericoptions.WebServerConfigOptions {
+	confPath := filepath.Join(dir, servername+".conf")
+	if err := ioutil.WriteFile(confPath, []byte("http {\n    server {\n        listen 80;\n    }\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	opts := genericoptions.NewWebServerConfigOptions()
+	opts.ServerName = servername
+	opts.ConfigPath = confPath
+	opts.VerifyExecPath = "/bin/true"
+	opts.LogsDirPath = dir
+	// the changes are only saved by the reloading in the tests
+	opts.SaveInterval = time.Hour
+	return opts
+}
+
+// newTestWebServerStore returns a store of the web servers with the running config managers, which is closed by the
+// returned function.
+func newTestWebServerStore(t *testing.T, items ...*genericoptions.WebServerConfigOptions) (*webServerStore, func()) {
+	store := &webServerStore{
+		rwLocker:   new(sync.RWMutex),
+		serverOpts: make(map[string]*genericoptions.WebServerConfigOptions),
+		logsDirs:   make(map[string]string),
+		linters:    make(map[string]linter.Linter),
+	}
+	cmsOpts := nginx.ConfigsManagerOptions{Options: make([]nginx.ConfigManagerOptions, 0)}
+	for _, opts := range items {
+		l, err := linter.New(linter.DefaultRegistry(), opts.LintRules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmsOpts.Options = append(cmsOpts.Options, newConfigManagerOptions(opts))
+		store.serverOpts[opts.ServerName] = opts
+		store.logsDirs[opts.ServerName] = opts.GetLogsDirPath()
+		store.linters[opts.ServerName] = l
+	}
+	cms, err := nginx.New(cmsOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Start(); err != nil {
+		t.Fatal(err)
+	}
+	store.cms = cms
+	return store, func() { cms.Stop() }
+}
+
+func TestWebServerStore_ReloadWebServerConfigs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-reload-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	changed, removed := newTestWebServerConfig(t, dir, "changed"), newTestWebServerConfig(t, dir, "removed")
+	kept := newTestWebServerConfig(t, dir, "kept")
+	oldOpts := &genericoptions.WebServerConfigsOptions{WebServerConfigs: []*genericoptions.WebServerConfigOptions{changed, removed, kept}}
+	store, closeStore := newTestWebServerStore(t, changed, removed, kept)
+	defer closeStore()
+
+	// the server registered at runtime is kept, as it is not changed in the options
+	runtime := newTestWebServerConfig(t, dir, "runtime")
+	if err = newWebServerManagerStore(store).Register(context.Background(), newManagedWebServer(runtime)); err != nil {
+		t.Fatal(err)
+	}
+	// the change of the changed server is not saved yet, when the options are reloaded
+	if err = store.cms.GetConfigs()["changed"].AppendSnippetByKeyword([]byte("server_tokens off;"), "http"); err != nil {
+		t.Fatal(err)
+	}
+
+	changedAgain := *changed
+	changedAgain.BackupCycle = 2
+	keptAgain := *kept
+	added := newTestWebServerConfig(t, dir, "added")
+	newOpts := &genericoptions.WebServerConfigsOptions{WebServerConfigs: []*genericoptions.WebServerConfigOptions{&changedAgain, &keptAgain, added}}
+	if err = store.ReloadWebServerConfigs(oldOpts, newOpts); err != nil {
+		t.Fatal(err)
+	}
+
+	var servernames []string
+	for servername := range store.serverOptions() {
+		servernames = append(servernames, servername)
+	}
+	sort.Strings(servernames)
+	if got, want := strings.Join(servernames, ","), "added,changed,kept,runtime"; got != want {
+		t.Errorf("web servers after reloading = %s, want %s", got, want)
+	}
+	if len(store.cms.GetConfigs()) != len(servernames) {
+		t.Errorf("%d config managers after reloading, want %d", len(store.cms.GetConfigs()), len(servernames))
+	}
+	if store.serverOptions()["changed"].BackupCycle != 2 {
+		t.Errorf("the changed web server is not reconfigured")
+	}
+	if store.serverOptions()["kept"] != kept {
+		t.Errorf("the unchanged web server is reconfigured")
+	}
+
+	// the change survives the reconfiguration, in the configuration and the config files
+	if view := string(store.cms.GetConfigs()["changed"].View()); !strings.Contains(view, "server_tokens off;") {
+		t.Errorf("the change is lost after reloading, config:\n%s", view)
+	}
+	data, err := ioutil.ReadFile(changed.ConfigPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "server_tokens off;") {
+		t.Errorf("the change is not saved by reloading, config file:\n%s", data)
+	}
+}
+
+func TestWebServerStore_ReloadMonitor(t *testing.T) {
+	m, err := startMonitor(genericoptions.NewMonitorOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &webServerStore{rwLocker: new(sync.RWMutex), m: m}
+	// the monitors are started asynchronously, and can only be stopped after started
+	time.Sleep(time.Millisecond * 100)
+
+	opts := genericoptions.NewMonitorOptions()
+	opts.SyncInterval = time.Minute
+	if err = store.ReloadMonitor(opts); err != nil {
+		t.Fatal(err)
+	}
+	if store.monitor() == m {
+		t.Errorf("the monitor is not replaced by reloading")
+	}
+	time.Sleep(time.Millisecond * 100)
+	if err = store.monitor().Stop(); err != nil && err != context.Canceled {
+		t.Error(err)
+	}
+}
+
+func TestWebServerStore_ReloadWebServerLogWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-reload-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	watched, unwatched := filepath.Join(dir, "watched.log"), filepath.Join(dir, "unwatched.log")
+	for _, path := range []string{watched, unwatched} {
+		if err = ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store := &webServerStore{wm: file_watcher.NewWatcherManager(file_watcher.NewConfig())}
+	defer store.wm.StopAll()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err = store.wm.Subscribe(ctx, watched); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := genericoptions.NewWebServerLogWatcherOptions()
+	opts.MaxConnections = 1
+	if err = store.ReloadWebServerLogWatcher(opts); err != nil {
+		t.Fatal(err)
+	}
+	// the running watcher is kept with the former options, and the new options apply to the new watchers
+	if _, err = store.wm.Subscribe(ctx, watched); err != nil {
+		t.Errorf("subscribing the running watcher after reloading: %v", err)
+	}
+	if _, err = store.wm.Subscribe(ctx, unwatched); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = store.wm.Subscribe(ctx, unwatched); err == nil {
+		t.Errorf("subscribing the new watcher beyond the reloaded max connections want an error")
+	}
+}
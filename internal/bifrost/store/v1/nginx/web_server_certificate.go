@@ -0,0 +1,128 @@
+package nginx
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/marmotedu/errors"
+	"time"
+)
+
+type webServerCertificateStore struct {
+	inspectorsFunc func() map[string]configuration.CertificateInspector
+	expiryWarning  time.Duration
+	checkInterval  time.Duration
+}
+
+func (w *webServerCertificateStore) Get(ctx context.Context, servername *v1.ServerName) (*v1.Certificates, error) {
+	if inspector, has := w.inspectorsFunc()[servername.Name]; has {
+		return &v1.Certificates{
+			ServerName: servername,
+			List:       inspector.Certificates(),
+		}, nil
+	}
+	return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", servername.Name)
+}
+
+// WatchExpiry checks the certificates of the web server regularly, and sends the expiry warnings until the context is done.
+// All web servers will be watched, if the request server name is empty. The web servers are looked up on each check, so
+// that the web servers registered or reconfigured later are watched as well, and a warning is only sent again when its
+// level or days left changes.
+func (w *webServerCertificateStore) WatchExpiry(ctx context.Context, request *v1.WebServerCertificateWatchRequest) (*v1.WebServerCertificateWarnings, error) {
+	watched := ""
+	if request.ServerName != nil && request.ServerName.Name != "" {
+		watched = request.ServerName.Name
+		if _, has := w.inspectorsFunc()[watched]; !has {
+			return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", watched)
+		}
+	}
+	threshold := request.ExpiryWarning
+	if threshold <= 0 {
+		threshold = w.expiryWarning
+	}
+
+	warningC := make(chan *v1.CertificateWarning)
+	go func() {
+		defer close(warningC)
+		ticker := time.NewTicker(w.checkInterval)
+		defer ticker.Stop()
+		sent := make(sentExpiryWarnings)
+		for {
+			warnings := make([]*v1.CertificateWarning, 0)
+			for servername, inspector := range w.inspectorsFunc() {
+				if watched != "" && servername != watched {
+					continue
+				}
+				for _, warning := range inspector.ExpiryWarnings(threshold) {
+					warning.ServerName = servername
+					warnings = append(warnings, warning)
+				}
+			}
+			for _, warning := range sent.filter(warnings, time.Now()) {
+				select {
+				case warningC <- warning:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return &v1.WebServerCertificateWarnings{Warnings: warningC}, nil
+}
+
+// sentExpiryWarnings is the states of the warnings sent, by the server name, the path, the position and the expiry
+// time of the certificates.
+type sentExpiryWarnings map[string]expiryWarningState
+
+// expiryWarningState is the level and the days left of a warning, the days left of an expired certificate are ignored,
+// so that it is warned once.
+type expiryWarningState struct {
+	level    v1.CertificateWarningLevel
+	daysLeft int
+}
+
+// filter returns the warnings which are new or changed since they were sent, and forgets the warnings which are gone,
+// e.g. of the renewed certificates.
+func (s sentExpiryWarnings) filter(warnings []*v1.CertificateWarning, now time.Time) []*v1.CertificateWarning {
+	changed := make([]*v1.CertificateWarning, 0)
+	current := make(map[string]bool)
+	for _, warning := range warnings {
+		cert := warning.Certificate
+		key := fmt.Sprintf("%s|%s|%s|%s", warning.ServerName, cert.Path, cert.Position, cert.NotAfter.Format(time.RFC3339))
+		state := expiryWarningState{level: warning.Level}
+		if warning.Level != v1.CertificateExpired {
+			state.daysLeft = int(cert.NotAfter.Sub(now).Hours() / 24)
+		}
+		current[key] = true
+		if sentState, has := s[key]; has && sentState == state {
+			continue
+		}
+		s[key] = state
+		changed = append(changed, warning)
+	}
+	for key := range s {
+		if !current[key] {
+			delete(s, key)
+		}
+	}
+	return changed
+}
+
+var _ storev1.WebServerCertificateStore = &webServerCertificateStore{}
+
+func newWebServerCertificateStore(store *webServerStore) storev1.WebServerCertificateStore {
+	return &webServerCertificateStore{
+		inspectorsFunc: store.certificateInspectors,
+		expiryWarning:  store.certExpiryWarning,
+		checkInterval:  store.certCheckInterval,
+	}
+}
@@ -0,0 +1,43 @@
+package nginx
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"testing"
+	"time"
+)
+
+func TestSentExpiryWarnings_filter(t *testing.T) {
+	now := time.Date(2020, 10, 10, 12, 0, 0, 0, time.UTC)
+	expiring := &v1.Certificate{Path: "/etc/nginx/ssl/a.crt", Position: "nginx.conf:10", NotAfter: now.Add(time.Hour * (24*10 + 12))}
+	renewed := &v1.Certificate{Path: "/etc/nginx/ssl/a.crt", Position: "nginx.conf:10", NotAfter: now.Add(time.Hour * 24 * 20)}
+	expired := &v1.Certificate{Path: "/etc/nginx/ssl/b.crt", Position: "nginx.conf:20", NotAfter: now.Add(-time.Hour)}
+	warning := func(servername string, level v1.CertificateWarningLevel, cert *v1.Certificate) *v1.CertificateWarning {
+		return &v1.CertificateWarning{ServerName: servername, Level: level, Certificate: cert}
+	}
+
+	sent := make(sentExpiryWarnings)
+	checks := []struct {
+		name     string
+		now      time.Time
+		warnings []*v1.CertificateWarning
+		want     int
+	}{
+		{"new", now, []*v1.CertificateWarning{warning("a", v1.CertificateExpiring, expiring), warning("a", v1.CertificateExpired, expired)}, 2},
+		{"unchanged", now.Add(time.Hour), []*v1.CertificateWarning{warning("a", v1.CertificateExpiring, expiring), warning("a", v1.CertificateExpired, expired)}, 0},
+		{"days left changed", now.Add(time.Hour * 24), []*v1.CertificateWarning{warning("a", v1.CertificateExpiring, expiring), warning("a", v1.CertificateExpired, expired)}, 1},
+		{"other web server", now.Add(time.Hour * 24), []*v1.CertificateWarning{warning("a", v1.CertificateExpiring, expiring), warning("b", v1.CertificateExpired, expired)}, 1},
+		{"level changed", now.Add(time.Hour * 24 * 11), []*v1.CertificateWarning{warning("a", v1.CertificateExpired, expiring)}, 1},
+		{"expired still", now.Add(time.Hour * 24 * 12), []*v1.CertificateWarning{warning("a", v1.CertificateExpired, expiring)}, 0},
+		{"renewed", now.Add(time.Hour * 24 * 12), []*v1.CertificateWarning{warning("a", v1.CertificateExpiring, renewed)}, 1},
+		{"gone", now.Add(time.Hour * 24 * 12), nil, 0},
+		{"back again", now.Add(time.Hour * 24 * 12), []*v1.CertificateWarning{warning("a", v1.CertificateExpiring, renewed)}, 1},
+	}
+	for _, check := range checks {
+		if got := sent.filter(check.warnings, check.now); len(got) != check.want {
+			t.Errorf("%s: got %d warnings, want %d", check.name, len(got), check.want)
+		}
+	}
+	if len(sent) != 1 {
+		t.Errorf("%d warnings are kept, want the only current one", len(sent))
+	}
+}
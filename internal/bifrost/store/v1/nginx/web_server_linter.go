@@ -0,0 +1,44 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/linter"
+	"github.com/marmotedu/errors"
+)
+
+type webServerLinterStore struct {
+	configs map[string]configuration.Configuration
+	linters map[string]linter.Linter
+}
+
+func (w *webServerLinterStore) Lint(ctx context.Context, servername *v1.ServerName) (*v1.LintReport, error) {
+	config, has := w.configs[servername.Name]
+	if !has {
+		return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", servername.Name)
+	}
+	l, has := w.linters[servername.Name]
+	if !has {
+		var err error
+		l, err = linter.New(linter.DefaultRegistry(), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &v1.LintReport{
+		ServerName: servername,
+		Issues:     l.Lint(config),
+	}, nil
+}
+
+var _ storev1.WebServerLinterStore = &webServerLinterStore{}
+
+func newWebServerLinterStore(store *webServerStore) storev1.WebServerLinterStore {
+	return &webServerLinterStore{
+		configs: store.cms.GetConfigs(),
+		linters: store.serverLinters(),
+	}
+}
@@ -0,0 +1,195 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"github.com/ClessLi/bifrost/internal/pkg/error_log"
+	"github.com/ClessLi/bifrost/internal/pkg/log_alert"
+	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"sync"
+	"time"
+)
+
+// logAlertRewatchDelay is the delay to watch the log again after it failed to be watched.
+const logAlertRewatchDelay = 10 * time.Second
+
+type logAlertTail struct {
+	path   string
+	format string
+	cancel context.CancelFunc
+}
+
+// logAlerter tails the logs of the web servers, evaluates the log alert rules on the lines, and dispatches the alerts
+// fired.
+type logAlerter struct {
+	store      *webServerStore
+	rules      []*log_alert.Rule
+	dispatcher *log_alert.Dispatcher
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// the tails are keyed by the server name and the rule name, and changed by the resyncing only
+	mu    sync.Mutex
+	tails map[string]map[string]*logAlertTail
+}
+
+// run resyncs the tailed logs with the configs of the web servers regularly, until the alerter is stopped.
+func (a *logAlerter) run(resyncInterval time.Duration) {
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+	for {
+		a.resync()
+		select {
+		case <-ticker.C:
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *logAlerter) stop() {
+	a.cancel()
+	a.dispatcher.Close()
+}
+
+func (a *logAlerter) hasRule(name string) bool {
+	for _, rule := range a.rules {
+		if rule.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// resync starts tailing the logs of the rules for the web servers, and stops tailing the ones no longer listed by the
+// web servers. The log is tailed again if its path or its log format changed.
+func (a *logAlerter) resync() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	logStore := newWebServerLogWatcherStore(a.store)
+	for servername := range logStore.configs {
+		tails := a.tails[servername]
+		if tails == nil {
+			tails = make(map[string]*logAlertTail)
+			a.tails[servername] = tails
+		}
+		for _, rule := range a.rules {
+			if !rule.AppliesTo(servername) {
+				continue
+			}
+			tail, tailed := tails[rule.Name]
+			file, err := logStore.logFile(servername, rule.LogName)
+			if err != nil {
+				if tailed {
+					tail.cancel()
+					delete(tails, rule.Name)
+				}
+				log.Debugf("log alert rule '%s' is not evaluated on web server '%s'. %s", rule.Name, servername, err.Error())
+				continue
+			}
+			format := ""
+			for _, writer := range file.Writers {
+				if writer.Directive == "access_log" {
+					format = writer.Format
+					break
+				}
+			}
+			if tailed {
+				if tail.path == file.Path && tail.format == format {
+					continue
+				}
+				tail.cancel()
+				delete(tails, rule.Name)
+			}
+			parse, err := logRecordParser(logStore, servername, file, rule)
+			if err != nil {
+				log.Warnf("failed to evaluate log alert rule '%s' on web server '%s'. %s", rule.Name, servername, err.Error())
+				continue
+			}
+			ctx, cancel := context.WithCancel(a.ctx)
+			tails[rule.Name] = &logAlertTail{path: file.Path, format: format, cancel: cancel}
+			go a.tail(ctx, file.Path, rule, log_alert.NewEvaluator(rule, servername, parse))
+		}
+	}
+	for servername, tails := range a.tails {
+		if _, has := logStore.configs[servername]; has {
+			continue
+		}
+		for _, tail := range tails {
+			tail.cancel()
+		}
+		delete(a.tails, servername)
+	}
+}
+
+// tail evaluates the rule on the lines of the log, until the context is done. The log is watched again when the output
+// of the watcher is closed by the watch timeout.
+func (a *logAlerter) tail(ctx context.Context, path string, rule *log_alert.Rule, evaluator *log_alert.Evaluator) {
+	for {
+		lines, err := a.store.wm.Watch(ctx, path)
+		if err != nil {
+			log.Warnf("failed to watch '%s' for log alert rule '%s'. %s", path, rule.Name, err.Error())
+			select {
+			case <-time.After(logAlertRewatchDelay):
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		for line := range lines {
+			if alert := evaluator.Evaluate(line, time.Now()); alert != nil {
+				log.Infof("log alert rule '%s' fired on web server '%s', %d lines matched in %ds", alert.Rule, alert.ServerName, alert.Count, alert.WindowSeconds)
+				a.dispatcher.Fire(alert, rule.Sinks)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// logRecordParser returns the function parsing the lines of the log into the records for the filter of the rule, the
+// error logs are parsed as the error log entries, and the others by the log format of the access log. Nil is returned
+// if the rule has no filter.
+func logRecordParser(logStore *webServerLogWatcherStore, servername string, file *v1.LogFile, rule *log_alert.Rule) (func(line []byte) (access_log.Record, bool), error) {
+	if rule.Filter == nil {
+		return nil, nil
+	}
+	if isErrorLog(file) {
+		return func(line []byte) (access_log.Record, bool) {
+			entry, ok := error_log.Parse(line)
+			if !ok {
+				return nil, false
+			}
+			return entry.Record(), true
+		}, nil
+	}
+	parser, err := logStore.accessLogParser(servername, file)
+	if err != nil {
+		return nil, err
+	}
+	return parser.Parse, nil
+}
+
+func newLogAlerter(store *webServerStore, opts *genericoptions.WebServerLogAlertOptions) (*logAlerter, error) {
+	rules, err := opts.LogAlertRules()
+	if err != nil {
+		return nil, err
+	}
+	sinks, err := opts.LogAlertSinks()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &logAlerter{
+		store:      store,
+		rules:      rules,
+		dispatcher: log_alert.NewDispatcher(sinks),
+		ctx:        ctx,
+		cancel:     cancel,
+		tails:      make(map[string]map[string]*logAlertTail),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package nginx
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/internal/pkg/log_download"
+	"github.com/ClessLi/bifrost/internal/pkg/log_query"
+	"github.com/marmotedu/errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DownloadLog downloads the log of the web server, or a tar.gz archive of the logs and the current config of the web
+// server if the archive is requested or several logs are requested. Only the listed logs and their rotated siblings
+// can be downloaded, and the log names may be patterns like `access.log*` in the archive.
+func (w *webServerLogWatcherStore) DownloadLog(ctx context.Context, request *v1.WebServerLogDownloadRequest) (*v1.WebServerLogDownload, error) {
+	serverName := request.ServerName.Name
+	if len(request.LogNames) == 0 {
+		return nil, errors.WithCode(code.ErrInvalidLogDownload, "no log of web server %s is requested to download", serverName)
+	}
+	if len(request.LogNames) == 1 && !request.Archive && !isLogNamePattern(request.LogNames[0]) {
+		return w.downloadFile(ctx, serverName, request.LogNames[0])
+	}
+	return w.downloadArchive(ctx, serverName, request.LogNames)
+}
+
+func (w *webServerLogWatcherStore) downloadFile(ctx context.Context, serverName, logName string) (*v1.WebServerLogDownload, error) {
+	logs, err := w.listLogs(serverName)
+	if err != nil {
+		return nil, err
+	}
+	path, err := downloadPath(logs, serverName, logName)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrLogNotFound, "log '%s' of web server %s can not be opened. %s", logName, serverName, err.Error())
+	}
+	if info, err := f.Stat(); err != nil || !info.Mode().IsRegular() {
+		f.Close()
+		return nil, errors.WithCode(code.ErrInvalidLogDownload, "log '%s' of web server %s is not a regular file", logName, serverName)
+	}
+	stream := log_download.NewStream(ctx, f)
+	return &v1.WebServerLogDownload{
+		FileName: filepath.Base(path),
+		Chunks:   stream.Chunks(),
+		Checksum: stream.Checksum,
+		Err:      stream.Err,
+	}, nil
+}
+
+// downloadArchive archives the logs under `logs/` and the config files of the web server under `config/`.
+func (w *webServerLogWatcherStore) downloadArchive(ctx context.Context, serverName string, logNames []string) (*v1.WebServerLogDownload, error) {
+	logs, err := w.listLogs(serverName)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]string) // the archive names of the log paths
+	for _, logName := range logNames {
+		if isLogNamePattern(logName) {
+			if _, err := filepath.Match(logName, ""); err != nil {
+				return nil, errors.WithCode(code.ErrInvalidLogDownload, "invalid log name pattern '%s'", logName)
+			}
+			matched, err := w.matchLogs(serverName, []string{logName})
+			if err != nil {
+				return nil, err
+			}
+			for _, file := range matched {
+				paths[file.Path] = file.Name
+			}
+			continue
+		}
+		path, err := downloadPath(logs, serverName, logName)
+		if err != nil {
+			return nil, err
+		}
+		paths[path] = logName
+	}
+
+	files := make([]log_download.ArchiveFile, 0, len(paths))
+	for path, name := range paths {
+		files = append(files, log_download.ArchiveFile{Name: "logs/" + log_download.ArchiveName(name), Path: path})
+	}
+	for path, data := range w.configs[serverName].Dump() {
+		files = append(files, log_download.ArchiveFile{Name: "config/" + log_download.ArchiveName(path), Data: data})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	stream := log_download.NewStream(ctx, log_download.Archive(files))
+	return &v1.WebServerLogDownload{
+		FileName: fmt.Sprintf("%s-logs-%s.tar.gz", serverName, time.Now().Format("20060102150405")),
+		Chunks:   stream.Chunks(),
+		Checksum: stream.Checksum,
+		Err:      stream.Err,
+	}, nil
+}
+
+// downloadPath returns the path of the log by the name, which is the name of a listed log, or the name of a rotated
+// sibling of a listed log, such as `access.log.1` and `access.log.2.gz` of `access.log`.
+func downloadPath(logs []*v1.LogFile, serverName, logName string) (string, error) {
+	for _, file := range logs {
+		if file.Name == logName {
+			return file.Path, nil
+		}
+	}
+	for _, file := range logs {
+		if !file.Exists || !strings.HasPrefix(logName, file.Name+".") {
+			continue
+		}
+		siblings, err := log_query.Files(file.Path)
+		if err != nil {
+			continue
+		}
+		for _, sibling := range siblings {
+			if file.Name+strings.TrimPrefix(sibling, file.Path) == logName {
+				return sibling, nil
+			}
+		}
+	}
+	return "", errors.WithCode(code.ErrLogNotFound, "log '%s' is not one of the logs of web server %s", logName, serverName)
+}
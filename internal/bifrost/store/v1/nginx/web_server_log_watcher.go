@@ -1,88 +1,637 @@
 package nginx
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
 	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/internal/pkg/error_log"
 	"github.com/ClessLi/bifrost/internal/pkg/file_watcher"
+	"github.com/ClessLi/bifrost/internal/pkg/log_query"
+	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
 	"github.com/marmotedu/errors"
 	"github.com/marmotedu/iam/pkg/log"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
+// logListInterval is the interval of listing the logs again, when the logs matched by the patterns are watched.
+const logListInterval = 5 * time.Second
+
 type webServerLogWatcherStore struct {
 	watcherManager    *file_watcher.WatcherManager
 	webServerLogsDirs map[string]string
+	configs           map[string]configuration.Configuration
+	serverOpts        map[string]*genericoptions.WebServerConfigOptions
+	alerter           *logAlerter
 }
 
 func (w *webServerLogWatcherStore) Watch(ctx context.Context, request *v1.WebServerLogWatchRequest) (*v1.WebServerLog, error) {
-	var logPath string
-	if logDir, ok := w.webServerLogsDirs[request.ServerName.Name]; ok {
-		logPath = filepath.Join(logDir, request.LogName)
-	} else {
-		return nil, errors.WithCode(code.ErrConfigurationNotFound, "web server %s is not exist", request.ServerName.Name)
+	if len(request.LogNames) > 0 || isLogNamePattern(request.LogName) {
+		return w.watchMerged(ctx, request)
+	}
+	target, err := w.logFile(request.ServerName.Name, request.LogName)
+	if err != nil {
+		return nil, err
+	}
+	if !isErrorLog(target) && (request.MinSeverity != "" || request.GroupWindow != 0 || request.UpstreamEvents) {
+		return nil, errors.WithCode(code.ErrInvalidLogFilter, "'%s' of web server %s is not an error log", request.LogName, request.ServerName.Name)
+	}
+	processor, err := w.newLogProcessor(request.ServerName.Name, target, request)
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := w.subscribe(ctx, target.Path, request.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		cursorMu sync.Mutex
+		cursor   = subscription.Start().String()
+		gap      = subscription.Gap()
+	)
+	fOutputC := make(chan []byte)
+	go func() {
+		defer close(fOutputC)
+		// the groups of the repeated messages are flushed every second, even if no more lines are written
+		var flushC <-chan time.Time
+		if processor.pipeline != nil && request.GroupWindow > 0 {
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
+			flushC = ticker.C
+		}
+		for {
+			var (
+				lines [][]byte
+				next  *file_watcher.Line
+			)
+			select {
+			case line, ok := <-subscription.Lines():
+				if !ok {
+					return
+				}
+				next = line
+				lines = processor.process(line.Text, time.Now())
+			case now := <-flushC:
+				lines = processor.flush(now)
+			case <-ctx.Done():
+				return
+			}
+			if !sendLines(fOutputC, lines) {
+				return
+			}
+			// the cursor is moved after the lines are received, then the lines are sent at least once when resumed
+			if next != nil {
+				cursorMu.Lock()
+				cursor = next.Cursor.String()
+				cursorMu.Unlock()
+			}
+		}
+	}()
+	return &v1.WebServerLog{
+		Lines:   fOutputC,
+		Dropped: subscription.Dropped,
+		Cursor: func() string {
+			cursorMu.Lock()
+			defer cursorMu.Unlock()
+			return cursor
+		},
+		Gap: func() bool { return gap },
+	}, nil
+}
+
+// watchMerged watches the logs named by the log names, or matched by them if they are patterns like `*.access.log`,
+// in one stream. The logs listed later and matched by the patterns are watched too. Each line is tagged by the name of
+// its log, as the `log` field of the json line if the lines are structured, or the prefix like `[access.log] `.
+func (w *webServerLogWatcherStore) watchMerged(ctx context.Context, request *v1.WebServerLogWatchRequest) (*v1.WebServerLog, error) {
+	serverName := request.ServerName.Name
+	if request.Cursor != "" {
+		return nil, errors.WithCode(code.ErrInvalidLogCursor, "the cursor can only resume watching a single log")
+	}
+	patterns := request.LogNames
+	if request.LogName != "" {
+		patterns = append([]string{request.LogName}, patterns...)
 	}
-	outputC, err := w.watcherManager.Watch(ctx, logPath)
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, errors.WithCode(code.ErrInvalidLogFilter, "invalid log name pattern '%s'", pattern)
+		}
+	}
+	logs, err := w.matchLogs(serverName, patterns)
 	if err != nil {
 		return nil, err
 	}
-	if len(request.FilteringRegexpRule) > 0 {
-		fOutputC := make(chan []byte)
-		go func() {
-			defer close(fOutputC)
-			for {
-				select {
-				//case fOutputC <- filterOutput(outputC, request.FilteringRegexpRule, &needClose):
-				//	if needClose {
-				//		return
-				//	}
-				case data := <-outputC:
-					err, ok := filterOutput(data, request.FilteringRegexpRule)
-					if err != nil {
-						data = []byte(err.Error())
+
+	// the processors of the logs matched later are created when their first lines are received
+	var mu sync.Mutex
+	matched := make(map[string]*v1.LogFile)
+	processors := make(map[string]*logProcessor)
+	for _, file := range logs {
+		matched[absLogPath(file)] = file
+		processors[absLogPath(file)], err = w.newLogProcessor(serverName, file, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+	list := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		if logs, err := w.matchLogs(serverName, patterns); err == nil {
+			matched = make(map[string]*v1.LogFile)
+			for _, file := range logs {
+				matched[absLogPath(file)] = file
+			}
+		}
+		paths := make([]string, 0, len(matched))
+		for path := range matched {
+			paths = append(paths, path)
+		}
+		return paths
+	}
+	processorOf := func(path string) *logProcessor {
+		mu.Lock()
+		defer mu.Unlock()
+		if processor, has := processors[path]; has {
+			return processor
+		}
+		file, has := matched[path]
+		if !has {
+			return nil
+		}
+		processor, err := w.newLogProcessor(serverName, file, request)
+		if err != nil {
+			log.Warnf("the lines of '%s' of web server %s are skipped. %s", file.Name, serverName, err.Error())
+		}
+		processors[path] = processor
+		return processor
+	}
+
+	merged, err := w.watcherManager.Merge(ctx, list, logListInterval)
+	if err != nil {
+		return nil, err
+	}
+	structured := request.Structured || request.UpstreamEvents
+	fOutputC := make(chan []byte)
+	go func() {
+		defer close(fOutputC)
+		var flushC <-chan time.Time
+		if request.GroupWindow > 0 {
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
+			flushC = ticker.C
+		}
+		for {
+			var lines [][]byte
+			select {
+			case line, ok := <-merged.Lines():
+				if !ok {
+					return
+				}
+				if processor := processorOf(line.File); processor != nil {
+					for _, data := range processor.process(line.Text, time.Now()) {
+						lines = append(lines, tagLine(processor.name, data, structured))
 					}
-					if ok {
-						select {
-						case fOutputC <- data:
-						case <-time.After(time.Second * 30):
-							log.Warnf("send filtered data timeout(30s)")
-							return
-						}
+				}
+			case now := <-flushC:
+				mu.Lock()
+				for _, processor := range processors {
+					if processor == nil {
+						continue
 					}
-					if err != nil {
-						return
+					for _, data := range processor.flush(now) {
+						lines = append(lines, tagLine(processor.name, data, structured))
 					}
-				case <-ctx.Done():
-					return
 				}
+				mu.Unlock()
+			case <-ctx.Done():
+				return
+			}
+			if !sendLines(fOutputC, lines) {
+				return
+			}
+		}
+	}()
+	return &v1.WebServerLog{Lines: fOutputC, Dropped: merged.Dropped}, nil
+}
+
+// matchLogs returns the logs of the web server named by the names or matched by the patterns. The names which are not
+// patterns must be listed, and at least one log must be matched.
+func (w *webServerLogWatcherStore) matchLogs(serverName string, patterns []string) ([]*v1.LogFile, error) {
+	logs, err := w.listLogs(serverName)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]*v1.LogFile, 0)
+	for _, file := range logs {
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(pattern, file.Name); ok {
+				matched = append(matched, file)
+				break
 			}
-		}()
-		return &v1.WebServerLog{Lines: fOutputC}, nil
+		}
+	}
+	for _, pattern := range patterns {
+		if isLogNamePattern(pattern) {
+			continue
+		}
+		found := false
+		for _, file := range matched {
+			found = found || file.Name == pattern
+		}
+		if !found {
+			return nil, errors.WithCode(code.ErrLogNotFound, "log '%s' is not one of the logs of web server %s", pattern, serverName)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, errors.WithCode(code.ErrLogNotFound, "no log of web server %s matches %v", serverName, patterns)
+	}
+	return matched, nil
+}
+
+// absLogPath returns the absolute path of the log, as the file of the lines watched.
+func absLogPath(file *v1.LogFile) string {
+	if path, err := filepath.Abs(file.Path); err == nil {
+		return path
+	}
+	return file.Path
+}
+
+// isLogNamePattern returns whether the log name is a pattern of filepath.Match.
+func isLogNamePattern(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}
+
+// tagLine tags the line by the name of its log, as the `log` field of the json line if the line is structured, or the
+// prefix like `[access.log] `.
+func tagLine(name string, line []byte, structured bool) []byte {
+	if structured && len(line) > 1 && line[0] == '{' {
+		field, _ := json.Marshal(name)
+		tagged := append([]byte(`{"log":`), field...)
+		if !bytes.Equal(line, []byte("{}")) {
+			tagged = append(tagged, ',')
+		}
+		return append(tagged, line[1:]...)
+	}
+	tagged := make([]byte, 0, len(name)+3+len(line))
+	tagged = append(tagged, '[')
+	tagged = append(tagged, name...)
+	tagged = append(tagged, "] "...)
+	return append(tagged, line...)
+}
+
+// sendLines sends the lines to the output, false is returned if the output is not received in time.
+func sendLines(output chan<- []byte, lines [][]byte) bool {
+	for _, line := range lines {
+		select {
+		case output <- line:
+		case <-time.After(time.Second * 30):
+			log.Warnf("send filtered data timeout(30s)")
+			return false
+		}
+	}
+	return true
+}
+
+// logProcessor filters and converts the lines of a watched log. The lines of the error log are processed by the
+// pipeline after they are matched by the regexp rule, and the options of the error logs are ignored by the others.
+type logProcessor struct {
+	name      string
+	transform func(line []byte) ([]byte, bool)
+	pipeline  *error_log.Pipeline
+}
+
+func (p *logProcessor) process(line []byte, now time.Time) [][]byte {
+	data, ok := line, true
+	if p.transform != nil {
+		data, ok = p.transform(data)
+	}
+	if !ok {
+		return nil
+	}
+	if p.pipeline != nil {
+		return p.pipeline.Process(data, now)
+	}
+	return [][]byte{data}
+}
+
+func (p *logProcessor) flush(now time.Time) [][]byte {
+	if p.pipeline == nil {
+		return nil
+	}
+	return p.pipeline.Flush(now)
+}
+
+func (w *webServerLogWatcherStore) newLogProcessor(serverName string, file *v1.LogFile, request *v1.WebServerLogWatchRequest) (*logProcessor, error) {
+	processor := &logProcessor{name: file.Name}
+	fieldRule, structured := request.FilteringFieldRule, request.Structured
+	if isErrorLog(file) {
+		// the fields of the error log are parsed by the pipeline, after the lines are matched by the regexp rule
+		pipeline, err := error_log.NewPipeline(error_log.Options{
+			MinSeverity:    request.MinSeverity,
+			Filter:         fieldRule,
+			Structured:     structured,
+			UpstreamEvents: request.UpstreamEvents,
+			GroupWindow:    request.GroupWindow,
+		})
+		if err != nil {
+			return nil, err
+		}
+		processor.pipeline = pipeline
+		fieldRule, structured = "", false
+	}
+	transform, err := w.lineTransformer(serverName, file, request.FilteringRegexpRule, fieldRule, structured)
+	if err != nil {
+		return nil, err
+	}
+	processor.transform = transform
+	return processor, nil
+}
+
+// subscribe subscribes the lines of the log written afterwards, or the ones after the cursor if it is not empty.
+func (w *webServerLogWatcherStore) subscribe(ctx context.Context, path, cursor string) (*file_watcher.Subscription, error) {
+	if cursor == "" {
+		return w.watcherManager.Subscribe(ctx, path)
+	}
+	from, err := file_watcher.ParseCursor(cursor)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrInvalidLogCursor, "%s", err.Error())
+	}
+	return w.watcherManager.Resume(ctx, path, from)
+}
+
+// QueryLogs queries the lines of the log and its rotated siblings, the log name should be one of the names listed by
+// ListLogs.
+func (w *webServerLogWatcherStore) QueryLogs(ctx context.Context, request *v1.WebServerLogQueryRequest) (*v1.WebServerLog, error) {
+	target, err := w.logFile(request.ServerName.Name, request.LogName)
+	if err != nil {
+		return nil, err
+	}
+	// the regexp rule is matched by the log query, before the lines are parsed
+	var transform func(line []byte) ([]byte, bool)
+	if isErrorLog(target) {
+		transform, err = errorLogTransformer(request.FilteringFieldRule, request.Structured)
+	} else {
+		transform, err = w.lineTransformer(request.ServerName.Name, target, "", request.FilteringFieldRule, request.Structured)
+	}
+	if err != nil {
+		return nil, err
+	}
+	lines, err := log_query.Lines(ctx, target.Path, log_query.Query{
+		Since:     request.Since,
+		Until:     request.Until,
+		Offset:    request.Offset,
+		Limit:     request.Limit,
+		Reverse:   request.Reverse,
+		Filter:    request.FilteringRegexpRule,
+		Transform: transform,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.WebServerLog{Lines: lines}, nil
+}
+
+// ListLogs lists the logs of the web server, which are the files written by the `access_log` and `error_log`
+// directives of the config, and the files in the logs directory. Only the listed logs can be watched and queried.
+func (w *webServerLogWatcherStore) ListLogs(ctx context.Context, servername *v1.ServerName) (*v1.LogFiles, error) {
+	logs, err := w.listLogs(servername.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LogFiles{ServerName: servername, List: logs}, nil
+}
+
+// Alerts sends the alerts fired by the log alert rule on the web server, until the context is done. The alerts of all
+// the web servers or all the rules are sent, if the request server name or the rule is empty.
+func (w *webServerLogWatcherStore) Alerts(ctx context.Context, request *v1.WebServerLogAlertsRequest) (*v1.WebServerLogAlerts, error) {
+	if w.alerter == nil {
+		return nil, errors.WithCode(code.ErrLogAlertDisabled, "log alert is disabled, see web-server-log-alert.rules")
+	}
+	servername := ""
+	if request.ServerName != nil && request.ServerName.Name != "" {
+		servername = request.ServerName.Name
+		if _, has := w.configs[servername]; !has {
+			return nil, errors.WithCode(code.ErrConfigurationNotFound, "web server %s is not exist", servername)
+		}
+	}
+	if request.Rule != "" && !w.alerter.hasRule(request.Rule) {
+		return nil, errors.WithCode(code.ErrLogAlertRuleNotFound, "log alert rule '%s' is not defined", request.Rule)
+	}
+	return &v1.WebServerLogAlerts{Alerts: w.alerter.dispatcher.Subscribe(ctx, servername, request.Rule)}, nil
+}
+
+func (w *webServerLogWatcherStore) listLogs(serverName string) ([]*v1.LogFile, error) {
+	config, ok := w.configs[serverName]
+	opts, has := w.serverOpts[serverName]
+	if !ok || !has {
+		return nil, errors.WithCode(code.ErrConfigurationNotFound, "web server %s is not exist", serverName)
+	}
+	prefix, logsDir := opts.GetPrefix(), w.webServerLogsDirs[serverName]
+	logs := make(map[string]*v1.LogFile)
+	logFile := func(path string) *v1.LogFile {
+		path = resolveLogPath(prefix, path)
+		if file, has := logs[path]; has {
+			return file
+		}
+		file := &v1.LogFile{Name: path, Path: path, Writers: make([]*v1.LogWriter, 0)}
+		// the logs in the logs directory are named by the relative paths, and the others by the absolute paths
+		if rel, err := filepath.Rel(logsDir, path); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			file.Name = rel
+		}
+		logs[path] = file
+		return file
+	}
+	for _, accessLog := range configuration.AccessLogs(config) {
+		file := logFile(accessLog.Path)
+		file.Writers = append(file.Writers, &v1.LogWriter{
+			Directive:   "access_log",
+			ServerNames: accessLog.ServerNames,
+			Location:    accessLog.Location,
+			Format:      accessLog.Format,
+			Position:    accessLog.Position,
+		})
+	}
+	for _, errorLog := range configuration.ErrorLogs(config) {
+		file := logFile(errorLog.Path)
+		file.Writers = append(file.Writers, &v1.LogWriter{
+			Directive:   "error_log",
+			ServerNames: errorLog.ServerNames,
+			Location:    errorLog.Location,
+			Level:       errorLog.Level,
+			Position:    errorLog.Position,
+		})
+	}
+	infos, err := ioutil.ReadDir(logsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "failed to list logs dir of web server %s", serverName)
+	}
+	for _, info := range infos {
+		if info.Mode().IsRegular() {
+			logFile(filepath.Join(logsDir, info.Name()))
+		}
+	}
+
+	list := make([]*v1.LogFile, 0, len(logs))
+	for path, file := range logs {
+		if info, err := os.Stat(path); err == nil {
+			file.Exists = true
+			file.Size = info.Size()
+			file.ModTime = info.ModTime()
+		}
+		list = append(list, file)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+// logFile returns the log of the web server in the list of ListLogs by the name.
+func (w *webServerLogWatcherStore) logFile(serverName, logName string) (*v1.LogFile, error) {
+	logs, err := w.listLogs(serverName)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range logs {
+		if file.Name == logName {
+			return file, nil
+		}
+	}
+	return nil, errors.WithCode(code.ErrLogNotFound, "log '%s' is not one of the logs of web server %s", logName, serverName)
+}
+
+// resolveLogPath resolves the relative path of the log with the prefix of the web server, as nginx does.
+func resolveLogPath(prefix, path string) string {
+	if !filepath.IsAbs(path) {
+		return filepath.Join(prefix, path)
 	}
-	return &v1.WebServerLog{Lines: outputC}, nil
+	return filepath.Clean(path)
 }
 
-func filterOutput(data []byte, pattern string) (error, bool) {
-	if data == nil {
-		return nil, true
+// lineTransformer returns the function filtering the lines by the regexp rule and the field rule, and converting them
+// into the json records if structured is set. The lines not matched by the log format are skipped if the field rule is
+// set, or else converted into the records of the raw lines. Nil is returned if there is nothing to do.
+func (w *webServerLogWatcherStore) lineTransformer(serverName string, file *v1.LogFile, regexpRule, fieldRule string, structured bool) (func(line []byte) ([]byte, bool), error) {
+	var (
+		pattern *regexp.Regexp
+		filter  access_log.Filter
+		parser  *access_log.Parser
+		err     error
+	)
+	if regexpRule != "" {
+		pattern, err = regexp.Compile(regexpRule)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrInvalidLogFilter, "invalid filtering regexp rule '%s'. %s", regexpRule, err.Error())
+		}
 	}
-	match, err := regexp.Match(pattern, data)
+	if fieldRule != "" {
+		filter, err = access_log.ParseFilter(fieldRule)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filter != nil || structured {
+		parser, err = w.accessLogParser(serverName, file)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pattern == nil && parser == nil {
+		return nil, nil
+	}
+
+	return func(line []byte) ([]byte, bool) {
+		if pattern != nil && !pattern.Match(line) {
+			return nil, false
+		}
+		if parser == nil {
+			return line, true
+		}
+		record, ok := parser.Parse(line)
+		if !ok {
+			if filter != nil {
+				return nil, false
+			}
+			record = access_log.Record{access_log.RawField: string(line)}
+		}
+		if filter != nil && !filter.Match(record) {
+			return nil, false
+		}
+		if structured {
+			return record.Bytes(), true
+		}
+		return line, true
+	}, nil
+}
+
+// errorLogTransformer returns the function filtering the error log lines by the field rule and converting them into
+// the json entries if structured is set, or nil if there is nothing to do.
+func errorLogTransformer(fieldRule string, structured bool) (func(line []byte) ([]byte, bool), error) {
+	if fieldRule == "" && !structured {
+		return nil, nil
+	}
+	pipeline, err := error_log.NewPipeline(error_log.Options{Filter: fieldRule, Structured: structured})
 	if err != nil {
-		log.Warnf("web server log watch error. %s", err.Error())
-		return err, true
+		return nil, err
 	}
-	if match {
-		return nil, true
+	return func(line []byte) ([]byte, bool) {
+		// nothing is held by the pipeline without the group window
+		lines := pipeline.Process(line, time.Time{})
+		if len(lines) == 0 {
+			return nil, false
+		}
+		return lines[0], true
+	}, nil
+}
+
+// isErrorLog returns whether the log is written by an `error_log` directive, or named like `error.log` if it is only
+// found in the logs directory.
+func isErrorLog(file *v1.LogFile) bool {
+	for _, writer := range file.Writers {
+		if writer.Directive == "error_log" {
+			return true
+		}
+	}
+	return len(file.Writers) == 0 && strings.Contains(filepath.Base(file.Name), "error")
+}
+
+// accessLogParser compiles the file format of the first `access_log` directive writing to the file.
+func (w *webServerLogWatcherStore) accessLogParser(serverName string, file *v1.LogFile) (*access_log.Parser, error) {
+	for _, writer := range file.Writers {
+		if writer.Directive == "access_log" {
+			return compileLogFormat(w.configs[serverName], writer.Format, file.Name)
+		}
 	}
+	return nil, errors.WithCode(code.ErrLogFormatNotFound, "no access file of web server %s is written to '%s'", serverName, file.Name)
+}
 
-	return nil, false
+// compileLogFormat compiles the log format defined in the config, or the predefined `combined` format.
+func compileLogFormat(config configuration.Configuration, formatName, logName string) (*access_log.Parser, error) {
+	if format, has := configuration.LogFormats(config)[formatName]; has {
+		return access_log.Compile(format.Format, format.Escape)
+	}
+	if formatName == configuration.CombinedLogFormat {
+		return access_log.Compile(access_log.CombinedFormat, "")
+	}
+	return nil, errors.WithCode(code.ErrLogFormatNotFound, "log format '%s' of '%s' is not defined", formatName, logName)
 }
 
 func newWebServerLogWatcherStore(store *webServerStore) *webServerLogWatcherStore {
 	return &webServerLogWatcherStore{
 		watcherManager:    store.wm,
-		webServerLogsDirs: store.logsDirs,
+		webServerLogsDirs: store.serverLogsDirs(),
+		configs:           store.cms.GetConfigs(),
+		serverOpts:        store.serverOptions(),
+		alerter:           store.alerter,
 	}
 }
@@ -0,0 +1,277 @@
+package nginx
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/linter"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/utils"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+type webServerManagerStore struct {
+	store *webServerStore
+}
+
+func (w *webServerManagerStore) List(ctx context.Context) (*v1.ManagedWebServers, error) {
+	w.store.rwLocker.RLock()
+	defer w.store.rwLocker.RUnlock()
+	servers := &v1.ManagedWebServers{Servers: make([]*v1.ManagedWebServer, 0, len(w.store.serverOpts))}
+	for _, opts := range sortedServerOptions(w.store.serverOpts) {
+		servers.Servers = append(servers.Servers, newManagedWebServer(opts))
+	}
+	return servers, nil
+}
+
+func (w *webServerManagerStore) GetStates(ctx context.Context) (*v1.ConfigManagerStates, error) {
+	w.store.rwLocker.RLock()
+	defer w.store.rwLocker.RUnlock()
+	return &v1.ConfigManagerStates{States: w.store.cms.GetStates()}, nil
+}
+
+func (w *webServerManagerStore) Register(ctx context.Context, server *v1.ManagedWebServer) error {
+	opts, l, err := validateManagedWebServer(server)
+	if err != nil {
+		return err
+	}
+	w.store.rwLocker.Lock()
+	defer w.store.rwLocker.Unlock()
+	if _, has := w.store.serverOpts[opts.ServerName]; has {
+		return errors.WithCode(code.ErrWebServerAlreadyExists, "web server '%s' is already registered", opts.ServerName)
+	}
+	err = w.store.cms.Register(newConfigManagerOptions(opts))
+	if err != nil {
+		return err
+	}
+	err = w.apply(opts.ServerName, opts, l)
+	if err != nil {
+		if rollbackErr := w.store.cms.Unregister(opts.ServerName); rollbackErr != nil {
+			log.Warnf("failed to roll back the registration of web server '%s', err: %v", opts.ServerName, rollbackErr)
+		}
+	}
+	return err
+}
+
+func (w *webServerManagerStore) Reconfigure(ctx context.Context, server *v1.ManagedWebServer) error {
+	opts, l, err := validateManagedWebServer(server)
+	if err != nil {
+		return err
+	}
+	w.store.rwLocker.Lock()
+	defer w.store.rwLocker.Unlock()
+	old, has := w.store.serverOpts[opts.ServerName]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "web server '%s' is not registered", opts.ServerName)
+	}
+	err = w.store.cms.Reconfigure(newConfigManagerOptions(opts))
+	if err != nil {
+		return err
+	}
+	err = w.apply(opts.ServerName, opts, l)
+	if err != nil {
+		if rollbackErr := w.store.cms.Reconfigure(newConfigManagerOptions(old)); rollbackErr != nil {
+			log.Warnf("failed to roll back the reconfiguration of web server '%s', err: %v", opts.ServerName, rollbackErr)
+		}
+	}
+	return err
+}
+
+func (w *webServerManagerStore) Unregister(ctx context.Context, servername *v1.ServerName) error {
+	w.store.rwLocker.Lock()
+	defer w.store.rwLocker.Unlock()
+	old, has := w.store.serverOpts[servername.Name]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "web server '%s' is not registered", servername.Name)
+	}
+	err := w.store.cms.Unregister(servername.Name)
+	if err != nil {
+		return err
+	}
+	err = w.apply(servername.Name, nil, nil)
+	if err != nil {
+		if rollbackErr := w.store.cms.Register(newConfigManagerOptions(old)); rollbackErr != nil {
+			log.Warnf("failed to roll back the unregistration of web server '%s', err: %v", servername.Name, rollbackErr)
+		}
+	}
+	return err
+}
+
+// apply persists the servers with the options of the server replaced, or removed if the options is nil, then replaces
+// the servers options, logs dirs and linters of the store. It should be called with the store locked.
+func (w *webServerManagerStore) apply(servername string, opts *genericoptions.WebServerConfigOptions, l linter.Linter) error {
+	serverOpts := make(map[string]*genericoptions.WebServerConfigOptions)
+	logsDirs := make(map[string]string)
+	linters := make(map[string]linter.Linter)
+	for name, itemOpts := range w.store.serverOpts {
+		if name == servername {
+			continue
+		}
+		serverOpts[name] = itemOpts
+		logsDirs[name] = w.store.logsDirs[name]
+		if itemLinter, has := w.store.linters[name]; has {
+			linters[name] = itemLinter
+		}
+	}
+	if opts != nil {
+		serverOpts[servername] = opts
+		logsDirs[servername] = opts.GetLogsDirPath()
+		linters[servername] = l
+	}
+
+	err := saveWebServerState(w.store.stateFile, sortedServerOptions(serverOpts))
+	if err != nil {
+		return err
+	}
+	w.store.serverOpts = serverOpts
+	w.store.logsDirs = logsDirs
+	w.store.linters = linters
+	return nil
+}
+
+var _ storev1.WebServerManagerStore = &webServerManagerStore{}
+
+func newWebServerManagerStore(store *webServerStore) storev1.WebServerManagerStore {
+	return &webServerManagerStore{store: store}
+}
+
+func validateManagedWebServer(server *v1.ManagedWebServer) (*genericoptions.WebServerConfigOptions, linter.Linter, error) {
+	opts := genericoptions.NewWebServerConfigOptions()
+	opts.ServerName = server.ServerName
+	if server.ServerType != "" {
+		opts.ServerType = server.ServerType
+	}
+	opts.ConfigPath = server.ConfigPath
+	opts.VerifyExecPath = server.VerifyExecPath
+	opts.Prefix = server.Prefix
+	opts.ExtraArgs = server.ExtraArgs
+	opts.LogsDirPath = server.LogsDirPath
+	if opts.LogsDirPath == "" {
+		opts.LogsDirPath = opts.GetLogsDirPath()
+	}
+	opts.BackupDir = server.BackupDir
+	opts.BackupCycle = server.BackupCycle
+	opts.BackupSaveTime = server.BackupSaveTime
+	opts.ReloadInterval = server.ReloadInterval
+	opts.ReloadDebounce = server.ReloadDebounce
+	opts.SaveInterval = server.SaveInterval
+	opts.ConflictPolicy = server.ConflictPolicy
+	opts.FileMode = server.FileMode
+	opts.LintRules = server.LintRules
+
+	if errs := opts.Validate(); len(errs) > 0 {
+		return nil, nil, errors.WithCode(code.ErrValidation, errors.NewAggregate(errs).Error())
+	}
+	l, err := linter.New(linter.DefaultRegistry(), opts.LintRules)
+	if err != nil {
+		return nil, nil, err
+	}
+	return opts, l, nil
+}
+
+func newManagedWebServer(opts *genericoptions.WebServerConfigOptions) *v1.ManagedWebServer {
+	return &v1.ManagedWebServer{
+		ServerName:     opts.ServerName,
+		ServerType:     opts.ServerType,
+		ConfigPath:     opts.ConfigPath,
+		VerifyExecPath: opts.VerifyExecPath,
+		LogsDirPath:    opts.LogsDirPath,
+		Prefix:         opts.Prefix,
+		ExtraArgs:      opts.ExtraArgs,
+		BackupDir:      opts.BackupDir,
+		BackupCycle:    opts.BackupCycle,
+		BackupSaveTime: opts.BackupSaveTime,
+		ReloadInterval: opts.ReloadInterval,
+		ReloadDebounce: opts.ReloadDebounce,
+		SaveInterval:   opts.SaveInterval,
+		ConflictPolicy: opts.ConflictPolicy,
+		FileMode:       opts.FileMode,
+		LintRules:      opts.LintRules,
+	}
+}
+
+func newConfigManagerOptions(opts *genericoptions.WebServerConfigOptions) nginx.ConfigManagerOptions {
+	// the file mode has been validated
+	fileMode, _ := opts.GetFileMode()
+	return nginx.ConfigManagerOptions{
+		ServerName:     opts.ServerName,
+		MainConfigPath: opts.GetConfigPath(),
+		ServerBinPath:  opts.VerifyExecPath,
+		BackupDir:      opts.BackupDir,
+		BackupCycle:    opts.BackupCycle,
+		BackupSaveTime: opts.BackupSaveTime,
+		ReloadInterval: opts.ReloadInterval,
+		ReloadDebounce: opts.ReloadDebounce,
+		SaveInterval:   opts.SaveInterval,
+		ConflictPolicy: v1.ConfigConflictPolicy(opts.ConflictPolicy),
+		FileMode:       fileMode,
+		Prefix:         opts.Prefix,
+		ExtraArgs:      opts.ExtraArgs,
+	}
+}
+
+func sortedServerOptions(serverOpts map[string]*genericoptions.WebServerConfigOptions) []*genericoptions.WebServerConfigOptions {
+	items := make([]*genericoptions.WebServerConfigOptions, 0, len(serverOpts))
+	for _, opts := range serverOpts {
+		items = append(items, opts)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].ServerName < items[j].ServerName
+	})
+	return items
+}
+
+type webServerState struct {
+	WebServerConfigs []*genericoptions.WebServerConfigOptions `json:"items"`
+}
+
+// loadWebServerState reads the web servers persisted in the state file, false will be returned if the state file is
+// disabled or not exist.
+func loadWebServerState(stateFile string) ([]*genericoptions.WebServerConfigOptions, bool, error) {
+	if strings.TrimSpace(stateFile) == "" {
+		return nil, false, nil
+	}
+	data, err := ioutil.ReadFile(stateFile)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to read the web server state file '%s'", stateFile)
+	}
+	state := new(webServerState)
+	err = json.Unmarshal(data, state)
+	if err != nil {
+		return nil, false, errors.WithCode(code.ErrDecodingJSON, "invalid web server state file '%s': %v", stateFile, err)
+	}
+	for _, opts := range state.WebServerConfigs {
+		if errs := opts.Validate(); len(errs) > 0 {
+			return nil, false, errors.Wrapf(errors.NewAggregate(errs), "invalid web server '%s' in the state file '%s'", opts.ServerName, stateFile)
+		}
+	}
+	return state.WebServerConfigs, true, nil
+}
+
+// saveWebServerState writes the web servers to the state file atomically, so that the state file is never left half
+// written.
+func saveWebServerState(stateFile string, items []*genericoptions.WebServerConfigOptions) error {
+	if strings.TrimSpace(stateFile) == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(&webServerState{WebServerConfigs: items}, "", "  ")
+	if err != nil {
+		return errors.WithCode(code.ErrEncodingJSON, err.Error())
+	}
+	err = utils.WriteFileAtomic(stateFile, data, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write the web server state file '%s'", stateFile)
+	}
+	return nil
+}
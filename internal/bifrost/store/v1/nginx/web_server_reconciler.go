@@ -0,0 +1,118 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/marmotedu/errors"
+	"sort"
+	"time"
+)
+
+type webServerReconcilerStore struct {
+	cms nginx.ConfigsManager
+	dir string
+}
+
+func (w *webServerReconcilerStore) Plan(ctx context.Context) (*v1.ReconcilePlans, error) {
+	return w.reconcile(false)
+}
+
+// Apply applies the changes to the configurations, which are saved and checked at once by the config managers, and
+// rolled back if the web servers reject them.
+func (w *webServerReconcilerStore) Apply(ctx context.Context) (*v1.ReconcilePlans, error) {
+	return w.reconcile(true)
+}
+
+func (w *webServerReconcilerStore) reconcile(apply bool) (*v1.ReconcilePlans, error) {
+	if w.dir == "" {
+		return nil, errors.WithCode(code.ErrInvalidSiteDefinition, "the directory of the site definitions is not set")
+	}
+	definitions, err := configuration.LoadSiteDefinitions(w.dir)
+	if err != nil {
+		return nil, err
+	}
+	servernames := make([]string, 0, len(definitions))
+	for servername := range definitions {
+		servernames = append(servernames, servername)
+	}
+	sort.Strings(servernames)
+
+	configs := w.cms.GetConfigs()
+	plans := &v1.ReconcilePlans{Plans: make([]*v1.ReconcilePlan, 0, len(servernames))}
+	for _, servername := range servernames {
+		config, has := configs[servername]
+		if !has {
+			return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", servername)
+		}
+		r := configuration.NewReconciler(config, definitions[servername])
+		var plan *v1.ReconcilePlan
+		if apply {
+			plan, err = r.Apply(func() error {
+				return w.cms.SaveWithCheck(servername)
+			})
+		} else {
+			plan, err = r.Plan()
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to reconcile nginx server '%s'", servername)
+		}
+		plans.Plans = append(plans.Plans, plan)
+	}
+	return plans, nil
+}
+
+// regularlyReconcile reconciles the web servers at the interval until the context is done, the drift is reported
+// in the log, and the changes are only reported if planOnly is set.
+func (w *webServerReconcilerStore) regularlyReconcile(ctx context.Context, interval time.Duration, planOnly bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		plans, err := w.reconcile(false)
+		if err != nil {
+			log.Errorf("failed to plan the reconciling of the web servers, %+v", err)
+			continue
+		}
+		for _, plan := range plans.Plans {
+			for _, change := range plan.Changes {
+				if change.Drifted {
+					log.Warnf("the %s '%s' of web server '%s' has drifted from the site definitions", change.Kind, change.Name, plan.ServerName)
+				}
+				if change.Action == v1.ReconcileActionConflict {
+					log.Warnf("the %s '%s' of web server '%s' conflicts with an unmanaged block at %s", change.Kind, change.Name, plan.ServerName, change.Position)
+				}
+			}
+		}
+		if planOnly {
+			continue
+		}
+		plans, err = w.reconcile(true)
+		if err != nil {
+			log.Errorf("failed to reconcile the web servers, %+v", err)
+			continue
+		}
+		for _, plan := range plans.Plans {
+			if plan.Applied {
+				log.Infof("web server '%s' is reconciled with %d change(s)", plan.ServerName, len(plan.Changes))
+			}
+		}
+	}
+}
+
+var _ storev1.WebServerReconcilerStore = &webServerReconcilerStore{}
+
+func newWebServerReconcilerStore(store *webServerStore) *webServerReconcilerStore {
+	return &webServerReconcilerStore{
+		cms: store.cms,
+		dir: store.reconcilerDir,
+	}
+}
@@ -0,0 +1,35 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/marmotedu/errors"
+)
+
+type webServerRouteSimulatorStore struct {
+	simulators map[string]configuration.RouteSimulator
+}
+
+func (w *webServerRouteSimulatorStore) Simulate(ctx context.Context, request *v1.RouteRequest) (*v1.RouteResult, error) {
+	if request.ServerName == nil {
+		return nil, errors.WithCode(code.ErrValidation, "the web server name of the request is missing")
+	}
+	if simulator, has := w.simulators[request.ServerName.Name]; has {
+		return simulator.Simulate(request.Host, request.Port, request.Scheme, request.URI)
+	}
+	return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", request.ServerName.Name)
+}
+
+var _ storev1.WebServerRouteSimulatorStore = &webServerRouteSimulatorStore{}
+
+func newWebServerRouteSimulatorStore(store *webServerStore) storev1.WebServerRouteSimulatorStore {
+	simulators := make(map[string]configuration.RouteSimulator)
+	for servername, config := range store.cms.GetConfigs() {
+		simulators[servername] = configuration.NewRouteSimulator(config)
+	}
+
+	return &webServerRouteSimulatorStore{simulators: simulators}
+}
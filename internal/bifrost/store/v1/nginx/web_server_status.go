@@ -3,24 +3,38 @@ package nginx
 import (
 	"context"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
 	"github.com/ClessLi/bifrost/internal/pkg/monitor"
+	"github.com/ClessLi/bifrost/internal/pkg/traffic_stats"
 	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
 	"github.com/marmotedu/component-base/pkg/version"
+	"github.com/marmotedu/errors"
 	"github.com/shirou/gopsutil/host"
 	"time"
 )
 
 const webServerStatusTimeFormatLayout = "2006/01/02 15:04:05"
 
+// the interval to send the traffic stats by default, and the min one
+const (
+	defaultTrafficStatsInterval = 5 * time.Second
+	minTrafficStatsInterval     = time.Second
+)
+
 type webServerStatusStore struct {
-	m                  monitor.Monitor
-	webServerInfosFunc func() []*v1.WebServerInfo
-	os                 string
-	bifrostVersion     string
+	systemInfoFunc          func() monitor.SystemInfo
+	webServerInfosFunc      func() []*v1.WebServerInfo
+	certificateWarningsFunc func() []*v1.CertificateWarning
+	configConflictsFunc     func() []*v1.ConfigConflict
+	configsFunc             func() map[string]configuration.Configuration
+	traffic                 *traffic_stats.Aggregator
+	os                      string
+	bifrostVersion          string
 }
 
 func (w *webServerStatusStore) Get(ctx context.Context) (*v1.Metrics, error) {
-	sysInfo := w.m.Report()
+	sysInfo := w.systemInfoFunc()
 	return &v1.Metrics{
 		OS:             w.os,
 		Time:           time.Now().In(time.Local).Format(webServerStatusTimeFormatLayout),
@@ -29,9 +43,56 @@ func (w *webServerStatusStore) Get(ctx context.Context) (*v1.Metrics, error) {
 		Disk:           sysInfo.DiskUsePct,
 		StatusList:     w.webServerInfosFunc(),
 		BifrostVersion: w.bifrostVersion,
+
+		CertificateWarnings: w.certificateWarningsFunc(),
+		ConfigConflicts:     w.configConflictsFunc(),
 	}, nil
 }
 
+// TrafficStats sends the traffic stats of the web server at the interval, until the context is done. The traffic stats
+// of all web servers will be sent, if the request server name is empty.
+func (w *webServerStatusStore) TrafficStats(ctx context.Context, request *v1.WebServerTrafficStatsRequest) (*v1.WebServerTrafficStats, error) {
+	if w.traffic == nil {
+		return nil, errors.WithCode(code.ErrTrafficStatsDisabled, "traffic stats are disabled, see --web-server-traffic.enabled")
+	}
+	servername := ""
+	if request.ServerName != nil && request.ServerName.Name != "" {
+		servername = request.ServerName.Name
+		if _, has := w.configsFunc()[servername]; !has {
+			return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", servername)
+		}
+	}
+	interval := request.Interval
+	if interval <= 0 {
+		interval = defaultTrafficStatsInterval
+	} else if interval < minTrafficStatsInterval {
+		interval = minTrafficStatsInterval
+	}
+
+	statsC := make(chan *v1.TrafficStats)
+	go func() {
+		defer close(statsC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			for _, stats := range w.traffic.Stats(servername, time.Now()) {
+				select {
+				case statsC <- stats:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return &v1.WebServerTrafficStats{Stats: statsC}, nil
+}
+
 func newWebServerStatusStore(store *webServerStore) *webServerStatusStore {
 	// get os release info
 	var os string
@@ -42,10 +103,18 @@ func newWebServerStatusStore(store *webServerStore) *webServerStatusStore {
 	} else {
 		os = platform + " " + release
 	}
+	var traffic *traffic_stats.Aggregator
+	if store.traffic != nil {
+		traffic = store.traffic.aggregator
+	}
 	return &webServerStatusStore{
-		m:                  store.m,
-		webServerInfosFunc: store.cms.GetServerInfos,
-		os:                 os,
-		bifrostVersion:     version.GitVersion,
+		systemInfoFunc:          store.systemInfo,
+		webServerInfosFunc:      store.cms.GetServerInfos,
+		certificateWarningsFunc: store.certificateWarnings,
+		configConflictsFunc:     store.cms.GetConflicts,
+		configsFunc:             store.cms.GetConfigs,
+		traffic:                 traffic,
+		os:                      os,
+		bifrostVersion:          version.GitVersion,
 	}
 }
@@ -0,0 +1,85 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/template"
+	"github.com/marmotedu/errors"
+)
+
+type webServerTemplateStore struct {
+	cms nginx.ConfigsManager
+	dir string
+}
+
+func (w *webServerTemplateStore) List(ctx context.Context) (*v1.WebServerTemplates, error) {
+	templates, err := template.LoadDir(w.dir)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1.WebServerTemplates{Templates: make([]*v1.WebServerTemplate, 0, len(templates))}
+	for _, t := range templates {
+		list.Templates = append(list.Templates, t.Info())
+	}
+	return list, nil
+}
+
+// Apply renders the template and appends the snippet to the configuration, which is saved at once, checked by the web
+// server and rolled back if it is rejected.
+func (w *webServerTemplateStore) Apply(ctx context.Context, request *v1.WebServerTemplateRequest) (*v1.WebServerTemplateResult, error) {
+	config, has := w.cms.GetConfigs()[request.ServerName]
+	if !has {
+		return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", request.ServerName)
+	}
+	t, err := template.Get(w.dir, request.TemplateName)
+	if err != nil {
+		return nil, err
+	}
+	target := request.Target
+	if target == "" {
+		target = t.Target
+	}
+	snippet, err := t.Render(request.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.WebServerTemplateResult{
+		ServerName:   request.ServerName,
+		TemplateName: t.Name,
+		Target:       target,
+		Snippet:      string(snippet),
+	}
+	if request.DryRun {
+		if _, err = config.Query(target); err != nil {
+			return nil, err
+		}
+		if _, err = t.RenderParsers(request.Params); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	err = config.AppendSnippetByKeyword(snippet, target)
+	if err != nil {
+		return nil, err
+	}
+	err = w.cms.SaveWithCheck(request.ServerName)
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) && !errors.IsCode(err, code.ErrSameConfigFingerprints) {
+		return nil, errors.Wrapf(err, "failed to save the snippet of template '%s' to nginx server '%s'", t.Name, request.ServerName)
+	}
+	result.Applied = true
+	return result, nil
+}
+
+var _ storev1.WebServerTemplateStore = &webServerTemplateStore{}
+
+func newWebServerTemplateStore(store *webServerStore) storev1.WebServerTemplateStore {
+	return &webServerTemplateStore{
+		cms: store.cms,
+		dir: store.templateDir,
+	}
+}
@@ -0,0 +1,85 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testTemplate = `name: tls-redirect
+target: http
+parameters:
+  - name: server_name
+    type: string
+    required: true
+content: |
+  server {
+      listen 80;
+      server_name {{ .server_name }};
+      return 301 https://$host$request_uri;
+  }
+`
+
+func TestWebServerTemplateStore_Apply(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-template-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	templateDir := filepath.Join(dir, "templates")
+	if err = os.Mkdir(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(templateDir, "tls-redirect.yml"), []byte(testTemplate), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	accepted, rejected := newTestWebServerConfig(t, dir, "accepted"), newTestWebServerConfig(t, dir, "rejected")
+	rejected.VerifyExecPath = "/bin/false"
+	store, closeStore := newTestWebServerStore(t, accepted, rejected)
+	defer closeStore()
+	store.templateDir = templateDir
+	templates := newWebServerTemplateStore(store)
+
+	apply := func(servername string) (*v1.WebServerTemplateResult, error) {
+		return templates.Apply(context.Background(), &v1.WebServerTemplateRequest{
+			ServerName:   servername,
+			TemplateName: "tls-redirect",
+			Params:       map[string]string{"server_name": "example.com"},
+		})
+	}
+
+	// the applied snippet is saved at once, instead of waiting for the save interval
+	result, err := apply("accepted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Applied {
+		t.Errorf("the template is not applied")
+	}
+	data, err := ioutil.ReadFile(accepted.ConfigPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "server_name example.com;") {
+		t.Errorf("the applied snippet is not saved, config file:\n%s", data)
+	}
+
+	// the snippet rejected by the web server is rolled back, and the error is returned
+	if _, err = apply("rejected"); err == nil {
+		t.Fatal("applying the template rejected by the web server want an error")
+	}
+	if view := string(store.cms.GetConfigs()["rejected"].View()); strings.Contains(view, "example.com") {
+		t.Errorf("the rejected snippet is not rolled back, config:\n%s", view)
+	}
+	if data, err = ioutil.ReadFile(rejected.ConfigPath); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "example.com") {
+		t.Errorf("the rejected snippet is saved, config file:\n%s", data)
+	}
+}
@@ -0,0 +1,161 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"github.com/ClessLi/bifrost/internal/pkg/traffic_stats"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"sync"
+	"time"
+)
+
+// trafficRewatchDelay is the delay to watch the access log again after it failed to be watched.
+const trafficRewatchDelay = 10 * time.Second
+
+type accessLogTail struct {
+	format string
+	cancel context.CancelFunc
+}
+
+// trafficCollector tails the access logs of the web servers, and aggregates the requests into the traffic stats.
+type trafficCollector struct {
+	store      *webServerStore
+	aggregator *traffic_stats.Aggregator
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// the tails are keyed by the server name and the path of the access log, and changed by the resyncing only
+	mu    sync.Mutex
+	tails map[string]map[string]*accessLogTail
+}
+
+// run resyncs the tailed access logs with the configs of the web servers regularly, until the collector is stopped.
+func (t *trafficCollector) run(resyncInterval time.Duration) {
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+	for {
+		t.resync()
+		select {
+		case <-ticker.C:
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *trafficCollector) stop() {
+	t.cancel()
+}
+
+// resync starts tailing the access logs added to the configs, and stops tailing the removed ones. The access log is
+// tailed again if its log format changed.
+func (t *trafficCollector) resync() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	serverOpts := t.store.serverOptions()
+	configs := t.store.cms.GetConfigs()
+	for servername, config := range configs {
+		opts, has := serverOpts[servername]
+		if !has {
+			continue
+		}
+		tails := t.tails[servername]
+		if tails == nil {
+			tails = make(map[string]*accessLogTail)
+			t.tails[servername] = tails
+		}
+		logs := accessLogsByPath(config, opts.GetPrefix())
+		for path, tail := range tails {
+			if accessLog, has := logs[path]; !has || accessLog.Format != tail.format {
+				tail.cancel()
+				delete(tails, path)
+			}
+		}
+		for path, accessLog := range logs {
+			if _, has := tails[path]; has {
+				continue
+			}
+			parser, err := compileLogFormat(config, accessLog.Format, path)
+			if err != nil {
+				log.Warnf("failed to collect the traffic of web server '%s' from '%s'. %s", servername, path, err.Error())
+				continue
+			}
+			ctx, cancel := context.WithCancel(t.ctx)
+			tails[path] = &accessLogTail{format: accessLog.Format, cancel: cancel}
+			go t.tail(ctx, servername, path, parser, accessLog.ServerNames)
+		}
+	}
+	for servername, tails := range t.tails {
+		if _, has := configs[servername]; has {
+			continue
+		}
+		for _, tail := range tails {
+			tail.cancel()
+		}
+		delete(t.tails, servername)
+		t.aggregator.Remove(servername)
+	}
+}
+
+// tail adds the requests of the access log into the aggregator, until the context is done. The access log is watched
+// again when the output of the watcher is closed by the watch timeout.
+func (t *trafficCollector) tail(ctx context.Context, servername, path string, parser *access_log.Parser, serverNames []string) {
+	for {
+		lines, err := t.store.wm.Watch(ctx, path)
+		if err != nil {
+			log.Warnf("failed to watch '%s' for the traffic of web server '%s'. %s", path, servername, err.Error())
+			select {
+			case <-time.After(trafficRewatchDelay):
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		for line := range lines {
+			if record, ok := parser.Parse(line); ok {
+				t.aggregator.Add(servername, record, serverNames, time.Now())
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// accessLogsByPath returns the access logs of the config by the absolute paths, the relative paths are resolved with
+// the prefix of the web server. The server names of the access log written by several servers are merged, and they
+// are dropped if the access log is written by the `http` context.
+func accessLogsByPath(config configuration.Configuration, prefix string) map[string]*v1.AccessLog {
+	logs := make(map[string]*v1.AccessLog)
+	for _, accessLog := range configuration.AccessLogs(config) {
+		path := resolveLogPath(prefix, accessLog.Path)
+		merged, has := logs[path]
+		if !has {
+			accessLog.Path = path
+			logs[path] = accessLog
+			continue
+		}
+		if merged.ServerNames != nil {
+			if accessLog.ServerNames == nil {
+				merged.ServerNames = nil
+			} else {
+				merged.ServerNames = append(merged.ServerNames, accessLog.ServerNames...)
+			}
+		}
+	}
+	return logs
+}
+
+func newTrafficCollector(store *webServerStore, window time.Duration) *trafficCollector {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &trafficCollector{
+		store:      store,
+		aggregator: traffic_stats.NewAggregator(window),
+		ctx:        ctx,
+		cancel:     cancel,
+		tails:      make(map[string]map[string]*accessLogTail),
+	}
+}
@@ -0,0 +1,84 @@
+package nginx
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	storev1 "github.com/ClessLi/bifrost/internal/bifrost/store/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/marmotedu/errors"
+)
+
+type webServerUpstreamStore struct {
+	cms nginx.ConfigsManager
+}
+
+func (w *webServerUpstreamStore) List(ctx context.Context, servername *v1.ServerName) (*v1.Upstreams, error) {
+	config, has := w.cms.GetConfigs()[servername.Name]
+	if !has {
+		return nil, errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", servername.Name)
+	}
+	return &v1.Upstreams{
+		ServerName: servername.Name,
+		Upstreams:  configuration.NewUpstreamManager(config).List(),
+	}, nil
+}
+
+func (w *webServerUpstreamStore) AddMember(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.change(request, func(manager configuration.UpstreamManager) error {
+		return manager.AddMember(request.Upstream, request.Member)
+	})
+}
+
+func (w *webServerUpstreamStore) RemoveMember(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.change(request, func(manager configuration.UpstreamManager) error {
+		return manager.RemoveMember(request.Upstream, request.Member.Address)
+	})
+}
+
+func (w *webServerUpstreamStore) SetWeight(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.change(request, func(manager configuration.UpstreamManager) error {
+		return manager.SetWeight(request.Upstream, request.Member.Address, request.Member.Weight)
+	})
+}
+
+func (w *webServerUpstreamStore) SetState(ctx context.Context, request *v1.UpstreamMemberRequest) error {
+	return w.change(request, func(manager configuration.UpstreamManager) error {
+		return manager.SetState(request.Upstream, request.Member.Address, request.Member.State)
+	})
+}
+
+// change changes the upstream, and saves the configuration at once, which is checked by the web server and rolled back
+// if it is rejected. The web server is reloaded after the configuration is saved, if it is requested.
+func (w *webServerUpstreamStore) change(request *v1.UpstreamMemberRequest, changeFunc func(manager configuration.UpstreamManager) error) error {
+	if request.Member == nil {
+		return errors.WithCode(code.ErrInvalidUpstreamMember, "the member of the request is empty")
+	}
+	config, has := w.cms.GetConfigs()[request.ServerName]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "nginx server config '%s' not found", request.ServerName)
+	}
+	err := changeFunc(configuration.NewUpstreamManager(config))
+	if err != nil {
+		return err
+	}
+
+	err = w.cms.SaveWithCheck(request.ServerName)
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) && !errors.IsCode(err, code.ErrSameConfigFingerprints) {
+		return errors.Wrapf(err, "failed to save the changed upstream '%s' of nginx server '%s'", request.Upstream, request.ServerName)
+	}
+	if request.Reload {
+		err = w.cms.ServerReload(request.ServerName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reload nginx server '%s'", request.ServerName)
+		}
+	}
+	return nil
+}
+
+var _ storev1.WebServerUpstreamStore = &webServerUpstreamStore{}
+
+func newWebServerUpstreamStore(store *webServerStore) storev1.WebServerUpstreamStore {
+	return &webServerUpstreamStore{cms: store.cms}
+}
@@ -1,5 +1,7 @@
 package v1
 
+import genericoptions "github.com/ClessLi/bifrost/internal/pkg/options"
+
 var client StoreFactory
 
 type StoreFactory interface {
@@ -7,6 +9,16 @@ type StoreFactory interface {
 	WebServerStatistics() WebServerStatisticsStore
 	WebServerStatus() WebServerStatusStore
 	WebServerLogWatcher() WebServerLogWatcher
+	WebServerCertificate() WebServerCertificateStore
+	WebServerLinter() WebServerLinterStore
+	WebServerRouteSimulator() WebServerRouteSimulatorStore
+	WebServerManager() WebServerManagerStore
+	WebServerTemplate() WebServerTemplateStore
+	WebServerReconciler() WebServerReconcilerStore
+	WebServerUpstream() WebServerUpstreamStore
+	ReloadWebServerConfigs(oldOpts, newOpts *genericoptions.WebServerConfigsOptions) error
+	ReloadMonitor(opts *genericoptions.MonitorOptions) error
+	ReloadWebServerLogWatcher(opts *genericoptions.WebServerLogWatcherOptions) error
 	Close() error
 }
 
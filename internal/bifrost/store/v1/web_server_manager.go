@@ -0,0 +1,14 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerManagerStore interface {
+	List(ctx context.Context) (*v1.ManagedWebServers, error)
+	Register(ctx context.Context, server *v1.ManagedWebServer) error
+	Reconfigure(ctx context.Context, server *v1.ManagedWebServer) error
+	Unregister(ctx context.Context, servername *v1.ServerName) error
+	GetStates(ctx context.Context) (*v1.ConfigManagerStates, error)
+}
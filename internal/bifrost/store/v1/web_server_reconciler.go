@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerReconcilerStore interface {
+	Plan(ctx context.Context) (*v1.ReconcilePlans, error)
+	Apply(ctx context.Context) (*v1.ReconcilePlans, error)
+}
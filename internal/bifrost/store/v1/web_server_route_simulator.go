@@ -0,0 +1,10 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerRouteSimulatorStore interface {
+	Simulate(ctx context.Context, request *v1.RouteRequest) (*v1.RouteResult, error)
+}
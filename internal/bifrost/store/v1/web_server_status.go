@@ -7,4 +7,5 @@ import (
 
 type WebServerStatusStore interface {
 	Get(ctx context.Context) (*v1.Metrics, error)
+	TrafficStats(ctx context.Context, request *v1.WebServerTrafficStatsRequest) (*v1.WebServerTrafficStats, error)
 }
@@ -0,0 +1,11 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerTemplateStore interface {
+	List(ctx context.Context) (*v1.WebServerTemplates, error)
+	Apply(ctx context.Context, request *v1.WebServerTemplateRequest) (*v1.WebServerTemplateResult, error)
+}
@@ -0,0 +1,14 @@
+package v1
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+)
+
+type WebServerUpstreamStore interface {
+	List(ctx context.Context, servername *v1.ServerName) (*v1.Upstreams, error)
+	AddMember(ctx context.Context, request *v1.UpstreamMemberRequest) error
+	RemoveMember(ctx context.Context, request *v1.UpstreamMemberRequest) error
+	SetWeight(ctx context.Context, request *v1.UpstreamMemberRequest) error
+	SetState(ctx context.Context, request *v1.UpstreamMemberRequest) error
+}
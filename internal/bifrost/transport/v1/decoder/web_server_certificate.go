@@ -0,0 +1,32 @@
+package decoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"time"
+)
+
+type webServerCertificate struct{}
+
+var _ Decoder = webServerCertificate{}
+
+func (w webServerCertificate) DecodeRequest(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *pbv1.ServerName: // decode `Get` request
+		return &v1.ServerName{Name: r.GetName()}, nil
+	case *pbv1.CertificateWatchRequest: // decode `WatchExpiry` request
+		return &v1.WebServerCertificateWatchRequest{
+			ServerName:    &v1.ServerName{Name: r.GetServerName()},
+			ExpiryWarning: time.Duration(r.GetExpiryWarningSeconds()) * time.Second,
+		}, nil
+	default:
+		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
+	}
+}
+
+func NewWebServerCertificateDecoder() Decoder {
+	return new(webServerCertificate)
+}
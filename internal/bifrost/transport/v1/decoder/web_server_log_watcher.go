@@ -6,6 +6,7 @@ import (
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/ClessLi/bifrost/internal/pkg/code"
 	"github.com/marmotedu/errors"
+	"time"
 )
 
 type webServerLogWatcher struct{}
@@ -18,7 +19,45 @@ func (w webServerLogWatcher) DecodeRequest(ctx context.Context, r interface{}) (
 		return &v1.WebServerLogWatchRequest{
 			ServerName:          &v1.ServerName{Name: r.ServerName},
 			LogName:             r.LogName,
+			LogNames:            r.LogNames,
 			FilteringRegexpRule: r.FilterRule,
+			FilteringFieldRule:  r.FieldFilterRule,
+			Structured:          r.Structured,
+			MinSeverity:         r.MinSeverity,
+			GroupWindow:         time.Duration(r.GroupWindowSeconds) * time.Second,
+			UpstreamEvents:      r.UpstreamEvents,
+			Cursor:              r.Cursor,
+		}, nil
+	case *pbv1.LogQueryRequest: // decode `QueryLogs` request
+		req := &v1.WebServerLogQueryRequest{
+			ServerName:          &v1.ServerName{Name: r.ServerName},
+			LogName:             r.LogName,
+			Offset:              r.Offset,
+			Limit:               int(r.Limit),
+			Reverse:             r.Reverse,
+			FilteringRegexpRule: r.FilterRule,
+			FilteringFieldRule:  r.FieldFilterRule,
+			Structured:          r.Structured,
+		}
+		if r.Since != 0 {
+			req.Since = time.Unix(0, r.Since)
+		}
+		if r.Until != 0 {
+			req.Until = time.Unix(0, r.Until)
+		}
+		return req, nil
+	case *pbv1.ServerName: // decode `ListLogs` request
+		return &v1.ServerName{Name: r.GetName()}, nil
+	case *pbv1.LogAlertsRequest: // decode `Alerts` request
+		return &v1.WebServerLogAlertsRequest{
+			ServerName: &v1.ServerName{Name: r.GetServerName()},
+			Rule:       r.GetRule(),
+		}, nil
+	case *pbv1.LogDownloadRequest: // decode `DownloadLog` request
+		return &v1.WebServerLogDownloadRequest{
+			ServerName: &v1.ServerName{Name: r.GetServerName()},
+			LogNames:   r.GetLogNames(),
+			Archive:    r.GetArchive(),
 		}, nil
 	default:
 		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
@@ -0,0 +1,48 @@
+package decoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"time"
+)
+
+type webServerManager struct{}
+
+var _ Decoder = webServerManager{}
+
+func (w webServerManager) DecodeRequest(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *pbv1.Null: // decode `List` and `GetStates` request
+		return r, nil
+	case *pbv1.ManagedWebServer: // decode `Register` and `Reconfigure` request
+		return &v1.ManagedWebServer{
+			ServerName:     r.GetServerName(),
+			ServerType:     r.GetServerType(),
+			ConfigPath:     r.GetConfigPath(),
+			VerifyExecPath: r.GetVerifyExecPath(),
+			LogsDirPath:    r.GetLogsDirPath(),
+			BackupDir:      r.GetBackupDir(),
+			BackupCycle:    int(r.GetBackupCycle()),
+			BackupSaveTime: int(r.GetBackupSaveTime()),
+			ReloadInterval: time.Duration(r.GetReloadInterval()),
+			ReloadDebounce: time.Duration(r.GetReloadDebounce()),
+			SaveInterval:   time.Duration(r.GetSaveInterval()),
+			ConflictPolicy: r.GetConflictPolicy(),
+			FileMode:       r.GetFileMode(),
+			Prefix:         r.GetPrefix(),
+			ExtraArgs:      r.GetExtraArgs(),
+			LintRules:      r.GetLintRules(),
+		}, nil
+	case *pbv1.ServerName: // decode `Unregister` request
+		return &v1.ServerName{Name: r.GetName()}, nil
+	default:
+		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
+	}
+}
+
+func NewWebServerManagerDecoder() Decoder {
+	return new(webServerManager)
+}
@@ -0,0 +1,25 @@
+package decoder
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerReconciler struct{}
+
+var _ Decoder = webServerReconciler{}
+
+func (w webServerReconciler) DecodeRequest(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *pbv1.Null: // decode `Plan` and `Apply` request
+		return r, nil
+	default:
+		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
+	}
+}
+
+func NewWebServerReconcilerDecoder() Decoder {
+	return new(webServerReconciler)
+}
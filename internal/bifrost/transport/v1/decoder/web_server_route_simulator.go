@@ -0,0 +1,32 @@
+package decoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerRouteSimulator struct{}
+
+var _ Decoder = webServerRouteSimulator{}
+
+func (w webServerRouteSimulator) DecodeRequest(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *pbv1.RouteRequest: // decode `Simulate` request
+		return &v1.RouteRequest{
+			ServerName: &v1.ServerName{Name: r.GetServerName()},
+			Host:       r.GetHost(),
+			Port:       int(r.GetPort()),
+			Scheme:     r.GetScheme(),
+			URI:        r.GetURI(),
+		}, nil
+	default:
+		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
+	}
+}
+
+func NewWebServerRouteSimulatorDecoder() Decoder {
+	return new(webServerRouteSimulator)
+}
@@ -2,9 +2,11 @@ package decoder
 
 import (
 	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/ClessLi/bifrost/internal/pkg/code"
 	"github.com/marmotedu/errors"
+	"time"
 )
 
 type webServerStatus struct{}
@@ -13,8 +15,13 @@ var _ Decoder = webServerStatus{}
 
 func (w webServerStatus) DecodeRequest(ctx context.Context, r interface{}) (interface{}, error) {
 	switch r := r.(type) {
-	case *pbv1.Null:
+	case *pbv1.Null: // decode `Get` request
 		return r, nil
+	case *pbv1.TrafficStatsRequest: // decode `TrafficStats` request
+		return &v1.WebServerTrafficStatsRequest{
+			ServerName: &v1.ServerName{Name: r.GetServerName()},
+			Interval:   time.Duration(r.GetIntervalSeconds()) * time.Second,
+		}, nil
 	default:
 		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
 	}
@@ -0,0 +1,34 @@
+package decoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerTemplate struct{}
+
+var _ Decoder = webServerTemplate{}
+
+func (w webServerTemplate) DecodeRequest(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *pbv1.Null: // decode `List` request
+		return r, nil
+	case *pbv1.TemplateApplyRequest: // decode `Apply` request
+		return &v1.WebServerTemplateRequest{
+			ServerName:   r.GetServerName(),
+			TemplateName: r.GetTemplateName(),
+			Params:       r.GetParams(),
+			Target:       r.GetTarget(),
+			DryRun:       r.GetDryRun(),
+		}, nil
+	default:
+		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
+	}
+}
+
+func NewWebServerTemplateDecoder() Decoder {
+	return new(webServerTemplate)
+}
@@ -0,0 +1,38 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerUpstream struct{}
+
+var _ Decoder = webServerUpstream{}
+
+func (w webServerUpstream) DecodeRequest(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *pbv1.ServerName: // decode `List` request
+		return &v1.ServerName{Name: r.GetName()}, nil
+	case *pbv1.UpstreamMemberRequest: // decode `AddMember`, `RemoveMember`, `SetWeight` and `SetState` request
+		member := new(v1.UpstreamMember)
+		if err := json.Unmarshal(r.GetMember(), member); err != nil {
+			return nil, errors.WithCode(code.ErrDecodingFailed, "invalid upstream member: %v", err)
+		}
+		return &v1.UpstreamMemberRequest{
+			ServerName: r.GetServerName(),
+			Upstream:   r.GetUpstream(),
+			Member:     member,
+			Reload:     r.GetReload(),
+		}, nil
+	default:
+		return nil, errors.WithCode(code.ErrDecodingFailed, "invalid request: %v", r)
+	}
+}
+
+func NewWebServerUpstreamDecoder() Decoder {
+	return new(webServerUpstream)
+}
@@ -0,0 +1,33 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerCertificate struct{}
+
+var _ Encoder = webServerCertificate{}
+
+func (w webServerCertificate) EncodeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *v1.Certificates: // encode `Get` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.Certificates{JsonData: jdata}, nil
+	case *v1.WebServerCertificateWarnings: // return a warnings channel structure(point) from WatchExpiry endpoint, not a *v1.Response
+		return r, nil
+	default:
+		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server certificate response: %v", r)
+	}
+}
+
+func NewWebServerCertificateEncoder() Encoder {
+	return new(webServerCertificate)
+}
@@ -0,0 +1,31 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerLinter struct{}
+
+var _ Encoder = webServerLinter{}
+
+func (w webServerLinter) EncodeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *v1.LintReport: // encode `Lint` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.LintReport{JsonData: jdata}, nil
+	default:
+		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server linter response: %v", r)
+	}
+}
+
+func NewWebServerLinterEncoder() Encoder {
+	return new(webServerLinter)
+}
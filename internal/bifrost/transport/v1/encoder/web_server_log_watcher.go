@@ -2,7 +2,9 @@ package encoder
 
 import (
 	"context"
+	"encoding/json"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/ClessLi/bifrost/internal/pkg/code"
 	"github.com/marmotedu/errors"
 )
@@ -15,6 +17,16 @@ func (w webServerLogWatcher) EncodeResponse(ctx context.Context, r interface{})
 	switch r := r.(type) {
 	case *v1.WebServerLog: // return a bytes channel structure(point) *v1.WebServerLog from Watch endpoint, not a *v1.Response
 		return r, nil
+	case *v1.LogFiles: // encode `ListLogs` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.LogFiles{JsonData: jdata}, nil
+	case *v1.WebServerLogAlerts: // return an alerts channel structure(point) from Alerts endpoint, not a *v1.Response
+		return r, nil
+	case *v1.WebServerLogDownload: // return a chunks channel structure(point) from DownloadLog endpoint, not a *pbv1.LogChunk
+		return r, nil
 	default:
 		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server log watcher response: %v", r)
 	}
@@ -0,0 +1,56 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerManager struct{}
+
+var _ Encoder = webServerManager{}
+
+func (w webServerManager) EncodeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *v1.ManagedWebServers: // encode `List` response
+		servers := &pbv1.ManagedWebServers{Servers: make([]*pbv1.ManagedWebServer, 0, len(r.Servers))}
+		for _, server := range r.Servers {
+			servers.Servers = append(servers.Servers, &pbv1.ManagedWebServer{
+				ServerName:     server.ServerName,
+				ServerType:     server.ServerType,
+				ConfigPath:     server.ConfigPath,
+				VerifyExecPath: server.VerifyExecPath,
+				LogsDirPath:    server.LogsDirPath,
+				BackupDir:      server.BackupDir,
+				BackupCycle:    int32(server.BackupCycle),
+				BackupSaveTime: int32(server.BackupSaveTime),
+				ReloadInterval: int64(server.ReloadInterval),
+				ReloadDebounce: int64(server.ReloadDebounce),
+				SaveInterval:   int64(server.SaveInterval),
+				ConflictPolicy: server.ConflictPolicy,
+				FileMode:       server.FileMode,
+				Prefix:         server.Prefix,
+				ExtraArgs:      server.ExtraArgs,
+				LintRules:      server.LintRules,
+			})
+		}
+		return servers, nil
+	case *v1.ConfigManagerStates: // encode `GetStates` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.ConfigManagerStates{JsonData: jdata}, nil
+	case *v1.Response: // encode `Register`, `Reconfigure` and `Unregister` response
+		return &pbv1.Response{Msg: []byte(r.Message)}, nil
+	default:
+		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server manager response: %v", r)
+	}
+}
+
+func NewWebServerManagerEncoder() Encoder {
+	return new(webServerManager)
+}
@@ -0,0 +1,31 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerRouteSimulator struct{}
+
+var _ Encoder = webServerRouteSimulator{}
+
+func (w webServerRouteSimulator) EncodeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *v1.RouteResult: // encode `Simulate` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.RouteResult{JsonData: jdata}, nil
+	default:
+		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server route simulator response: %v", r)
+	}
+}
+
+func NewWebServerRouteSimulatorEncoder() Encoder {
+	return new(webServerRouteSimulator)
+}
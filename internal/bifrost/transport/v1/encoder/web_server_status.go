@@ -23,6 +23,8 @@ func (w webServerStatus) EncodeResponse(ctx context.Context, r interface{}) (int
 		return &pbv1.Metrics{
 			JsonData: jdata,
 		}, nil
+	case *v1.WebServerTrafficStats: // return a stats channel structure(point) from TrafficStats endpoint, not a *v1.Response
+		return r, nil
 	default:
 		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server status response: %v", r)
 	}
@@ -0,0 +1,37 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerTemplate struct{}
+
+var _ Encoder = webServerTemplate{}
+
+func (w webServerTemplate) EncodeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *v1.WebServerTemplates: // encode `List` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.Templates{JsonData: jdata}, nil
+	case *v1.WebServerTemplateResult: // encode `Apply` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.TemplateApplyResult{JsonData: jdata}, nil
+	default:
+		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server template response: %v", r)
+	}
+}
+
+func NewWebServerTemplateEncoder() Encoder {
+	return new(webServerTemplate)
+}
@@ -0,0 +1,33 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+)
+
+type webServerUpstream struct{}
+
+var _ Encoder = webServerUpstream{}
+
+func (w webServerUpstream) EncodeResponse(_ context.Context, r interface{}) (interface{}, error) {
+	switch r := r.(type) {
+	case *v1.Upstreams: // encode `List` response
+		jdata, err := json.Marshal(r)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrEncodingFailed, err.Error())
+		}
+		return &pbv1.Upstreams{JsonData: jdata}, nil
+	case *v1.Response: // encode `AddMember`, `RemoveMember`, `SetWeight` and `SetState` response
+		return &pbv1.Response{Msg: []byte(r.Message)}, nil
+	default:
+		return nil, errors.WithCode(code.ErrEncodingFailed, "invalid web server upstream response: %v", r)
+	}
+}
+
+func NewWebServerUpstreamEncoder() Encoder {
+	return new(webServerUpstream)
+}
@@ -24,6 +24,34 @@ func (t transport) WebServerLogWatcher() pbv1.WebServerLogWatcherServer {
 	return webServerLogWatcher{}
 }
 
+func (t transport) WebServerCertificate() pbv1.WebServerCertificateServer {
+	return webServerCertificate{}
+}
+
+func (t transport) WebServerLinter() pbv1.WebServerLinterServer {
+	return webServerLinter{}
+}
+
+func (t transport) WebServerRouteSimulator() pbv1.WebServerRouteSimulatorServer {
+	return webServerRouteSimulator{}
+}
+
+func (t transport) WebServerManager() pbv1.WebServerManagerServer {
+	return webServerManager{}
+}
+
+func (t transport) WebServerTemplate() pbv1.WebServerTemplateServer {
+	return webServerTemplate{}
+}
+
+func (t transport) WebServerReconciler() pbv1.WebServerReconcilerServer {
+	return webServerReconciler{}
+}
+
+func (t transport) WebServerUpstream() pbv1.WebServerUpstreamServer {
+	return webServerUpstream{}
+}
+
 func New() txpv1.Factory {
 	return transport{}
 }
@@ -0,0 +1,18 @@
+package fake
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerCertificate struct{}
+
+func (w webServerCertificate) Get(servername *pbv1.ServerName, stream pbv1.WebServerCertificate_GetServer) error {
+	log.Infof("get web server '%s' certificates", servername.GetName())
+	return nil
+}
+
+func (w webServerCertificate) WatchExpiry(request *pbv1.CertificateWatchRequest, stream pbv1.WebServerCertificate_WatchExpiryServer) error {
+	log.Infof("watch web server '%s' certificates expiry", request.GetServerName())
+	return nil
+}
@@ -0,0 +1,13 @@
+package fake
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerLinter struct{}
+
+func (w webServerLinter) Lint(servername *pbv1.ServerName, stream pbv1.WebServerLinter_LintServer) error {
+	log.Infof("lint web server '%s' config", servername.GetName())
+	return nil
+}
@@ -1,6 +1,7 @@
 package fake
 
 import (
+	"context"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	log "github.com/ClessLi/bifrost/pkg/log/v1"
 )
@@ -11,3 +12,23 @@ func (w webServerLogWatcher) Watch(request *pbv1.LogWatchRequest, stream pbv1.We
 	log.Infof("watch web server log '%s'", request.ServerName)
 	return nil
 }
+
+func (w webServerLogWatcher) QueryLogs(request *pbv1.LogQueryRequest, stream pbv1.WebServerLogWatcher_QueryLogsServer) error {
+	log.Infof("query web server log '%s'", request.ServerName)
+	return nil
+}
+
+func (w webServerLogWatcher) ListLogs(ctx context.Context, servername *pbv1.ServerName) (*pbv1.LogFiles, error) {
+	log.Infof("list web server '%s' logs", servername.GetName())
+	return &pbv1.LogFiles{JsonData: []byte(`{"server-name":{"name":"test1"},"list":[{"name":"access.log","path":"/usr/local/nginx/logs/access.log","exists":true,"size":0,"mod-time":"0001-01-01T00:00:00Z","writers":[]}]}`)}, nil
+}
+
+func (w webServerLogWatcher) Alerts(request *pbv1.LogAlertsRequest, stream pbv1.WebServerLogWatcher_AlertsServer) error {
+	log.Infof("watch web server '%s' log alerts", request.GetServerName())
+	return nil
+}
+
+func (w webServerLogWatcher) DownloadLog(request *pbv1.LogDownloadRequest, stream pbv1.WebServerLogWatcher_DownloadLogServer) error {
+	log.Infof("download web server '%s' logs %v", request.GetServerName(), request.GetLogNames())
+	return nil
+}
@@ -0,0 +1,36 @@
+package fake
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerManager struct{}
+
+func (w webServerManager) List(ctx context.Context, null *pbv1.Null) (*pbv1.ManagedWebServers, error) {
+	log.Info("list managed web servers")
+	return &pbv1.ManagedWebServers{Servers: []*pbv1.ManagedWebServer{{ServerName: "test1"}, {ServerName: "test2"}}}, nil
+}
+
+func (w webServerManager) Register(ctx context.Context, server *pbv1.ManagedWebServer) (*pbv1.Response, error) {
+	log.Infof("register web server %s", server.GetServerName())
+	return &pbv1.Response{Msg: []byte("register success")}, nil
+}
+
+func (w webServerManager) Reconfigure(ctx context.Context, server *pbv1.ManagedWebServer) (*pbv1.Response, error) {
+	log.Infof("reconfigure web server %s", server.GetServerName())
+	return &pbv1.Response{Msg: []byte("reconfigure success")}, nil
+}
+
+func (w webServerManager) Unregister(ctx context.Context, servername *pbv1.ServerName) (*pbv1.Response, error) {
+	log.Infof("unregister web server %s", servername.GetName())
+	return &pbv1.Response{Msg: []byte("unregister success")}, nil
+}
+
+func (w webServerManager) GetStates(ctx context.Context, null *pbv1.Null) (*pbv1.ConfigManagerStates, error) {
+	log.Info("get config manager states")
+	return &pbv1.ConfigManagerStates{JsonData: []byte(`{"states":[{"server-name":"test1","running":true,"in-sync":true}]}`)}, nil
+}
+
+var _ pbv1.WebServerManagerServer = webServerManager{}
@@ -0,0 +1,21 @@
+package fake
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerReconciler struct{}
+
+func (w webServerReconciler) Plan(ctx context.Context, null *pbv1.Null) (*pbv1.ReconcilePlans, error) {
+	log.Info("plan the reconciling of web servers")
+	return &pbv1.ReconcilePlans{JsonData: []byte(`{"plans":[{"server-name":"test1","changes":[{"action":"create","kind":"server","name":"www","drifted":false,"diff":"+ server {\n+ }"}],"applied":false}]}`)}, nil
+}
+
+func (w webServerReconciler) Apply(ctx context.Context, null *pbv1.Null) (*pbv1.ReconcilePlans, error) {
+	log.Info("reconcile web servers")
+	return &pbv1.ReconcilePlans{JsonData: []byte(`{"plans":[{"server-name":"test1","changes":[{"action":"create","kind":"server","name":"www","drifted":false,"diff":"+ server {\n+ }"}],"applied":true}]}`)}, nil
+}
+
+var _ pbv1.WebServerReconcilerServer = webServerReconciler{}
@@ -0,0 +1,13 @@
+package fake
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerRouteSimulator struct{}
+
+func (w webServerRouteSimulator) Simulate(request *pbv1.RouteRequest, stream pbv1.WebServerRouteSimulator_SimulateServer) error {
+	log.Infof("simulate the routing of '%s://%s%s' in web server '%s'", request.GetScheme(), request.GetHost(), request.GetURI(), request.GetServerName())
+	return nil
+}
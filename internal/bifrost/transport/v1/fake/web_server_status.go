@@ -11,3 +11,8 @@ func (w webServerStatus) Get(null *pbv1.Null, stream pbv1.WebServerStatus_GetSer
 	log.Infof("get web server status")
 	return nil
 }
+
+func (w webServerStatus) TrafficStats(request *pbv1.TrafficStatsRequest, stream pbv1.WebServerStatus_TrafficStatsServer) error {
+	log.Infof("watch web server '%s' traffic stats", request.GetServerName())
+	return nil
+}
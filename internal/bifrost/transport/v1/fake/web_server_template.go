@@ -0,0 +1,21 @@
+package fake
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerTemplate struct{}
+
+func (w webServerTemplate) List(ctx context.Context, null *pbv1.Null) (*pbv1.Templates, error) {
+	log.Info("list web server templates")
+	return &pbv1.Templates{JsonData: []byte(`{"templates":[{"name":"static-site","target":"http","parameters":[],"content":""}]}`)}, nil
+}
+
+func (w webServerTemplate) Apply(ctx context.Context, request *pbv1.TemplateApplyRequest) (*pbv1.TemplateApplyResult, error) {
+	log.Infof("apply template %s to web server %s", request.GetTemplateName(), request.GetServerName())
+	return &pbv1.TemplateApplyResult{JsonData: []byte(`{"server-name":"test1","template-name":"static-site","target":"http","snippet":"","applied":true}`)}, nil
+}
+
+var _ pbv1.WebServerTemplateServer = webServerTemplate{}
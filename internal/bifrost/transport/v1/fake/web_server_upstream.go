@@ -0,0 +1,36 @@
+package fake
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type webServerUpstream struct{}
+
+func (w webServerUpstream) List(ctx context.Context, servername *pbv1.ServerName) (*pbv1.Upstreams, error) {
+	log.Infof("list upstreams of web server %s", servername.GetName())
+	return &pbv1.Upstreams{JsonData: []byte(`{"server-name":"test1","upstreams":[{"name":"backend","position":"","members":[{"address":"127.0.0.1:8080","weight":2,"state":"up"}]}]}`)}, nil
+}
+
+func (w webServerUpstream) AddMember(ctx context.Context, request *pbv1.UpstreamMemberRequest) (*pbv1.Response, error) {
+	log.Infof("add member %s to upstream %s of web server %s", request.GetMember(), request.GetUpstream(), request.GetServerName())
+	return &pbv1.Response{Msg: []byte("add member success")}, nil
+}
+
+func (w webServerUpstream) RemoveMember(ctx context.Context, request *pbv1.UpstreamMemberRequest) (*pbv1.Response, error) {
+	log.Infof("remove member %s from upstream %s of web server %s", request.GetMember(), request.GetUpstream(), request.GetServerName())
+	return &pbv1.Response{Msg: []byte("remove member success")}, nil
+}
+
+func (w webServerUpstream) SetWeight(ctx context.Context, request *pbv1.UpstreamMemberRequest) (*pbv1.Response, error) {
+	log.Infof("set weight of member %s in upstream %s of web server %s", request.GetMember(), request.GetUpstream(), request.GetServerName())
+	return &pbv1.Response{Msg: []byte("set weight success")}, nil
+}
+
+func (w webServerUpstream) SetState(ctx context.Context, request *pbv1.UpstreamMemberRequest) (*pbv1.Response, error) {
+	log.Infof("set state of member %s in upstream %s of web server %s", request.GetMember(), request.GetUpstream(), request.GetServerName())
+	return &pbv1.Response{Msg: []byte("set state success")}, nil
+}
+
+var _ pbv1.WebServerUpstreamServer = webServerUpstream{}
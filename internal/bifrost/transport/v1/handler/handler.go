@@ -13,6 +13,13 @@ type HandlersFactory interface {
 	WebServerStatistics() WebServerStatisticsHandlers
 	WebServerStatus() WebServerStatusHandlers
 	WebServerLogWatcher() WebServerLogWatcherHandlers
+	WebServerCertificate() WebServerCertificateHandlers
+	WebServerLinter() WebServerLinterHandlers
+	WebServerRouteSimulator() WebServerRouteSimulatorHandlers
+	WebServerManager() WebServerManagerHandlers
+	WebServerTemplate() WebServerTemplateHandlers
+	WebServerReconciler() WebServerReconcilerHandlers
+	WebServerUpstream() WebServerUpstreamHandlers
 }
 
 type handlersFactory struct {
@@ -41,6 +48,34 @@ func (h *handlersFactory) WebServerLogWatcher() WebServerLogWatcherHandlers {
 	return NewWebServerLogWatcherHandlers(h.eps)
 }
 
+func (h *handlersFactory) WebServerCertificate() WebServerCertificateHandlers {
+	return NewWebServerCertificateHandlers(h.eps)
+}
+
+func (h *handlersFactory) WebServerLinter() WebServerLinterHandlers {
+	return NewWebServerLinterHandlers(h.eps)
+}
+
+func (h *handlersFactory) WebServerRouteSimulator() WebServerRouteSimulatorHandlers {
+	return NewWebServerRouteSimulatorHandlers(h.eps)
+}
+
+func (h *handlersFactory) WebServerManager() WebServerManagerHandlers {
+	return NewWebServerManagerHandlers(h.eps)
+}
+
+func (h *handlersFactory) WebServerTemplate() WebServerTemplateHandlers {
+	return NewWebServerTemplateHandlers(h.eps)
+}
+
+func (h *handlersFactory) WebServerReconciler() WebServerReconcilerHandlers {
+	return NewWebServerReconcilerHandlers(h.eps)
+}
+
+func (h *handlersFactory) WebServerUpstream() WebServerUpstreamHandlers {
+	return NewWebServerUpstreamHandlers(h.eps)
+}
+
 func NewHandler(ep endpoint.Endpoint, decoder decoder.Decoder, encoder encoder.Encoder) grpc.Handler {
 	return grpc.NewServer(ep, decoder.DecodeRequest, encoder.EncodeResponse)
 }
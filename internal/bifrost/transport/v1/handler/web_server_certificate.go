@@ -0,0 +1,67 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerCertificateHandlers interface {
+	HandlerGet() grpc.Handler
+	HandlerWatchExpiry() grpc.Handler
+}
+
+var _ WebServerCertificateHandlers = &webServerCertificateHandlers{}
+
+type webServerCertificateHandlers struct {
+	onceGet                     sync.Once
+	onceWatchExpiry             sync.Once
+	singletonHandlerGet         grpc.Handler
+	singletonHandlerWatchExpiry grpc.Handler
+	eps                         epv1.WebServerCertificateEndpoints
+	decoder                     decoder.Decoder
+	encoder                     encoder.Encoder
+}
+
+func (w *webServerCertificateHandlers) HandlerGet() grpc.Handler {
+	w.onceGet.Do(func() {
+		if w.singletonHandlerGet == nil {
+			w.singletonHandlerGet = NewHandler(w.eps.EndpointGet(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerGet == nil {
+		log.Fatal("web server certificate handler `Get` is nil")
+
+		return nil
+	}
+
+	return w.singletonHandlerGet
+}
+
+func (w *webServerCertificateHandlers) HandlerWatchExpiry() grpc.Handler {
+	w.onceWatchExpiry.Do(func() {
+		if w.singletonHandlerWatchExpiry == nil {
+			w.singletonHandlerWatchExpiry = NewHandler(w.eps.EndpointWatchExpiry(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerWatchExpiry == nil {
+		log.Fatal("web server certificate handler `WatchExpiry` is nil")
+
+		return nil
+	}
+
+	return w.singletonHandlerWatchExpiry
+}
+
+func NewWebServerCertificateHandlers(eps epv1.EndpointsFactory) WebServerCertificateHandlers {
+	return &webServerCertificateHandlers{
+		onceGet:         sync.Once{},
+		onceWatchExpiry: sync.Once{},
+		eps:             eps.WebServerCertificate(),
+		decoder:         decoder.NewWebServerCertificateDecoder(),
+		encoder:         encoder.NewWebServerCertificateEncoder(),
+	}
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerLinterHandlers interface {
+	HandlerLint() grpc.Handler
+}
+
+var _ WebServerLinterHandlers = &webServerLinterHandlers{}
+
+type webServerLinterHandlers struct {
+	onceLint             sync.Once
+	singletonHandlerLint grpc.Handler
+	eps                  epv1.WebServerLinterEndpoints
+	decoder              decoder.Decoder
+	encoder              encoder.Encoder
+}
+
+func (w *webServerLinterHandlers) HandlerLint() grpc.Handler {
+	w.onceLint.Do(func() {
+		if w.singletonHandlerLint == nil {
+			w.singletonHandlerLint = NewHandler(w.eps.EndpointLint(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerLint == nil {
+		log.Fatal("web server linter handler `Lint` is nil")
+
+		return nil
+	}
+
+	return w.singletonHandlerLint
+}
+
+func NewWebServerLinterHandlers(eps epv1.EndpointsFactory) WebServerLinterHandlers {
+	return &webServerLinterHandlers{
+		onceLint: sync.Once{},
+		eps:      eps.WebServerLinter(),
+		decoder:  decoder.NewWebServerLinterDecoder(),
+		encoder:  encoder.NewWebServerLinterEncoder(),
+	}
+}
@@ -11,16 +11,28 @@ import (
 
 type WebServerLogWatcherHandlers interface {
 	HandlerWatch() grpc.Handler
+	HandlerQueryLogs() grpc.Handler
+	HandlerListLogs() grpc.Handler
+	HandlerAlerts() grpc.Handler
+	HandlerDownloadLog() grpc.Handler
 }
 
 var _ WebServerLogWatcherHandlers = &webServerLogWatcherHandlers{}
 
 type webServerLogWatcherHandlers struct {
-	onceWatch             sync.Once
-	singletonHandlerWatch grpc.Handler
-	eps                   epv1.WebServerLogWatcherEndpoints
-	decoder               decoder.Decoder
-	encoder               encoder.Encoder
+	onceWatch                   sync.Once
+	singletonHandlerWatch       grpc.Handler
+	onceQueryLogs               sync.Once
+	singletonHandlerQueryLogs   grpc.Handler
+	onceListLogs                sync.Once
+	singletonHandlerListLogs    grpc.Handler
+	onceAlerts                  sync.Once
+	singletonHandlerAlerts      grpc.Handler
+	onceDownloadLog             sync.Once
+	singletonHandlerDownloadLog grpc.Handler
+	eps                         epv1.WebServerLogWatcherEndpoints
+	decoder                     decoder.Decoder
+	encoder                     encoder.Encoder
 }
 
 func (lw *webServerLogWatcherHandlers) HandlerWatch() grpc.Handler {
@@ -40,12 +52,84 @@ func (lw *webServerLogWatcherHandlers) HandlerWatch() grpc.Handler {
 
 }
 
+func (lw *webServerLogWatcherHandlers) HandlerQueryLogs() grpc.Handler {
+	lw.onceQueryLogs.Do(func() {
+		if lw.singletonHandlerQueryLogs == nil {
+			lw.singletonHandlerQueryLogs = NewHandler(lw.eps.EndpointQueryLogs(), lw.decoder, lw.encoder)
+		}
+	})
+
+	if lw.singletonHandlerQueryLogs == nil {
+		log.Fatal("web server log watcher handler `QueryLogs` is nil")
+
+		return nil
+	}
+
+	return lw.singletonHandlerQueryLogs
+}
+
+func (lw *webServerLogWatcherHandlers) HandlerListLogs() grpc.Handler {
+	lw.onceListLogs.Do(func() {
+		if lw.singletonHandlerListLogs == nil {
+			lw.singletonHandlerListLogs = NewHandler(lw.eps.EndpointListLogs(), lw.decoder, lw.encoder)
+		}
+	})
+
+	if lw.singletonHandlerListLogs == nil {
+		log.Fatal("web server log watcher handler `ListLogs` is nil")
+
+		return nil
+	}
+
+	return lw.singletonHandlerListLogs
+}
+
+func (lw *webServerLogWatcherHandlers) HandlerAlerts() grpc.Handler {
+	lw.onceAlerts.Do(func() {
+		if lw.singletonHandlerAlerts == nil {
+			lw.singletonHandlerAlerts = NewHandler(lw.eps.EndpointAlerts(), lw.decoder, lw.encoder)
+		}
+	})
+
+	if lw.singletonHandlerAlerts == nil {
+		log.Fatal("web server log watcher handler `Alerts` is nil")
+
+		return nil
+	}
+
+	return lw.singletonHandlerAlerts
+}
+
+func (lw *webServerLogWatcherHandlers) HandlerDownloadLog() grpc.Handler {
+	lw.onceDownloadLog.Do(func() {
+		if lw.singletonHandlerDownloadLog == nil {
+			lw.singletonHandlerDownloadLog = NewHandler(lw.eps.EndpointDownloadLog(), lw.decoder, lw.encoder)
+		}
+	})
+
+	if lw.singletonHandlerDownloadLog == nil {
+		log.Fatal("web server log watcher handler `DownloadLog` is nil")
+
+		return nil
+	}
+
+	return lw.singletonHandlerDownloadLog
+}
+
 func NewWebServerLogWatcherHandlers(eps epv1.EndpointsFactory) WebServerLogWatcherHandlers {
 	return &webServerLogWatcherHandlers{
-		onceWatch:             sync.Once{},
-		singletonHandlerWatch: nil,
-		eps:                   eps.WebServerLogWatcher(),
-		decoder:               decoder.NewWebServerLogWatcherDecoder(),
-		encoder:               encoder.NewWebServerLogWatcherEncoder(),
+		onceWatch:                   sync.Once{},
+		singletonHandlerWatch:       nil,
+		onceQueryLogs:               sync.Once{},
+		singletonHandlerQueryLogs:   nil,
+		onceListLogs:                sync.Once{},
+		singletonHandlerListLogs:    nil,
+		onceAlerts:                  sync.Once{},
+		singletonHandlerAlerts:      nil,
+		onceDownloadLog:             sync.Once{},
+		singletonHandlerDownloadLog: nil,
+		eps:                         eps.WebServerLogWatcher(),
+		decoder:                     decoder.NewWebServerLogWatcherDecoder(),
+		encoder:                     encoder.NewWebServerLogWatcherEncoder(),
 	}
 }
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerManagerHandlers interface {
+	HandlerList() grpc.Handler
+	HandlerRegister() grpc.Handler
+	HandlerReconfigure() grpc.Handler
+	HandlerUnregister() grpc.Handler
+	HandlerGetStates() grpc.Handler
+}
+
+var _ WebServerManagerHandlers = &webServerManagerHandlers{}
+
+type webServerManagerHandlers struct {
+	onceList                    sync.Once
+	onceRegister                sync.Once
+	onceReconfigure             sync.Once
+	onceUnregister              sync.Once
+	onceGetStates               sync.Once
+	singletonHandlerList        grpc.Handler
+	singletonHandlerRegister    grpc.Handler
+	singletonHandlerReconfigure grpc.Handler
+	singletonHandlerUnregister  grpc.Handler
+	singletonHandlerGetStates   grpc.Handler
+	eps                         epv1.WebServerManagerEndpoints
+	decoder                     decoder.Decoder
+	encoder                     encoder.Encoder
+}
+
+func (w *webServerManagerHandlers) HandlerList() grpc.Handler {
+	w.onceList.Do(func() {
+		if w.singletonHandlerList == nil {
+			w.singletonHandlerList = NewHandler(w.eps.EndpointList(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerList == nil {
+		log.Fatal("web server manager handler `List` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerList
+}
+
+func (w *webServerManagerHandlers) HandlerRegister() grpc.Handler {
+	w.onceRegister.Do(func() {
+		if w.singletonHandlerRegister == nil {
+			w.singletonHandlerRegister = NewHandler(w.eps.EndpointRegister(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerRegister == nil {
+		log.Fatal("web server manager handler `Register` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerRegister
+}
+
+func (w *webServerManagerHandlers) HandlerReconfigure() grpc.Handler {
+	w.onceReconfigure.Do(func() {
+		if w.singletonHandlerReconfigure == nil {
+			w.singletonHandlerReconfigure = NewHandler(w.eps.EndpointReconfigure(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerReconfigure == nil {
+		log.Fatal("web server manager handler `Reconfigure` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerReconfigure
+}
+
+func (w *webServerManagerHandlers) HandlerUnregister() grpc.Handler {
+	w.onceUnregister.Do(func() {
+		if w.singletonHandlerUnregister == nil {
+			w.singletonHandlerUnregister = NewHandler(w.eps.EndpointUnregister(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerUnregister == nil {
+		log.Fatal("web server manager handler `Unregister` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerUnregister
+}
+
+func (w *webServerManagerHandlers) HandlerGetStates() grpc.Handler {
+	w.onceGetStates.Do(func() {
+		if w.singletonHandlerGetStates == nil {
+			w.singletonHandlerGetStates = NewHandler(w.eps.EndpointGetStates(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerGetStates == nil {
+		log.Fatal("web server manager handler `GetStates` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerGetStates
+}
+
+func NewWebServerManagerHandlers(eps epv1.EndpointsFactory) WebServerManagerHandlers {
+	return &webServerManagerHandlers{
+		onceList:        sync.Once{},
+		onceRegister:    sync.Once{},
+		onceReconfigure: sync.Once{},
+		onceUnregister:  sync.Once{},
+		onceGetStates:   sync.Once{},
+		eps:             eps.WebServerManager(),
+		decoder:         decoder.NewWebServerManagerDecoder(),
+		encoder:         encoder.NewWebServerManagerEncoder(),
+	}
+}
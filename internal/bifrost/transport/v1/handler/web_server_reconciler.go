@@ -0,0 +1,65 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerReconcilerHandlers interface {
+	HandlerPlan() grpc.Handler
+	HandlerApply() grpc.Handler
+}
+
+var _ WebServerReconcilerHandlers = &webServerReconcilerHandlers{}
+
+type webServerReconcilerHandlers struct {
+	oncePlan              sync.Once
+	onceApply             sync.Once
+	singletonHandlerPlan  grpc.Handler
+	singletonHandlerApply grpc.Handler
+	eps                   epv1.WebServerReconcilerEndpoints
+	decoder               decoder.Decoder
+	encoder               encoder.Encoder
+}
+
+func (w *webServerReconcilerHandlers) HandlerPlan() grpc.Handler {
+	w.oncePlan.Do(func() {
+		if w.singletonHandlerPlan == nil {
+			w.singletonHandlerPlan = NewHandler(w.eps.EndpointPlan(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerPlan == nil {
+		log.Fatal("web server reconciler handler `Plan` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerPlan
+}
+
+func (w *webServerReconcilerHandlers) HandlerApply() grpc.Handler {
+	w.onceApply.Do(func() {
+		if w.singletonHandlerApply == nil {
+			w.singletonHandlerApply = NewHandler(w.eps.EndpointApply(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerApply == nil {
+		log.Fatal("web server reconciler handler `Apply` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerApply
+}
+
+func NewWebServerReconcilerHandlers(eps epv1.EndpointsFactory) WebServerReconcilerHandlers {
+	return &webServerReconcilerHandlers{
+		oncePlan:  sync.Once{},
+		onceApply: sync.Once{},
+		eps:       eps.WebServerReconciler(),
+		decoder:   decoder.NewWebServerReconcilerDecoder(),
+		encoder:   encoder.NewWebServerReconcilerEncoder(),
+	}
+}
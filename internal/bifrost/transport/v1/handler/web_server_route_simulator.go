@@ -0,0 +1,48 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerRouteSimulatorHandlers interface {
+	HandlerSimulate() grpc.Handler
+}
+
+var _ WebServerRouteSimulatorHandlers = &webServerRouteSimulatorHandlers{}
+
+type webServerRouteSimulatorHandlers struct {
+	onceSimulate             sync.Once
+	singletonHandlerSimulate grpc.Handler
+	eps                      epv1.WebServerRouteSimulatorEndpoints
+	decoder                  decoder.Decoder
+	encoder                  encoder.Encoder
+}
+
+func (w *webServerRouteSimulatorHandlers) HandlerSimulate() grpc.Handler {
+	w.onceSimulate.Do(func() {
+		if w.singletonHandlerSimulate == nil {
+			w.singletonHandlerSimulate = NewHandler(w.eps.EndpointSimulate(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerSimulate == nil {
+		log.Fatal("web server route simulator handler `Simulate` is nil")
+
+		return nil
+	}
+
+	return w.singletonHandlerSimulate
+}
+
+func NewWebServerRouteSimulatorHandlers(eps epv1.EndpointsFactory) WebServerRouteSimulatorHandlers {
+	return &webServerRouteSimulatorHandlers{
+		onceSimulate: sync.Once{},
+		eps:          eps.WebServerRouteSimulator(),
+		decoder:      decoder.NewWebServerRouteSimulatorDecoder(),
+		encoder:      encoder.NewWebServerRouteSimulatorEncoder(),
+	}
+}
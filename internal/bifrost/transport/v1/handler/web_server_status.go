@@ -11,16 +11,19 @@ import (
 
 type WebServerStatusHandlers interface {
 	HandlerGet() grpc.Handler
+	HandlerTrafficStats() grpc.Handler
 }
 
 var _ WebServerStatusHandlers = &webServerStatusHandlers{}
 
 type webServerStatusHandlers struct {
-	onceGet             sync.Once
-	singletonHandlerGet grpc.Handler
-	eps                 epv1.WebServerStatusEndpoints
-	decoder             decoder.Decoder
-	encoder             encoder.Encoder
+	onceGet                      sync.Once
+	onceTrafficStats             sync.Once
+	singletonHandlerGet          grpc.Handler
+	singletonHandlerTrafficStats grpc.Handler
+	eps                          epv1.WebServerStatusEndpoints
+	decoder                      decoder.Decoder
+	encoder                      encoder.Encoder
 }
 
 func (w *webServerStatusHandlers) HandlerGet() grpc.Handler {
@@ -37,11 +40,28 @@ func (w *webServerStatusHandlers) HandlerGet() grpc.Handler {
 
 	return w.singletonHandlerGet
 }
+
+func (w *webServerStatusHandlers) HandlerTrafficStats() grpc.Handler {
+	w.onceTrafficStats.Do(func() {
+		if w.singletonHandlerTrafficStats == nil {
+			w.singletonHandlerTrafficStats = NewHandler(w.eps.EndpointTrafficStats(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerTrafficStats == nil {
+		log.Fatal("web server status handler `TrafficStats` is nil")
+
+		return nil
+	}
+
+	return w.singletonHandlerTrafficStats
+}
+
 func NewWebServerStatusHandlers(eps epv1.EndpointsFactory) WebServerStatusHandlers {
 	return &webServerStatusHandlers{
-		onceGet: sync.Once{},
-		eps:     eps.WebServerStatus(),
-		decoder: decoder.NewWebServerStatusDecoder(),
-		encoder: encoder.NewWebServerStatusEncoder(),
+		onceGet:          sync.Once{},
+		onceTrafficStats: sync.Once{},
+		eps:              eps.WebServerStatus(),
+		decoder:          decoder.NewWebServerStatusDecoder(),
+		encoder:          encoder.NewWebServerStatusEncoder(),
 	}
 }
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerTemplateHandlers interface {
+	HandlerList() grpc.Handler
+	HandlerApply() grpc.Handler
+}
+
+var _ WebServerTemplateHandlers = &webServerTemplateHandlers{}
+
+type webServerTemplateHandlers struct {
+	onceList              sync.Once
+	onceApply             sync.Once
+	singletonHandlerList  grpc.Handler
+	singletonHandlerApply grpc.Handler
+	eps                   epv1.WebServerTemplateEndpoints
+	decoder               decoder.Decoder
+	encoder               encoder.Encoder
+}
+
+func (w *webServerTemplateHandlers) HandlerList() grpc.Handler {
+	w.onceList.Do(func() {
+		if w.singletonHandlerList == nil {
+			w.singletonHandlerList = NewHandler(w.eps.EndpointList(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerList == nil {
+		log.Fatal("web server template handler `List` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerList
+}
+
+func (w *webServerTemplateHandlers) HandlerApply() grpc.Handler {
+	w.onceApply.Do(func() {
+		if w.singletonHandlerApply == nil {
+			w.singletonHandlerApply = NewHandler(w.eps.EndpointApply(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerApply == nil {
+		log.Fatal("web server template handler `Apply` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerApply
+}
+
+func NewWebServerTemplateHandlers(eps epv1.EndpointsFactory) WebServerTemplateHandlers {
+	return &webServerTemplateHandlers{
+		onceList:  sync.Once{},
+		onceApply: sync.Once{},
+		eps:       eps.WebServerTemplate(),
+		decoder:   decoder.NewWebServerTemplateDecoder(),
+		encoder:   encoder.NewWebServerTemplateEncoder(),
+	}
+}
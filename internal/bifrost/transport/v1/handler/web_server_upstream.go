@@ -0,0 +1,119 @@
+package handler
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/decoder"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/encoder"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/go-kit/kit/transport/grpc"
+	"sync"
+)
+
+type WebServerUpstreamHandlers interface {
+	HandlerList() grpc.Handler
+	HandlerAddMember() grpc.Handler
+	HandlerRemoveMember() grpc.Handler
+	HandlerSetWeight() grpc.Handler
+	HandlerSetState() grpc.Handler
+}
+
+var _ WebServerUpstreamHandlers = &webServerUpstreamHandlers{}
+
+type webServerUpstreamHandlers struct {
+	onceList                     sync.Once
+	onceAddMember                sync.Once
+	onceRemoveMember             sync.Once
+	onceSetWeight                sync.Once
+	onceSetState                 sync.Once
+	singletonHandlerList         grpc.Handler
+	singletonHandlerAddMember    grpc.Handler
+	singletonHandlerRemoveMember grpc.Handler
+	singletonHandlerSetWeight    grpc.Handler
+	singletonHandlerSetState     grpc.Handler
+	eps                          epv1.WebServerUpstreamEndpoints
+	decoder                      decoder.Decoder
+	encoder                      encoder.Encoder
+}
+
+func (w *webServerUpstreamHandlers) HandlerList() grpc.Handler {
+	w.onceList.Do(func() {
+		if w.singletonHandlerList == nil {
+			w.singletonHandlerList = NewHandler(w.eps.EndpointList(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerList == nil {
+		log.Fatal("web server upstream handler `List` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerList
+}
+
+func (w *webServerUpstreamHandlers) HandlerAddMember() grpc.Handler {
+	w.onceAddMember.Do(func() {
+		if w.singletonHandlerAddMember == nil {
+			w.singletonHandlerAddMember = NewHandler(w.eps.EndpointAddMember(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerAddMember == nil {
+		log.Fatal("web server upstream handler `AddMember` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerAddMember
+}
+
+func (w *webServerUpstreamHandlers) HandlerRemoveMember() grpc.Handler {
+	w.onceRemoveMember.Do(func() {
+		if w.singletonHandlerRemoveMember == nil {
+			w.singletonHandlerRemoveMember = NewHandler(w.eps.EndpointRemoveMember(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerRemoveMember == nil {
+		log.Fatal("web server upstream handler `RemoveMember` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerRemoveMember
+}
+
+func (w *webServerUpstreamHandlers) HandlerSetWeight() grpc.Handler {
+	w.onceSetWeight.Do(func() {
+		if w.singletonHandlerSetWeight == nil {
+			w.singletonHandlerSetWeight = NewHandler(w.eps.EndpointSetWeight(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerSetWeight == nil {
+		log.Fatal("web server upstream handler `SetWeight` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerSetWeight
+}
+
+func (w *webServerUpstreamHandlers) HandlerSetState() grpc.Handler {
+	w.onceSetState.Do(func() {
+		if w.singletonHandlerSetState == nil {
+			w.singletonHandlerSetState = NewHandler(w.eps.EndpointSetState(), w.decoder, w.encoder)
+		}
+	})
+	if w.singletonHandlerSetState == nil {
+		log.Fatal("web server upstream handler `SetState` is nil")
+
+		return nil
+	}
+	return w.singletonHandlerSetState
+}
+
+func NewWebServerUpstreamHandlers(eps epv1.EndpointsFactory) WebServerUpstreamHandlers {
+	return &webServerUpstreamHandlers{
+		onceList:         sync.Once{},
+		onceAddMember:    sync.Once{},
+		onceRemoveMember: sync.Once{},
+		onceSetWeight:    sync.Once{},
+		onceSetState:     sync.Once{},
+		eps:              eps.WebServerUpstream(),
+		decoder:          decoder.NewWebServerUpstreamDecoder(),
+		encoder:          encoder.NewWebServerUpstreamEncoder(),
+	}
+}
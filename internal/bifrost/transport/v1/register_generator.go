@@ -44,6 +44,48 @@ func (b *bifrostServiceRegister) Generate() map[string]service_register.ServiceR
 			}
 			pbv1.RegisterWebServerLogWatcherServer(server, b.factory.WebServerLogWatcher())
 		},
+		b.instancePrefixName + ".bifrostpb.WebServerCertificate": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerCertificate", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerCertificateServer(server, b.factory.WebServerCertificate())
+		},
+		b.instancePrefixName + ".bifrostpb.WebServerLinter": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerLinter", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerLinterServer(server, b.factory.WebServerLinter())
+		},
+		b.instancePrefixName + ".bifrostpb.WebServerRouteSimulator": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerRouteSimulator", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerRouteSimulatorServer(server, b.factory.WebServerRouteSimulator())
+		},
+		b.instancePrefixName + ".bifrostpb.WebServerManager": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerManager", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerManagerServer(server, b.factory.WebServerManager())
+		},
+		b.instancePrefixName + ".bifrostpb.WebServerTemplate": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerTemplate", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerTemplateServer(server, b.factory.WebServerTemplate())
+		},
+		b.instancePrefixName + ".bifrostpb.WebServerReconciler": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerReconciler", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerReconcilerServer(server, b.factory.WebServerReconciler())
+		},
+		b.instancePrefixName + ".bifrostpb.WebServerUpstream": func(server *grpc.Server, healthzSvr *health.Server) {
+			if healthzSvr != nil {
+				healthzSvr.SetServingStatus(b.instancePrefixName+".bifrostpb.WebServerUpstream", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			pbv1.RegisterWebServerUpstreamServer(server, b.factory.WebServerUpstream())
+		},
 	}
 }
 
@@ -4,10 +4,17 @@ import (
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_certificate"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_config"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_linter"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_log_watcher"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_manager"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_reconciler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_route_simulator"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_statistics"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_status"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_template"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/web_server_upstream"
 )
 
 type Factory interface {
@@ -15,6 +22,13 @@ type Factory interface {
 	WebServerStatistics() pbv1.WebServerStatisticsServer
 	WebServerStatus() pbv1.WebServerStatusServer
 	WebServerLogWatcher() pbv1.WebServerLogWatcherServer
+	WebServerCertificate() pbv1.WebServerCertificateServer
+	WebServerLinter() pbv1.WebServerLinterServer
+	WebServerRouteSimulator() pbv1.WebServerRouteSimulatorServer
+	WebServerManager() pbv1.WebServerManagerServer
+	WebServerTemplate() pbv1.WebServerTemplateServer
+	WebServerReconciler() pbv1.WebServerReconcilerServer
+	WebServerUpstream() pbv1.WebServerUpstreamServer
 }
 
 type transport struct {
@@ -38,6 +52,34 @@ func (t *transport) WebServerLogWatcher() pbv1.WebServerLogWatcherServer {
 	return web_server_log_watcher.NewWebServerLogWatcherServer(t.handlers.WebServerLogWatcher(), t.opts)
 }
 
+func (t *transport) WebServerCertificate() pbv1.WebServerCertificateServer {
+	return web_server_certificate.NewWebServerCertificateServer(t.handlers.WebServerCertificate(), t.opts)
+}
+
+func (t *transport) WebServerLinter() pbv1.WebServerLinterServer {
+	return web_server_linter.NewWebServerLinterServer(t.handlers.WebServerLinter(), t.opts)
+}
+
+func (t *transport) WebServerRouteSimulator() pbv1.WebServerRouteSimulatorServer {
+	return web_server_route_simulator.NewWebServerRouteSimulatorServer(t.handlers.WebServerRouteSimulator(), t.opts)
+}
+
+func (t *transport) WebServerManager() pbv1.WebServerManagerServer {
+	return web_server_manager.NewWebServerManagerServer(t.handlers.WebServerManager(), t.opts)
+}
+
+func (t *transport) WebServerTemplate() pbv1.WebServerTemplateServer {
+	return web_server_template.NewWebServerTemplateServer(t.handlers.WebServerTemplate(), t.opts)
+}
+
+func (t *transport) WebServerReconciler() pbv1.WebServerReconcilerServer {
+	return web_server_reconciler.NewWebServerReconcilerServer(t.handlers.WebServerReconciler(), t.opts)
+}
+
+func (t *transport) WebServerUpstream() pbv1.WebServerUpstreamServer {
+	return web_server_upstream.NewWebServerUpstreamServer(t.handlers.WebServerUpstream(), t.opts)
+}
+
 func New(handlers handler.HandlersFactory, opts *options.Options) Factory {
 	return &transport{
 		handlers: handlers,
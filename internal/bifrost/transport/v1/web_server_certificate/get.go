@@ -0,0 +1,18 @@
+package web_server_certificate
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+)
+
+func (w *webServerCertificateServer) Get(r *pbv1.ServerName, stream pbv1.WebServerCertificate_GetServer) error {
+	_, resp, err := w.handler.HandlerGet().ServeGRPC(stream.Context(), r)
+	if err != nil {
+		return err
+	}
+
+	response := resp.(*pbv1.Certificates)
+	return utils.StreamSendMsg(stream, response.GetJsonData(), w.options.ChunkSize, func(msg []byte) interface{} {
+		return &pbv1.Certificates{JsonData: msg}
+	})
+}
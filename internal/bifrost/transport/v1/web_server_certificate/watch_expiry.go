@@ -0,0 +1,51 @@
+package web_server_certificate
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"io"
+)
+
+// WatchExpiry sends each certificate warning as a line of json data.
+func (w *webServerCertificateServer) WatchExpiry(request *pbv1.CertificateWatchRequest, stream pbv1.WebServerCertificate_WatchExpiryServer) error {
+	reqCtx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	respCtx, resp, err := w.handler.HandlerWatchExpiry().ServeGRPC(reqCtx, request) // resp is a *v1.WebServerCertificateWarnings
+	if err != nil {
+		return err
+	}
+	respWarnings := resp.(*v1.WebServerCertificateWarnings)
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		case <-respCtx.Done():
+			return respCtx.Err()
+		case warning := <-respWarnings.Warnings:
+			if warning == nil {
+				return nil
+			}
+			line, err := json.Marshal(warning)
+			if err != nil {
+				return errors.WithCode(code.ErrEncodingFailed, err.Error())
+			}
+			line = append(line, '\n')
+			err = utils.StreamSendMsg(stream, line, w.options.ChunkSize, func(msg []byte) interface{} {
+				return &pbv1.Response{Msg: msg}
+			})
+			if err != nil && err != io.EOF {
+				return err
+			}
+			if err == io.EOF {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package web_server_certificate
+
+import (
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+type webServerCertificateServer struct {
+	handler handler.WebServerCertificateHandlers
+	options *options.Options
+}
+
+func NewWebServerCertificateServer(handler handler.WebServerCertificateHandlers, options *options.Options) *webServerCertificateServer {
+	return &webServerCertificateServer{
+		handler: handler,
+		options: options,
+	}
+}
@@ -0,0 +1,18 @@
+package web_server_linter
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+)
+
+func (w *webServerLinterServer) Lint(r *pbv1.ServerName, stream pbv1.WebServerLinter_LintServer) error {
+	_, resp, err := w.handler.HandlerLint().ServeGRPC(stream.Context(), r)
+	if err != nil {
+		return err
+	}
+
+	response := resp.(*pbv1.LintReport)
+	return utils.StreamSendMsg(stream, response.GetJsonData(), w.options.ChunkSize, func(msg []byte) interface{} {
+		return &pbv1.LintReport{JsonData: msg}
+	})
+}
@@ -0,0 +1,18 @@
+package web_server_linter
+
+import (
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+type webServerLinterServer struct {
+	handler handler.WebServerLinterHandlers
+	options *options.Options
+}
+
+func NewWebServerLinterServer(handler handler.WebServerLinterHandlers, options *options.Options) *webServerLinterServer {
+	return &webServerLinterServer{
+		handler: handler,
+		options: options,
+	}
+}
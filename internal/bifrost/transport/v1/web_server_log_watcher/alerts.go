@@ -0,0 +1,51 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"io"
+)
+
+// Alerts sends each alert as a line of json data.
+func (w *webServerLogWatcherServer) Alerts(request *pbv1.LogAlertsRequest, stream pbv1.WebServerLogWatcher_AlertsServer) error {
+	reqCtx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	respCtx, resp, err := w.handler.HandlerAlerts().ServeGRPC(reqCtx, request) // resp is a *v1.WebServerLogAlerts
+	if err != nil {
+		return err
+	}
+	respAlerts := resp.(*v1.WebServerLogAlerts)
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		case <-respCtx.Done():
+			return respCtx.Err()
+		case alert := <-respAlerts.Alerts:
+			if alert == nil {
+				return nil
+			}
+			line, err := json.Marshal(alert)
+			if err != nil {
+				return errors.WithCode(code.ErrEncodingFailed, err.Error())
+			}
+			line = append(line, '\n')
+			err = utils.StreamSendMsg(stream, line, w.options.ChunkSize, func(msg []byte) interface{} {
+				return &pbv1.Response{Msg: msg}
+			})
+			if err != nil && err != io.EOF {
+				return err
+			}
+			if err == io.EOF {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+	"github.com/marmotedu/errors"
+	"io"
+)
+
+// DownloadLog sends the file name first, then the file in chunks, and the checksum of the whole file last. The
+// download failed is stopped by the error, without the checksum.
+func (w *webServerLogWatcherServer) DownloadLog(request *pbv1.LogDownloadRequest, stream pbv1.WebServerLogWatcher_DownloadLogServer) error {
+	reqCtx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	respCtx, resp, err := w.handler.HandlerDownloadLog().ServeGRPC(reqCtx, request) // resp is a *v1.WebServerLogDownload
+	if err != nil {
+		return err
+	}
+	respDownload := resp.(*v1.WebServerLogDownload)
+	if err = stream.Send(&pbv1.LogChunk{FileName: respDownload.FileName}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		case <-respCtx.Done():
+			return respCtx.Err()
+		case chunk, ok := <-respDownload.Chunks:
+			if !ok {
+				if err := respDownload.Err(); err != nil {
+					return errors.Wrapf(err, "failed to download '%s'", respDownload.FileName)
+				}
+				return stream.Send(&pbv1.LogChunk{Checksum: respDownload.Checksum()})
+			}
+			err = utils.StreamSendMsg(stream, chunk, w.options.ChunkSize, func(msg []byte) interface{} {
+				return &pbv1.LogChunk{Data: msg}
+			})
+			if err != nil && err != io.EOF {
+				return err
+			}
+			if err == io.EOF {
+				return nil
+			}
+		}
+	}
+}
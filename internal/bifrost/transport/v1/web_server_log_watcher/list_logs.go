@@ -0,0 +1,14 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerLogWatcherServer) ListLogs(ctx context.Context, servername *pbv1.ServerName) (*pbv1.LogFiles, error) {
+	_, resp, err := w.handler.HandlerListLogs().ServeGRPC(ctx, servername)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.LogFiles), nil
+}
@@ -0,0 +1,56 @@
+package web_server_log_watcher
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+	"io"
+)
+
+// QueryLogs sends the queried lines in chunks of the chunk size, the lines are not split, unless a line is larger
+// than the chunk size.
+func (w *webServerLogWatcherServer) QueryLogs(request *pbv1.LogQueryRequest, stream pbv1.WebServerLogWatcher_QueryLogsServer) error {
+	reqCtx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	respCtx, resp, err := w.handler.HandlerQueryLogs().ServeGRPC(reqCtx, request) // resp is a *v1.WebServerLog
+	if err != nil {
+		return err
+	}
+	respLog := resp.(*v1.WebServerLog)
+
+	chunk := make([]byte, 0, w.options.ChunkSize)
+	send := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		err := utils.StreamSendMsg(stream, chunk, w.options.ChunkSize, func(msg []byte) interface{} {
+			return &pbv1.Response{Msg: msg}
+		})
+		chunk = make([]byte, 0, w.options.ChunkSize)
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	for {
+		select {
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		case <-respCtx.Done():
+			return respCtx.Err()
+		case line, ok := <-respLog.Lines:
+			if !ok {
+				return send()
+			}
+			if len(chunk) > 0 && len(chunk)+len(line)+1 > w.options.ChunkSize {
+				if err = send(); err != nil {
+					return err
+				}
+			}
+			chunk = append(chunk, line...)
+			chunk = append(chunk, '\n')
+		}
+	}
+}
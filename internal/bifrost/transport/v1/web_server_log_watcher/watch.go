@@ -6,8 +6,13 @@ import (
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
 	"io"
+	"time"
 )
 
+// reportInterval is the interval of checking the lines dropped for the client and the cursor of the lines skipped,
+// which are reported to the client by a response without message when they change.
+const reportInterval = time.Second
+
 func (w *webServerLogWatcherServer) Watch(request *pbv1.LogWatchRequest, stream pbv1.WebServerLogWatcher_WatchServer) error {
 	reqCtx, cancel := context.WithCancel(stream.Context())
 	defer cancel()
@@ -18,19 +23,79 @@ func (w *webServerLogWatcherServer) Watch(request *pbv1.LogWatchRequest, stream
 	}
 	respWatcher := resp.(*v1.WebServerLog)
 
+	var (
+		reportC  <-chan time.Time
+		dropped  uint64
+		cursor   string
+		reported = &pbv1.Response{}
+	)
+	if respWatcher.Dropped != nil || respWatcher.Cursor != nil {
+		ticker := time.NewTicker(reportInterval)
+		defer ticker.Stop()
+		reportC = ticker.C
+	}
+	if respWatcher.Cursor != nil {
+		cursor = respWatcher.Cursor()
+		reported.Cursor = cursor
+	}
+	if respWatcher.Gap != nil && respWatcher.Gap() {
+		reported.Gap = true
+	}
+	// the first response reports the cursor before the lines, and the gap if the lines after the resumed cursor are lost
+	if reported.Cursor != "" || reported.Gap {
+		if err = stream.Send(reported); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+
 	for {
 		select {
 		case <-reqCtx.Done():
 			return reqCtx.Err()
 		case <-respCtx.Done():
 			return respCtx.Err()
+		case <-reportC:
+			report := &pbv1.Response{}
+			if respWatcher.Dropped != nil {
+				if d := respWatcher.Dropped(); d > dropped {
+					dropped = d
+					report.Dropped = d
+				}
+			}
+			if respWatcher.Cursor != nil {
+				if c := respWatcher.Cursor(); c != cursor {
+					cursor = c
+					report.Cursor = c
+				}
+			}
+			if report.Dropped == 0 && report.Cursor == "" {
+				continue
+			}
+			if err = stream.Send(report); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
 		case line := <-respWatcher.Lines:
 			if line == nil {
 				return nil
 			}
 			line = append(line, '\n')
+			sent := 0
 			err = utils.StreamSendMsg(stream, line, w.options.ChunkSize, func(msg []byte) interface{} {
-				return &pbv1.Response{Msg: msg}
+				resp := &pbv1.Response{Msg: msg}
+				// the cursor is sent with the last chunk of the line
+				if sent += len(msg); sent == len(line) && respWatcher.Cursor != nil {
+					if c := respWatcher.Cursor(); c != cursor {
+						cursor = c
+						resp.Cursor = c
+					}
+				}
+				return resp
 			})
 			if err != nil && err != io.EOF {
 				return err
@@ -0,0 +1,14 @@
+package web_server_manager
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerManagerServer) GetStates(ctx context.Context, null *pbv1.Null) (*pbv1.ConfigManagerStates, error) {
+	_, resp, err := w.handler.HandlerGetStates().ServeGRPC(ctx, null)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.ConfigManagerStates), nil
+}
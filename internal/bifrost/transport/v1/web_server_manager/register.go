@@ -0,0 +1,22 @@
+package web_server_manager
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerManagerServer) Register(ctx context.Context, server *pbv1.ManagedWebServer) (*pbv1.Response, error) {
+	_, resp, err := w.handler.HandlerRegister().ServeGRPC(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.Response), nil
+}
+
+func (w *webServerManagerServer) Reconfigure(ctx context.Context, server *pbv1.ManagedWebServer) (*pbv1.Response, error) {
+	_, resp, err := w.handler.HandlerReconfigure().ServeGRPC(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.Response), nil
+}
@@ -0,0 +1,14 @@
+package web_server_manager
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerManagerServer) Unregister(ctx context.Context, servername *pbv1.ServerName) (*pbv1.Response, error) {
+	_, resp, err := w.handler.HandlerUnregister().ServeGRPC(ctx, servername)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.Response), nil
+}
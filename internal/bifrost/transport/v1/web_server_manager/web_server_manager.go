@@ -0,0 +1,21 @@
+package web_server_manager
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+var _ pbv1.WebServerManagerServer = &webServerManagerServer{}
+
+type webServerManagerServer struct {
+	handler handler.WebServerManagerHandlers
+	options *options.Options
+}
+
+func NewWebServerManagerServer(handler handler.WebServerManagerHandlers, options *options.Options) pbv1.WebServerManagerServer {
+	return &webServerManagerServer{
+		handler: handler,
+		options: options,
+	}
+}
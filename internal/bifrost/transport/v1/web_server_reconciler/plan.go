@@ -0,0 +1,14 @@
+package web_server_reconciler
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerReconcilerServer) Plan(ctx context.Context, null *pbv1.Null) (*pbv1.ReconcilePlans, error) {
+	_, resp, err := w.handler.HandlerPlan().ServeGRPC(ctx, null)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.ReconcilePlans), nil
+}
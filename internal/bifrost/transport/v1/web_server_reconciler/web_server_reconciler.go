@@ -0,0 +1,21 @@
+package web_server_reconciler
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+var _ pbv1.WebServerReconcilerServer = &webServerReconcilerServer{}
+
+type webServerReconcilerServer struct {
+	handler handler.WebServerReconcilerHandlers
+	options *options.Options
+}
+
+func NewWebServerReconcilerServer(handler handler.WebServerReconcilerHandlers, options *options.Options) pbv1.WebServerReconcilerServer {
+	return &webServerReconcilerServer{
+		handler: handler,
+		options: options,
+	}
+}
@@ -0,0 +1,18 @@
+package web_server_route_simulator
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+)
+
+func (w *webServerRouteSimulatorServer) Simulate(r *pbv1.RouteRequest, stream pbv1.WebServerRouteSimulator_SimulateServer) error {
+	_, resp, err := w.handler.HandlerSimulate().ServeGRPC(stream.Context(), r)
+	if err != nil {
+		return err
+	}
+
+	response := resp.(*pbv1.RouteResult)
+	return utils.StreamSendMsg(stream, response.GetJsonData(), w.options.ChunkSize, func(msg []byte) interface{} {
+		return &pbv1.RouteResult{JsonData: msg}
+	})
+}
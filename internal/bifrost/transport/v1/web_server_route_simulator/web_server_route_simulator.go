@@ -0,0 +1,18 @@
+package web_server_route_simulator
+
+import (
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+type webServerRouteSimulatorServer struct {
+	handler handler.WebServerRouteSimulatorHandlers
+	options *options.Options
+}
+
+func NewWebServerRouteSimulatorServer(handler handler.WebServerRouteSimulatorHandlers, options *options.Options) *webServerRouteSimulatorServer {
+	return &webServerRouteSimulatorServer{
+		handler: handler,
+		options: options,
+	}
+}
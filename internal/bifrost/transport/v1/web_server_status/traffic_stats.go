@@ -0,0 +1,51 @@
+package web_server_status
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/utils"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"io"
+)
+
+// TrafficStats sends each traffic stats as a line of json data.
+func (w *webServerStatusServer) TrafficStats(request *pbv1.TrafficStatsRequest, stream pbv1.WebServerStatus_TrafficStatsServer) error {
+	reqCtx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	respCtx, resp, err := w.handler.HandlerTrafficStats().ServeGRPC(reqCtx, request) // resp is a *v1.WebServerTrafficStats
+	if err != nil {
+		return err
+	}
+	respStats := resp.(*v1.WebServerTrafficStats)
+
+	for {
+		select {
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		case <-respCtx.Done():
+			return respCtx.Err()
+		case stats := <-respStats.Stats:
+			if stats == nil {
+				return nil
+			}
+			line, err := json.Marshal(stats)
+			if err != nil {
+				return errors.WithCode(code.ErrEncodingFailed, err.Error())
+			}
+			line = append(line, '\n')
+			err = utils.StreamSendMsg(stream, line, w.options.ChunkSize, func(msg []byte) interface{} {
+				return &pbv1.Response{Msg: msg}
+			})
+			if err != nil && err != io.EOF {
+				return err
+			}
+			if err == io.EOF {
+				return nil
+			}
+		}
+	}
+}
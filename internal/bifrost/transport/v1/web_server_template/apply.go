@@ -0,0 +1,14 @@
+package web_server_template
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerTemplateServer) Apply(ctx context.Context, request *pbv1.TemplateApplyRequest) (*pbv1.TemplateApplyResult, error) {
+	_, resp, err := w.handler.HandlerApply().ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.TemplateApplyResult), nil
+}
@@ -0,0 +1,21 @@
+package web_server_template
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+var _ pbv1.WebServerTemplateServer = &webServerTemplateServer{}
+
+type webServerTemplateServer struct {
+	handler handler.WebServerTemplateHandlers
+	options *options.Options
+}
+
+func NewWebServerTemplateServer(handler handler.WebServerTemplateHandlers, options *options.Options) pbv1.WebServerTemplateServer {
+	return &webServerTemplateServer{
+		handler: handler,
+		options: options,
+	}
+}
@@ -0,0 +1,14 @@
+package web_server_upstream
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerUpstreamServer) AddMember(ctx context.Context, request *pbv1.UpstreamMemberRequest) (*pbv1.Response, error) {
+	_, resp, err := w.handler.HandlerAddMember().ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.Response), nil
+}
@@ -0,0 +1,14 @@
+package web_server_upstream
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+)
+
+func (w *webServerUpstreamServer) List(ctx context.Context, servername *pbv1.ServerName) (*pbv1.Upstreams, error) {
+	_, resp, err := w.handler.HandlerList().ServeGRPC(ctx, servername)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pbv1.Upstreams), nil
+}
@@ -0,0 +1,21 @@
+package web_server_upstream
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/handler"
+	"github.com/ClessLi/bifrost/internal/bifrost/transport/v1/options"
+)
+
+var _ pbv1.WebServerUpstreamServer = &webServerUpstreamServer{}
+
+type webServerUpstreamServer struct {
+	handler handler.WebServerUpstreamHandlers
+	options *options.Options
+}
+
+func NewWebServerUpstreamServer(handler handler.WebServerUpstreamHandlers, options *options.Options) pbv1.WebServerUpstreamServer {
+	return &webServerUpstreamServer{
+		handler: handler,
+		options: options,
+	}
+}
@@ -0,0 +1,256 @@
+package access_log
+
+import (
+	"fmt"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Filter is a field-based filter of the records, e.g. `status>=500 && host=shop`.
+//
+// The conditions are combined by `&&` and `||`, and grouped by parentheses. A condition compares a field with a value
+// by one of the operators:
+//     =, ==, !=    equal or not, the values are compared as numbers if both of them are numbers
+//     >, >=, <, <= compare the numbers, false if either of them is not a number
+//     ~, !~        match the regexp or not
+// The value is a word, or a quoted string if it contains spaces or operators, and the missing field is an empty value.
+type Filter interface {
+	Match(record Record) bool
+}
+
+type andFilter []Filter
+
+func (a andFilter) Match(record Record) bool {
+	for _, f := range a {
+		if !f.Match(record) {
+			return false
+		}
+	}
+	return true
+}
+
+type orFilter []Filter
+
+func (o orFilter) Match(record Record) bool {
+	for _, f := range o {
+		if f.Match(record) {
+			return true
+		}
+	}
+	return false
+}
+
+type condition struct {
+	field   string
+	op      string
+	value   string
+	number  *float64
+	pattern *regexp.Regexp
+}
+
+func (c *condition) Match(record Record) bool {
+	value := record[c.field]
+	switch c.op {
+	case "~":
+		return c.pattern.MatchString(value)
+	case "!~":
+		return !c.pattern.MatchString(value)
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	isNumber := err == nil && c.number != nil
+	switch c.op {
+	case "=", "==":
+		if isNumber {
+			return number == *c.number
+		}
+		return value == c.value
+	case "!=":
+		if isNumber {
+			return number != *c.number
+		}
+		return value != c.value
+	}
+	if !isNumber {
+		return false
+	}
+	switch c.op {
+	case ">":
+		return number > *c.number
+	case ">=":
+		return number >= *c.number
+	case "<":
+		return number < *c.number
+	default: // "<="
+		return number <= *c.number
+	}
+}
+
+// ParseFilter parses the filter expression.
+func ParseFilter(expr string) (Filter, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{expr: expr, tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, p.errorf("unexpected '%s'", p.tokens[p.pos].text)
+	}
+	return f, nil
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+var operators = []string{"&&", "||", "==", "!=", ">=", "<=", "!~", "=", ">", "<", "~", "(", ")"}
+
+func tokenize(expr string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		if unicode.IsSpace(rune(c)) {
+			i++
+			continue
+		}
+		if c == '"' || c == '\'' {
+			end := strings.IndexByte(expr[i+1:], c)
+			if end < 0 {
+				return nil, errors.WithCode(code.ErrInvalidLogFilter, "unterminated quote in filter '%s'", expr)
+			}
+			tokens = append(tokens, token{text: expr[i+1 : i+1+end], quoted: true})
+			i += end + 2
+			continue
+		}
+		if op := operatorAt(expr, i); op != "" {
+			tokens = append(tokens, token{text: op})
+			i += len(op)
+			continue
+		}
+		start := i
+		for i < len(expr) && !unicode.IsSpace(rune(expr[i])) && expr[i] != '"' && expr[i] != '\'' && operatorAt(expr, i) == "" {
+			i++
+		}
+		tokens = append(tokens, token{text: expr[start:i]})
+	}
+	if len(tokens) == 0 {
+		return nil, errors.WithCode(code.ErrInvalidLogFilter, "filter is empty")
+	}
+	return tokens, nil
+}
+
+func operatorAt(expr string, i int) string {
+	for _, op := range operators {
+		if strings.HasPrefix(expr[i:], op) {
+			return op
+		}
+	}
+	return ""
+}
+
+type filterParser struct {
+	expr   string
+	tokens []token
+	pos    int
+}
+
+func (p *filterParser) errorf(format string, args ...interface{}) error {
+	return errors.WithCode(code.ErrInvalidLogFilter, "invalid filter '%s', %s", p.expr, fmt.Sprintf(format, args...))
+}
+
+func (p *filterParser) peek(text string) bool {
+	return p.pos < len(p.tokens) && !p.tokens[p.pos].quoted && p.tokens[p.pos].text == text
+}
+
+func (p *filterParser) parseOr() (Filter, error) {
+	filters := make(orFilter, 0)
+	for {
+		f, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+		if !p.peek("||") {
+			break
+		}
+		p.pos++
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return filters, nil
+}
+
+func (p *filterParser) parseAnd() (Filter, error) {
+	filters := make(andFilter, 0)
+	for {
+		f, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+		if !p.peek("&&") {
+			break
+		}
+		p.pos++
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return filters, nil
+}
+
+func (p *filterParser) parseCondition() (Filter, error) {
+	if p.peek("(") {
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peek(")") {
+			return nil, p.errorf("missing ')'")
+		}
+		p.pos++
+		return f, nil
+	}
+	if p.pos+3 > len(p.tokens) {
+		return nil, p.errorf("incomplete condition")
+	}
+	field, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if field.quoted || operatorAt(field.text, 0) != "" {
+		return nil, p.errorf("invalid field '%s'", field.text)
+	}
+	if !value.quoted && operatorAt(value.text, 0) != "" {
+		return nil, p.errorf("invalid value '%s' of field '%s'", value.text, field.text)
+	}
+	if op.quoted {
+		return nil, p.errorf("invalid operator '%s' of field '%s'", op.text, field.text)
+	}
+	p.pos += 3
+	c := &condition{field: strings.TrimPrefix(field.text, "$"), op: op.text, value: value.text}
+	switch op.text {
+	case "~", "!~":
+		pattern, err := regexp.Compile(value.text)
+		if err != nil {
+			return nil, p.errorf("invalid regexp '%s'. %s", value.text, err.Error())
+		}
+		c.pattern = pattern
+	case "=", "==", "!=", ">", ">=", "<", "<=":
+		if number, err := strconv.ParseFloat(value.text, 64); err == nil {
+			c.number = &number
+		} else if op.text != "=" && op.text != "==" && op.text != "!=" {
+			return nil, p.errorf("the value '%s' of field '%s' is not a number", value.text, field.text)
+		}
+	default:
+		return nil, p.errorf("invalid operator '%s' of field '%s'", op.text, field.text)
+	}
+	return c, nil
+}
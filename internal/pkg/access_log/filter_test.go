@@ -0,0 +1,42 @@
+package access_log
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	record := Record{"status": "502", "host": "shop", "request_time": "1.250", "request_uri": "/api/v1/orders", "upstream_addr": "-"}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "status>=500 && host=shop", want: true},
+		{expr: "status>=500&&host=www", want: false},
+		{expr: "status == 502.0", want: true},
+		{expr: "status<500 || request_time>1", want: true},
+		{expr: "(status<500 || host!=shop) && request_time>1", want: false},
+		{expr: `request_uri ~ "^/api/v\d+/"`, want: true},
+		{expr: `request_uri !~ '^/static/'`, want: true},
+		{expr: "$host=shop", want: true},
+		{expr: "referer=''", want: true},
+		{expr: "upstream_addr>0", want: false},
+	}
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.expr)
+		if err != nil {
+			t.Errorf("ParseFilter(%q) error = %+v", tt.expr, err)
+			continue
+		}
+		if got := f.Match(record); got != tt.want {
+			t.Errorf("ParseFilter(%q).Match() = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+
+	for _, expr := range []string{"", "status", "status>=", "status>=abc", "(status=1", "status=1 host=shop", "status ~ '('", `host="shop`, "status=1 &&", "status \"=\" 1"} {
+		if _, err := ParseFilter(expr); !errors.IsCode(err, code.ErrInvalidLogFilter) {
+			t.Errorf("ParseFilter(%q) error = %v, want ErrInvalidLogFilter", expr, err)
+		}
+	}
+}
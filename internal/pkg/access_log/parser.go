@@ -0,0 +1,157 @@
+package access_log
+
+import (
+	"encoding/json"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CombinedFormat is the predefined `combined` format of nginx.
+const CombinedFormat = `$remote_addr - $remote_user [$time_local] "$request" $status $body_bytes_sent "$http_referer" "$http_user_agent"`
+
+// RawField is the only field of the records of the lines not matched by the log format.
+const RawField = "raw"
+
+const ( // escape of the log format
+	EscapeDefault = "default"
+	EscapeJSON    = "json"
+	EscapeNone    = "none"
+)
+
+var variablePattern = regexp.MustCompile(`\$(?:\{(\w+)}|(\w+))`)
+
+// Record is an access log line parsed into the fields named by the variables of the log format, without the `$`.
+type Record map[string]string
+
+// Bytes returns the json of the record.
+func (r Record) Bytes() []byte {
+	data, _ := json.Marshal(r)
+	return data
+}
+
+// Parser parses the access log lines written in a log format.
+type Parser struct {
+	patterns []*regexp.Regexp
+	fields   []string
+	escape   string
+}
+
+// Compile compiles the log format into a parser, the escape is the `escape` parameter of the `log_format` directive,
+// and the empty escape means the default one.
+func Compile(format, escape string) (*Parser, error) {
+	switch escape {
+	case "":
+		escape = EscapeDefault
+	case EscapeDefault, EscapeJSON, EscapeNone:
+	default:
+		return nil, errors.WithCode(code.ErrInvalidLogFormat, "invalid escape '%s' of log format", escape)
+	}
+	locs := variablePattern.FindAllStringSubmatchIndex(format, -1)
+	if len(locs) == 0 {
+		return nil, errors.WithCode(code.ErrInvalidLogFormat, "no variable in log format '%s'", format)
+	}
+
+	// the values of the variables followed by spaces are matched as words, or the lists joined by ", " like the ones of
+	// `$upstream_addr`, and then every value is matched lazily, if the line is not matched.
+	var word, lazy strings.Builder
+	word.WriteString("^")
+	lazy.WriteString("^")
+	fields := make([]string, 0, len(locs))
+	last := 0
+	for i, loc := range locs {
+		literal := regexp.QuoteMeta(format[last:loc[0]])
+		word.WriteString(literal)
+		lazy.WriteString(literal)
+		next := len(format)
+		if i+1 < len(locs) {
+			next = locs[i+1][0]
+		}
+		if loc[1] < next && format[loc[1]] == ' ' {
+			word.WriteString("((?:, |[^ ])*)")
+		} else {
+			word.WriteString("(.*?)")
+		}
+		lazy.WriteString("(.*?)")
+		if loc[2] >= 0 {
+			fields = append(fields, format[loc[2]:loc[3]])
+		} else {
+			fields = append(fields, format[loc[4]:loc[5]])
+		}
+		last = loc[1]
+	}
+	patterns := make([]*regexp.Regexp, 0, 2)
+	for _, pattern := range []*strings.Builder{&word, &lazy} {
+		pattern.WriteString(regexp.QuoteMeta(format[last:]))
+		pattern.WriteString("$")
+		re, err := regexp.Compile(pattern.String())
+		if err != nil {
+			return nil, errors.WithCode(code.ErrInvalidLogFormat, "failed to compile log format '%s'. %s", format, err.Error())
+		}
+		patterns = append(patterns, re)
+	}
+	return &Parser{patterns: patterns, fields: fields, escape: escape}, nil
+}
+
+// Parse parses the line into a record, the values escaped by nginx are unescaped. The method, uri and protocol of the
+// `$request` are parsed into `request_method`, `request_uri` and `server_protocol`, unless they are in the format.
+func (p *Parser) Parse(line []byte) (Record, bool) {
+	var m [][]byte
+	for _, pattern := range p.patterns {
+		if m = pattern.FindSubmatch(line); m != nil {
+			break
+		}
+	}
+	if m == nil {
+		return nil, false
+	}
+	record := make(Record, len(p.fields)+3)
+	for i, field := range p.fields {
+		value := p.unescape(string(m[i+1]))
+		// the value of the variable repeated in the format is the first one
+		if _, has := record[field]; !has {
+			record[field] = value
+		}
+	}
+	if request, has := record["request"]; has {
+		parts := strings.SplitN(request, " ", 3)
+		if len(parts) == 3 {
+			for i, field := range []string{"request_method", "request_uri", "server_protocol"} {
+				if _, has := record[field]; !has {
+					record[field] = parts[i]
+				}
+			}
+		}
+	}
+	return record, true
+}
+
+func (p *Parser) unescape(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	switch p.escape {
+	case EscapeDefault:
+		// the `"`, `\` and the characters out of the printable ascii are escaped as `\xXX`
+		var b strings.Builder
+		for i := 0; i < len(value); i++ {
+			if value[i] == '\\' && i+3 < len(value) && value[i+1] == 'x' {
+				if c, err := strconv.ParseUint(value[i+2:i+4], 16, 8); err == nil {
+					b.WriteByte(byte(c))
+					i += 3
+					continue
+				}
+			}
+			b.WriteByte(value[i])
+		}
+		return b.String()
+	case EscapeJSON:
+		var unquoted string
+		if err := json.Unmarshal([]byte(`"`+value+`"`), &unquoted); err == nil {
+			return unquoted
+		}
+	}
+	return value
+}
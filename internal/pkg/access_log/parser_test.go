@@ -0,0 +1,80 @@
+package access_log
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"reflect"
+	"testing"
+)
+
+func TestParser_Parse(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		escape string
+		line   string
+		want   Record
+	}{
+		{
+			name:   "combined",
+			format: CombinedFormat,
+			line:   `127.0.0.1 - - [10/Oct/2020:13:55:36 +0800] "GET /a?b=\x22c\x22 HTTP/1.1" 200 612 "-" "curl/7.64.1"`,
+			want: Record{
+				"remote_addr":     "127.0.0.1",
+				"remote_user":     "-",
+				"time_local":      "10/Oct/2020:13:55:36 +0800",
+				"request":         `GET /a?b="c" HTTP/1.1`,
+				"request_method":  "GET",
+				"request_uri":     `/a?b="c"`,
+				"server_protocol": "HTTP/1.1",
+				"status":          "200",
+				"body_bytes_sent": "612",
+				"http_referer":    "-",
+				"http_user_agent": "curl/7.64.1",
+			},
+		},
+		{
+			name:   "multiple upstreams",
+			format: `$host $status ${request_time}s $upstream_addr $upstream_response_time`,
+			line:   `shop 502 0.120s 10.0.0.1:80, 10.0.0.2:80 0.060, 0.060`,
+			want: Record{
+				"host":                   "shop",
+				"status":                 "502",
+				"request_time":           "0.120",
+				"upstream_addr":          "10.0.0.1:80, 10.0.0.2:80",
+				"upstream_response_time": "0.060, 0.060",
+			},
+		},
+		{
+			name:   "json",
+			format: `{"host":"$host","uri":"$request_uri"}`,
+			escape: EscapeJSON,
+			line:   `{"host":"shop","uri":"/a\"b"}`,
+			want:   Record{"host": "shop", "request_uri": `/a"b`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Compile(tt.format, tt.escape)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			got, ok := p.Parse([]byte(tt.line))
+			if !ok || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, %v, want %v", got, ok, tt.want)
+			}
+			if _, ok = p.Parse([]byte("not a log line")); ok {
+				t.Errorf("Parse() of an invalid line should fail")
+			}
+		})
+	}
+
+	for _, format := range []string{"no variables", ""} {
+		if _, err := Compile(format, ""); !errors.IsCode(err, code.ErrInvalidLogFormat) {
+			t.Errorf("Compile(%q) error = %v, want ErrInvalidLogFormat", format, err)
+		}
+	}
+	if _, err := Compile(CombinedFormat, "html"); !errors.IsCode(err, code.ErrInvalidLogFormat) {
+		t.Errorf("Compile() with invalid escape error = %v, want ErrInvalidLogFormat", err)
+	}
+}
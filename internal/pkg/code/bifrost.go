@@ -33,6 +33,15 @@ const (
 
 	// ErrParseFailed - 500: Config parse failed.
 	ErrParseFailed
+
+	// ErrWebServerAlreadyExists - 400: Web server already exists.
+	ErrWebServerAlreadyExists
+
+	// ErrConfigConflict - 500: Config files and configuration are both changed.
+	ErrConfigConflict
+
+	// ErrConfigMergeFailed - 500: Config files and configuration merge failed.
+	ErrConfigMergeFailed
 )
 
 // bifrost: statistics errors.
@@ -70,3 +79,87 @@ const (
 	// ErrUnknownLockError - 500: Unknown lock error.
 	ErrUnknownLockError
 )
+
+// bifrost: lint errors.
+const (
+	// ErrLintRuleNotFound - 400: Lint rule not found.
+	ErrLintRuleNotFound int = iota + 110401
+
+	// ErrLintRuleAlreadyRegistered - 500: Lint rule is already registered.
+	ErrLintRuleAlreadyRegistered
+
+	// ErrInvalidLintSeverity - 400: Invalid lint severity.
+	ErrInvalidLintSeverity
+)
+
+// bifrost: template errors.
+const (
+	// ErrTemplateNotFound - 404: Template not found.
+	ErrTemplateNotFound int = iota + 110501
+
+	// ErrInvalidTemplate - 500: Invalid template.
+	ErrInvalidTemplate
+
+	// ErrInvalidTemplateParameter - 400: Invalid template parameter.
+	ErrInvalidTemplateParameter
+)
+
+// bifrost: reconciler errors.
+const (
+	// ErrInvalidSiteDefinition - 400: Invalid site definition.
+	ErrInvalidSiteDefinition int = iota + 110601
+
+	// ErrReconcileFailed - 500: Reconcile failed.
+	ErrReconcileFailed
+)
+
+// bifrost: upstream errors.
+const (
+	// ErrUpstreamNotFound - 404: Upstream not found.
+	ErrUpstreamNotFound int = iota + 110701
+
+	// ErrUpstreamMemberNotFound - 404: Upstream member not found.
+	ErrUpstreamMemberNotFound
+
+	// ErrInvalidUpstreamMember - 400: Invalid upstream member.
+	ErrInvalidUpstreamMember
+)
+
+// bifrost: web server log errors.
+const (
+	// ErrInvalidLogQuery - 400: Invalid log query.
+	ErrInvalidLogQuery int = iota + 110801
+
+	// ErrLogNotFound - 404: Log not found.
+	ErrLogNotFound
+
+	// ErrInvalidLogFilter - 400: Invalid log filter.
+	ErrInvalidLogFilter
+
+	// ErrInvalidLogFormat - 400: Invalid log format.
+	ErrInvalidLogFormat
+
+	// ErrLogFormatNotFound - 404: Log format not found.
+	ErrLogFormatNotFound
+
+	// ErrInvalidLogCursor - 400: Invalid log cursor.
+	ErrInvalidLogCursor
+
+	// ErrInvalidLogDownload - 400: Invalid log download.
+	ErrInvalidLogDownload
+)
+
+// bifrost: traffic stats errors.
+const (
+	// ErrTrafficStatsDisabled - 400: Traffic stats disabled.
+	ErrTrafficStatsDisabled int = iota + 110901
+)
+
+// bifrost: log alert errors.
+const (
+	// ErrLogAlertDisabled - 400: Log alert disabled.
+	ErrLogAlertDisabled int = iota + 111001
+
+	// ErrLogAlertRuleNotFound - 404: Log alert rule not found.
+	ErrLogAlertRuleNotFound
+)
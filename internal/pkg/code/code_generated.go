@@ -45,6 +45,9 @@ func init() {
 	register(ErrUnknownKeywordString, 500, "Unknown keyword string")
 	register(ErrInvalidConfig, 500, "Invalid parser.Config")
 	register(ErrParseFailed, 500, "Config parse failed")
+	register(ErrWebServerAlreadyExists, 400, "Web server already exists")
+	register(ErrConfigConflict, 500, "Config files and configuration are both changed")
+	register(ErrConfigMergeFailed, 500, "Config files and configuration merge failed")
 	register(ErrStopMonitoringTimeout, 500, "Stop monitoring timeout")
 	register(ErrMonitoringServiceSuspension, 500, "Monitoring service suspension")
 	register(ErrMonitoringStarted, 500, "Monitoring is already started")
@@ -54,4 +57,25 @@ func init() {
 	register(ErrLogIsLocked, 500, "Log is locked")
 	register(ErrLogIsUnlocked, 500, "Log is unlocked")
 	register(ErrUnknownLockError, 500, "Unknown lock error")
+	register(ErrLintRuleNotFound, 400, "Lint rule not found")
+	register(ErrLintRuleAlreadyRegistered, 500, "Lint rule is already registered")
+	register(ErrInvalidLintSeverity, 400, "Invalid lint severity")
+	register(ErrTemplateNotFound, 404, "Template not found")
+	register(ErrInvalidTemplate, 500, "Invalid template")
+	register(ErrInvalidTemplateParameter, 400, "Invalid template parameter")
+	register(ErrInvalidSiteDefinition, 400, "Invalid site definition")
+	register(ErrReconcileFailed, 500, "Reconcile failed")
+	register(ErrUpstreamNotFound, 404, "Upstream not found")
+	register(ErrUpstreamMemberNotFound, 404, "Upstream member not found")
+	register(ErrInvalidUpstreamMember, 400, "Invalid upstream member")
+	register(ErrInvalidLogQuery, 400, "Invalid log query")
+	register(ErrLogNotFound, 404, "Log not found")
+	register(ErrInvalidLogFilter, 400, "Invalid log filter")
+	register(ErrInvalidLogFormat, 400, "Invalid log format")
+	register(ErrLogFormatNotFound, 404, "Log format not found")
+	register(ErrInvalidLogCursor, 400, "Invalid log cursor")
+	register(ErrInvalidLogDownload, 400, "Invalid log download")
+	register(ErrTrafficStatsDisabled, 400, "Traffic stats disabled")
+	register(ErrLogAlertDisabled, 400, "Log alert disabled")
+	register(ErrLogAlertRuleNotFound, 404, "Log alert rule not found")
 }
@@ -0,0 +1,163 @@
+package error_log
+
+import (
+	"encoding/json"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const upstreamLine = `2020/10/10 13:05:00 [error] 1234#0: *90 connect() failed (111: Connection refused) while connecting to upstream, ` +
+	`client: 10.0.0.1, server: shop.example.com, request: "GET /api?a=1, b HTTP/1.1", upstream: "http://10.0.0.2:8080/api", host: "shop.example.com"`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		line string
+		want *Entry
+	}{
+		{
+			line: upstreamLine,
+			want: &Entry{
+				Time:         time.Date(2020, 10, 10, 13, 5, 0, 0, time.Local),
+				Level:        "error",
+				PID:          1234,
+				ConnectionID: 90,
+				Message:      "connect() failed (111: Connection refused) while connecting to upstream",
+				Client:       "10.0.0.1",
+				Server:       "shop.example.com",
+				Request:      "GET /api?a=1, b HTTP/1.1",
+				Upstream:     "http://10.0.0.2:8080/api",
+				Host:         "shop.example.com",
+			},
+		},
+		{
+			line: "2020/10/10 13:06:00 [notice] 1#1: signal process started",
+			want: &Entry{
+				Time:    time.Date(2020, 10, 10, 13, 6, 0, 0, time.Local),
+				Level:   "notice",
+				PID:     1,
+				TID:     1,
+				Message: "signal process started",
+			},
+		},
+	}
+	for _, tt := range tests {
+		got, ok := Parse([]byte(tt.line))
+		if !ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Parse(%q) = %+v, %v, want %+v", tt.line, got, ok, tt.want)
+		}
+	}
+	if _, ok := Parse([]byte("    at stack line")); ok {
+		t.Errorf("Parse() of a line without time and level should fail")
+	}
+}
+
+func TestUpstreamEventOf(t *testing.T) {
+	tests := []struct {
+		message  string
+		upstream string
+		kind     string
+		member   string
+	}{
+		{message: "connect() failed (111: Connection refused) while connecting to upstream", upstream: "http://10.0.0.2:8080/api", kind: EventConnectFailed, member: "10.0.0.2:8080"},
+		{message: "connect() to 10.0.0.3:9000 failed (113: No route to host) while connecting to upstream", upstream: "fastcgi://10.0.0.3:9000", kind: EventConnectFailed, member: "10.0.0.3:9000"},
+		{message: "upstream timed out (110: Connection timed out) while reading response header from upstream", upstream: "http://10.0.0.2:8080/api", kind: EventTimedOut, member: "10.0.0.2:8080"},
+		{message: "upstream prematurely closed connection while reading response header from upstream", upstream: "http://10.0.0.2:8080/", kind: EventPrematurelyClosed, member: "10.0.0.2:8080"},
+		{message: "recv() failed (104: Connection reset by peer) while reading response header from upstream", upstream: "http://10.0.0.2:8080/", kind: EventConnectionReset, member: "10.0.0.2:8080"},
+		{message: "upstream sent invalid header while reading response header from upstream", upstream: "http://10.0.0.2:8080/", kind: EventInvalidResponse, member: "10.0.0.2:8080"},
+		{message: "no live upstreams while connecting to upstream", upstream: "http://backend/api", kind: EventNoLiveUpstreams, member: "backend"},
+		{message: `open() "/usr/share/nginx/html/favicon.ico" failed (2: No such file or directory)`},
+		{message: "connect() failed (111: Connection refused)"},
+	}
+	for _, tt := range tests {
+		got, ok := UpstreamEventOf(&Entry{Message: tt.message, Upstream: tt.upstream})
+		if tt.kind == "" {
+			if ok {
+				t.Errorf("UpstreamEventOf(%q) = %+v, want no event", tt.message, got)
+			}
+			continue
+		}
+		if !ok || got.Kind != tt.kind || got.Member != tt.member {
+			t.Errorf("UpstreamEventOf(%q) = %+v, want kind %s, member %s", tt.message, got, tt.kind, tt.member)
+		}
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	lines := []string{
+		upstreamLine,
+		"2020/10/10 13:05:01 [warn] 1234#0: *91 an upstream response is buffered to a temporary file",
+		strings.Replace(upstreamLine, "*90", "*92", 1),
+		"2020/10/10 13:05:02 [info] 1234#0: *93 client closed connection while waiting for request",
+		"continuation without time",
+		strings.Replace(upstreamLine, "10.0.0.2", "10.0.0.4", 1),
+		strings.Replace(upstreamLine, "*90", "*94", 1),
+	}
+	start := time.Date(2020, 10, 10, 13, 5, 0, 0, time.Local)
+	run := func(opts Options) []string {
+		p, err := NewPipeline(opts)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		output := make([]string, 0)
+		for i, line := range lines {
+			for _, out := range p.Process([]byte(line), start.Add(time.Duration(i)*time.Second)) {
+				output = append(output, string(out))
+			}
+		}
+		for _, out := range p.Flush(start.Add(time.Hour)) {
+			output = append(output, string(out))
+		}
+		return output
+	}
+
+	if got := run(Options{}); !reflect.DeepEqual(got, lines) {
+		t.Errorf("Process() without options = %q, want the lines", got)
+	}
+	if got := run(Options{MinSeverity: "WARN"}); len(got) != 5 {
+		t.Errorf("Process() of min severity warn = %q, want 5 lines", got)
+	}
+	if got := run(Options{MinSeverity: "error", Filter: "upstream ~ 10.0.0.4"}); len(got) != 1 || got[0] != lines[5] {
+		t.Errorf("Process() of field filter = %q, want the line of 10.0.0.4", got)
+	}
+
+	grouped := run(Options{MinSeverity: "error", GroupWindow: 10 * time.Second})
+	want := []string{lines[0], "2020/10/10 13:05:00 [error] last message repeated 3 times: connect() failed (111: Connection refused) while connecting to upstream"}
+	if !reflect.DeepEqual(grouped, want) {
+		t.Errorf("Process() grouped = %q, want %q", grouped, want)
+	}
+
+	events := run(Options{UpstreamEvents: true, GroupWindow: 10 * time.Second})
+	members := make([]string, 0)
+	for _, data := range events {
+		event := new(UpstreamEvent)
+		if err := json.Unmarshal([]byte(data), event); err != nil {
+			t.Fatal(err)
+		}
+		if event.Kind != EventConnectFailed {
+			t.Errorf("kind of event %s = %s, want %s", data, event.Kind, EventConnectFailed)
+		}
+		members = append(members, event.Member+"*"+string(rune('0'+event.Repeated)))
+	}
+	if want := []string{"10.0.0.2:8080*0", "10.0.0.4:8080*0", "10.0.0.2:8080*2"}; !reflect.DeepEqual(members, want) {
+		t.Errorf("Process() of grouped upstream events = %v, want %v", members, want)
+	}
+
+	structured := run(Options{Structured: true})
+	entry := new(Entry)
+	if err := json.Unmarshal([]byte(structured[0]), entry); err != nil || entry.Upstream != "http://10.0.0.2:8080/api" {
+		t.Errorf("Process() structured = %s, %v", structured[0], err)
+	}
+	if structured[4] != `{"raw":"continuation without time"}` {
+		t.Errorf("Process() structured of unparsed line = %s", structured[4])
+	}
+
+	for _, opts := range []Options{{MinSeverity: "fatal"}, {GroupWindow: -time.Second}, {Filter: "("}} {
+		if _, err := NewPipeline(opts); !errors.IsCode(err, code.ErrInvalidLogFilter) {
+			t.Errorf("NewPipeline(%+v) error = %v, want code %d", opts, err, code.ErrInvalidLogFilter)
+		}
+	}
+}
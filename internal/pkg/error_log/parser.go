@@ -0,0 +1,163 @@
+package error_log
+
+import (
+	"encoding/json"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeLayout is the layout of the time of the error log lines, which is the local time of nginx.
+const TimeLayout = "2006/01/02 15:04:05"
+
+// Levels are the levels of the error log in the ascending order of the severity.
+var Levels = []string{"debug", "info", "notice", "warn", "error", "crit", "alert", "emerg"}
+
+var linePattern = regexp.MustCompile(`^(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}) \[(\w+)] (\d+)#(\d+): (?:\*(\d+) )?(.*)$`)
+
+// Entry is an error log line, e.g.
+//     2020/10/10 13:05:00 [error] 1234#0: *90 connect() failed (111: Connection refused) while connecting to upstream,
+//     client: 10.0.0.1, server: shop.example.com, request: "GET /api HTTP/1.1", upstream: "http://10.0.0.2:8080/api",
+//     host: "shop.example.com"
+// The context of the request following the message is parsed into the fields. Repeated is the number of the entries
+// of the same message grouped into the entry.
+type Entry struct {
+	Time         time.Time `json:"time"`
+	Level        string    `json:"level"`
+	PID          int       `json:"pid"`
+	TID          int       `json:"tid"`
+	ConnectionID int64     `json:"connection-id,omitempty"`
+	Message      string    `json:"message"`
+	Client       string    `json:"client,omitempty"`
+	Server       string    `json:"server,omitempty"`
+	Request      string    `json:"request,omitempty"`
+	Upstream     string    `json:"upstream,omitempty"`
+	Host         string    `json:"host,omitempty"`
+	Referrer     string    `json:"referrer,omitempty"`
+	Repeated     int       `json:"repeated,omitempty"`
+}
+
+// Bytes returns the json of the entry.
+func (e *Entry) Bytes() []byte {
+	data, _ := json.Marshal(e)
+	return data
+}
+
+// Record returns the fields of the entry, which can be matched by the field-based filters of the access logs.
+func (e *Entry) Record() access_log.Record {
+	record := access_log.Record{
+		"time":    e.Time.Format(TimeLayout),
+		"level":   e.Level,
+		"pid":     strconv.Itoa(e.PID),
+		"tid":     strconv.Itoa(e.TID),
+		"message": e.Message,
+	}
+	for field, value := range map[string]string{
+		"client":   e.Client,
+		"server":   e.Server,
+		"request":  e.Request,
+		"upstream": e.Upstream,
+		"host":     e.Host,
+		"referrer": e.Referrer,
+	} {
+		if value != "" {
+			record[field] = value
+		}
+	}
+	if e.ConnectionID > 0 {
+		record["connection_id"] = strconv.FormatInt(e.ConnectionID, 10)
+	}
+	return record
+}
+
+// Severity returns the severity of the level, which is the index of it in Levels, or -1 if it is unknown.
+func Severity(level string) int {
+	for i, l := range Levels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// Parse parses the error log line, the lines not starting with the time, the level and the pid#tid are not parsed.
+func Parse(line []byte) (*Entry, bool) {
+	m := linePattern.FindSubmatch(line)
+	if m == nil {
+		return nil, false
+	}
+	t, err := time.ParseInLocation(TimeLayout, string(m[1]), time.Local)
+	if err != nil {
+		return nil, false
+	}
+	entry := &Entry{Time: t, Level: string(m[2])}
+	entry.PID, _ = strconv.Atoi(string(m[3]))
+	entry.TID, _ = strconv.Atoi(string(m[4]))
+	if len(m[5]) > 0 {
+		entry.ConnectionID, _ = strconv.ParseInt(string(m[5]), 10, 64)
+	}
+	entry.Message = string(m[6])
+	if i := strings.Index(entry.Message, ", client: "); i >= 0 {
+		parseContext(entry, entry.Message[i:])
+		entry.Message = entry.Message[:i]
+	}
+	return entry, true
+}
+
+// parseContext parses the context of the request like `, client: 10.0.0.1, request: "GET / HTTP/1.1"`, the values of
+// which are quoted except the ones of `client` and `server`.
+func parseContext(entry *Entry, context string) {
+	for strings.HasPrefix(context, ", ") {
+		context = context[2:]
+		i := strings.Index(context, ": ")
+		if i < 0 {
+			return
+		}
+		name := context[:i]
+		context = context[i+2:]
+		var value string
+		if strings.HasPrefix(context, `"`) {
+			end := strings.Index(context[1:], `"`)
+			if end < 0 {
+				return
+			}
+			value, context = context[1:end+1], context[end+2:]
+		} else {
+			end := strings.Index(context, ", ")
+			if end < 0 {
+				end = len(context)
+			}
+			value, context = context[:end], context[end:]
+		}
+		switch name {
+		case "client":
+			entry.Client = value
+		case "server":
+			entry.Server = value
+		case "request":
+			entry.Request = value
+		case "upstream":
+			entry.Upstream = value
+		case "host":
+			entry.Host = value
+		case "referrer":
+			entry.Referrer = value
+		}
+	}
+}
+
+// ParseSeverity returns the severity of the min level of the severity filter, or -1 if the level is empty.
+func ParseSeverity(level string) (int, error) {
+	if level == "" {
+		return -1, nil
+	}
+	severity := Severity(strings.ToLower(level))
+	if severity < 0 {
+		return -1, errors.WithCode(code.ErrInvalidLogFilter, "invalid error log level '%s', need one of %v", level, Levels)
+	}
+	return severity, nil
+}
@@ -0,0 +1,152 @@
+package error_log
+
+import (
+	"fmt"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"sort"
+	"time"
+)
+
+// Options are the options of the pipeline of the error log lines.
+type Options struct {
+	// MinSeverity is the min level of the entries, e.g. `warn`, all the lines are passed if it is empty.
+	MinSeverity string
+	// Filter is the field-based filter of the entries, see access_log.Filter and Entry.Record for the fields.
+	Filter string
+	// Structured converts the entries into json.
+	Structured bool
+	// UpstreamEvents passes the upstream failure events in json only.
+	UpstreamEvents bool
+	// GroupWindow groups the repeated messages within the window, the first one of which is passed and the others are
+	// summarized when the window ends. The upstream events are grouped by the member and the kind.
+	GroupWindow time.Duration
+}
+
+type group struct {
+	start    time.Time
+	last     interface{}
+	repeated int
+}
+
+// Pipeline filters, groups and converts the error log lines. It is not safe for concurrent use.
+type Pipeline struct {
+	minSeverity    int
+	filter         access_log.Filter
+	structured     bool
+	upstreamEvents bool
+	groupWindow    time.Duration
+	groups         map[string]*group
+}
+
+// Process processes the line at now, and returns the output lines, including the summaries of the groups ended before
+// now. The lines not parsed are passed as they are, or as the raw records if structured, unless filtered by the
+// severity, the fields or the upstream events.
+func (p *Pipeline) Process(line []byte, now time.Time) [][]byte {
+	output := p.Flush(now)
+	entry, ok := Parse(line)
+	if !ok {
+		if p.minSeverity >= 0 || p.filter != nil || p.upstreamEvents {
+			return output
+		}
+		if p.structured {
+			return append(output, access_log.Record{access_log.RawField: string(line)}.Bytes())
+		}
+		return append(output, line)
+	}
+	if Severity(entry.Level) < p.minSeverity {
+		return output
+	}
+	if p.filter != nil && !p.filter.Match(entry.Record()) {
+		return output
+	}
+	if p.upstreamEvents {
+		event, ok := UpstreamEventOf(entry)
+		if !ok || !p.group(event.Member+" "+event.Kind, event, now) {
+			return output
+		}
+		return append(output, event.Bytes())
+	}
+	if !p.group(entry.Level+" "+entry.Message, entry, now) {
+		return output
+	}
+	if p.structured {
+		return append(output, entry.Bytes())
+	}
+	return append(output, line)
+}
+
+// group returns whether the item is the first one of its group, all the items are the first ones if not grouped.
+func (p *Pipeline) group(key string, item interface{}, now time.Time) bool {
+	if p.groupWindow <= 0 {
+		return true
+	}
+	if g, has := p.groups[key]; has {
+		g.last = item
+		g.repeated++
+		return false
+	}
+	p.groups[key] = &group{start: now, last: item}
+	return true
+}
+
+// Flush returns the summaries of the groups ended before now, in the order of the starts of the groups. The summary of
+// an entry is a line like `2020/10/10 13:05:10 [error] last message repeated 5 times: ...`, or the last entry of the
+// group with the repeated number if structured, and the one of an upstream event is the last event with the number.
+func (p *Pipeline) Flush(now time.Time) [][]byte {
+	ended := make([]*group, 0)
+	for key, g := range p.groups {
+		if now.Sub(g.start) < p.groupWindow {
+			continue
+		}
+		delete(p.groups, key)
+		if g.repeated > 0 {
+			ended = append(ended, g)
+		}
+	}
+	sort.Slice(ended, func(i, j int) bool { return ended[i].start.Before(ended[j].start) })
+	output := make([][]byte, 0, len(ended))
+	for _, g := range ended {
+		switch last := g.last.(type) {
+		case *UpstreamEvent:
+			event := *last
+			event.Repeated = g.repeated
+			output = append(output, event.Bytes())
+		case *Entry:
+			if p.structured {
+				entry := *last
+				entry.Repeated = g.repeated
+				output = append(output, entry.Bytes())
+			} else {
+				output = append(output, []byte(fmt.Sprintf("%s [%s] last message repeated %d times: %s",
+					last.Time.Format(TimeLayout), last.Level, g.repeated, last.Message)))
+			}
+		}
+	}
+	return output
+}
+
+// NewPipeline returns the pipeline of the options, the error is coded code.ErrInvalidLogFilter.
+func NewPipeline(opts Options) (*Pipeline, error) {
+	minSeverity, err := ParseSeverity(opts.MinSeverity)
+	if err != nil {
+		return nil, err
+	}
+	if opts.GroupWindow < 0 {
+		return nil, errors.WithCode(code.ErrInvalidLogFilter, "invalid group window %s", opts.GroupWindow)
+	}
+	p := &Pipeline{
+		minSeverity:    minSeverity,
+		structured:     opts.Structured,
+		upstreamEvents: opts.UpstreamEvents,
+		groupWindow:    opts.GroupWindow,
+		groups:         make(map[string]*group),
+	}
+	if opts.Filter != "" {
+		if p.filter, err = access_log.ParseFilter(opts.Filter); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,87 @@
+package error_log
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const ( // kinds of the upstream events
+	EventConnectFailed     = "connect-failed"
+	EventTimedOut          = "timed-out"
+	EventPrematurelyClosed = "prematurely-closed"
+	EventConnectionReset   = "connection-reset"
+	EventInvalidResponse   = "invalid-response"
+	EventNoLiveUpstreams   = "no-live-upstreams"
+)
+
+// eventPatterns are the patterns of the messages of the upstream failures, in the order of matching.
+var eventPatterns = []struct {
+	kind     string
+	patterns []string
+}{
+	{kind: EventNoLiveUpstreams, patterns: []string{"no live upstreams"}},
+	{kind: EventTimedOut, patterns: []string{"upstream timed out"}},
+	{kind: EventConnectFailed, patterns: []string{"connect() failed", "connect() to "}},
+	{kind: EventPrematurelyClosed, patterns: []string{"upstream prematurely closed"}},
+	{kind: EventConnectionReset, patterns: []string{"Connection reset by peer"}},
+	{kind: EventInvalidResponse, patterns: []string{"upstream sent "}},
+}
+
+// UpstreamEvent is a failure of an upstream member extracted from the error log. The member is the address of the
+// upstream server like `10.0.0.2:8080`, or the name of the upstream block if no live upstreams. Repeated is the number
+// of the events of the same member and kind grouped into the event.
+type UpstreamEvent struct {
+	Time     time.Time `json:"time"`
+	Kind     string    `json:"kind"`
+	Member   string    `json:"member"`
+	Upstream string    `json:"upstream"`
+	Server   string    `json:"server,omitempty"`
+	Request  string    `json:"request,omitempty"`
+	Message  string    `json:"message"`
+	Repeated int       `json:"repeated,omitempty"`
+}
+
+// Bytes returns the json of the event.
+func (e *UpstreamEvent) Bytes() []byte {
+	data, _ := json.Marshal(e)
+	return data
+}
+
+// UpstreamEventOf extracts the upstream failure event from the error log entry, the entries without the upstream or
+// not about the upstream failures are not events.
+func UpstreamEventOf(entry *Entry) (*UpstreamEvent, bool) {
+	if entry.Upstream == "" {
+		return nil, false
+	}
+	for _, p := range eventPatterns {
+		for _, pattern := range p.patterns {
+			if !strings.Contains(entry.Message, pattern) {
+				continue
+			}
+			if p.kind == EventConnectFailed && pattern == "connect() to " && !strings.Contains(entry.Message, " failed") {
+				continue
+			}
+			return &UpstreamEvent{
+				Time:     entry.Time,
+				Kind:     p.kind,
+				Member:   upstreamMember(entry.Upstream),
+				Upstream: entry.Upstream,
+				Server:   entry.Server,
+				Request:  entry.Request,
+				Message:  entry.Message,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// upstreamMember returns the host of the upstream url like `http://10.0.0.2:8080/api`, or the upstream itself if it is
+// not an url, e.g. the address of a fastcgi server.
+func upstreamMember(upstream string) string {
+	if u, err := url.Parse(upstream); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return upstream
+}
@@ -9,15 +9,21 @@ import (
 	"time"
 )
 
+// Config is the config of the file watchers. The lines of a watched file are buffered in a ring buffer of BufferSize
+// lines shared by the outputs, and the outputs falling behind by the whole buffer are handled by SlowConsumerPolicy.
 type Config struct {
-	MaxConnections int
-	OutputTimeout  time.Duration
+	MaxConnections     int
+	OutputTimeout      time.Duration
+	BufferSize         int
+	SlowConsumerPolicy SlowConsumerPolicy
 }
 
 func NewConfig() *Config {
 	return &Config{
-		MaxConnections: 1000,
-		OutputTimeout:  time.Minute * 5,
+		MaxConnections:     1000,
+		OutputTimeout:      time.Minute * 5,
+		BufferSize:         4096,
+		SlowConsumerPolicy: DropOldest,
 	}
 }
 
@@ -26,9 +32,16 @@ func (c *Config) Complete(path string) (*CompletedConfig, error) {
 	if err != nil {
 		return &CompletedConfig{}, err
 	}
+	completed := *c
+	if completed.BufferSize < 1 {
+		completed.BufferSize = 1
+	}
+	if completed.SlowConsumerPolicy == "" {
+		completed.SlowConsumerPolicy = DropOldest
+	}
 	return &CompletedConfig{
 		filePath: abspath,
-		Config:   c,
+		Config:   &completed,
 	}, nil
 }
 
@@ -48,13 +61,23 @@ type CompletedConfig struct {
 	*Config
 }
 
-func (cc *CompletedConfig) NewFileWatcher(firstOutputCtx context.Context) (*FileWatcher, <-chan []byte, error) {
-	watcher, err := newFileWatcher(context.Background(), cc)
+func (cc *CompletedConfig) NewFileWatcher(firstOutputCtx context.Context) (*FileWatcher, *Subscription, error) {
+	return cc.newFileWatcher(firstOutputCtx, nil)
+}
+
+// NewResumedFileWatcher returns a file watcher of the lines after the cursor, which is not shared with the others.
+func (cc *CompletedConfig) NewResumedFileWatcher(firstOutputCtx context.Context, from Cursor) (*FileWatcher, *Subscription, error) {
+	return cc.newFileWatcher(firstOutputCtx, &from)
+}
+
+func (cc *CompletedConfig) newFileWatcher(firstOutputCtx context.Context, from *Cursor) (*FileWatcher, *Subscription, error) {
+	watcher, err := newFileWatcher(context.Background(), cc, from)
 	if err != nil {
 		return nil, nil, err
 	}
-	output, err := watcher.Output(firstOutputCtx)
+	output, err := watcher.Subscribe(firstOutputCtx)
 	if err != nil {
+		watcher.tailer.close()
 		return nil, nil, err
 	}
 	go func() {
@@ -0,0 +1,45 @@
+package file_watcher
+
+import (
+	"fmt"
+	"github.com/marmotedu/errors"
+	"strconv"
+	"strings"
+)
+
+// Cursor is the position after a line of a watched file, that is the inode of the file and the offset of the next line
+// in it. The inode follows the file after it is rotated by renaming, and it is always 0 on windows.
+type Cursor struct {
+	Inode  uint64
+	Offset int64
+}
+
+// String returns the cursor like `1234:5678`, which can be parsed by ParseCursor.
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d:%d", c.Inode, c.Offset)
+}
+
+// ParseCursor parses the cursor returned by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return Cursor{}, errors.Errorf("invalid cursor '%s'", s)
+	}
+	inode, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return Cursor{}, errors.Errorf("invalid inode of cursor '%s'", s)
+	}
+	offset, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || offset < 0 {
+		return Cursor{}, errors.Errorf("invalid offset of cursor '%s'", s)
+	}
+	return Cursor{Inode: inode, Offset: offset}, nil
+}
+
+// Line is a line of a watched file without the line break, with the cursor after it. The file is the path watched, even
+// if the line is replayed from a rotated sibling of it.
+type Line struct {
+	Text   []byte
+	Cursor Cursor
+	File   string
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package file_watcher
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileInode returns the inode of the file.
+func fileInode(info os.FileInfo) uint64 {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0
+	}
+	return uint64(stat.Ino)
+}
@@ -0,0 +1,8 @@
+package file_watcher
+
+import "os"
+
+// fileInode, windows 不提供inode，返回0，轮转后的文件无法通过游标定位
+func fileInode(info os.FileInfo) uint64 {
+	return 0
+}
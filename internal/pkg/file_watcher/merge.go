@@ -0,0 +1,168 @@
+package file_watcher
+
+import (
+	"context"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MergedSubscription merges the lines of the subscriptions of several files into one stream, each line is tagged by
+// the file in Line.File. The lines of a file are in order, but the ones of different files are interleaved as they are
+// read.
+type MergedSubscription struct {
+	wm    *WatcherManager
+	list  func() []string
+	lines chan *Line
+	ctx   context.Context
+
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	subscriptions map[string]*mergedFile
+	dropped       uint64 // the lines dropped for the closed subscriptions
+}
+
+type mergedFile struct {
+	subscription *Subscription
+	cancel       context.CancelFunc
+}
+
+// Lines returns the lines of all the files, the channel is closed after the context of the merged subscription is done
+// or the output timeout is reached.
+func (m *MergedSubscription) Lines() <-chan *Line {
+	return m.lines
+}
+
+// Dropped returns the number of the lines dropped for all the files.
+func (m *MergedSubscription) Dropped() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dropped := m.dropped
+	for _, f := range m.subscriptions {
+		dropped += f.subscription.Dropped()
+	}
+	return dropped
+}
+
+// Files returns the files subscribed to currently.
+func (m *MergedSubscription) Files() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	files := make([]string, 0, len(m.subscriptions))
+	for file := range m.subscriptions {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// refresh subscribes to the files listed but not subscribed to, and unsubscribes from the ones no longer listed. The
+// error of the first file failed to be subscribed to is returned.
+func (m *MergedSubscription) refresh() error {
+	var firstErr error
+	listed := make(map[string]bool)
+	for _, file := range m.list() {
+		listed[file] = true
+		m.mu.Lock()
+		_, has := m.subscriptions[file]
+		m.mu.Unlock()
+		if has {
+			continue
+		}
+		ctx, cancel := context.WithCancel(m.ctx)
+		s, err := m.wm.Subscribe(ctx, file)
+		if err != nil {
+			cancel()
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		f := &mergedFile{subscription: s, cancel: cancel}
+		m.mu.Lock()
+		m.subscriptions[file] = f
+		m.mu.Unlock()
+		m.wg.Add(1)
+		go m.forward(file, f)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for file, f := range m.subscriptions {
+		if !listed[file] {
+			f.cancel()
+		}
+	}
+	return firstErr
+}
+
+// forward sends the lines of the file to the merged lines, until the subscription of the file is closed. Then the file
+// is removed from the subscriptions, and subscribed to again by the next refreshing if it is still listed.
+func (m *MergedSubscription) forward(file string, f *mergedFile) {
+	defer m.wg.Done()
+	defer func() {
+		f.cancel()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.subscriptions[file] == f {
+			delete(m.subscriptions, file)
+			m.dropped += f.subscription.Dropped()
+		}
+	}()
+	for line := range f.subscription.Lines() {
+		select {
+		case m.lines <- line:
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// run refreshes the files at the interval, until the context is done.
+func (m *MergedSubscription) run(interval time.Duration) {
+	defer m.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.refresh(); err != nil {
+				log.Debugf("failed to subscribe to the merged files. %s", err.Error())
+			}
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// Merge subscribes to the files listed by list, and merges their lines into one stream. The files are listed again at
+// the interval, the files appearing later are subscribed to, the ones failed to be subscribed to, e.g. not existing yet,
+// are tried again, and the ones no longer listed are unsubscribed from. The error is returned if none of the files
+// listed at first is subscribed to.
+func (wm *WatcherManager) Merge(ctx context.Context, list func() []string, interval time.Duration) (*MergedSubscription, error) {
+	wm.mu.RLock()
+	timeout := wm.config.OutputTimeout
+	wm.mu.RUnlock()
+	mctx, cancel := context.WithTimeout(ctx, timeout)
+	m := &MergedSubscription{
+		wm:            wm,
+		list:          list,
+		lines:         make(chan *Line),
+		ctx:           mctx,
+		subscriptions: make(map[string]*mergedFile),
+	}
+	if err := m.refresh(); err != nil && len(m.Files()) == 0 {
+		cancel()
+		m.wg.Wait()
+		return nil, err
+	}
+	m.wg.Add(1)
+	go m.run(interval)
+	go func() {
+		<-mctx.Done()
+		m.wg.Wait()
+		cancel()
+		close(m.lines)
+	}()
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package file_watcher
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func nextMergedLine(t *testing.T, m *MergedSubscription) *Line {
+	t.Helper()
+	select {
+	case line, ok := <-m.Lines():
+		if !ok {
+			t.Fatalf("merged lines are closed")
+		}
+		return line
+	case <-time.After(time.Second * 5):
+		t.Fatalf("next merged line timeout")
+	}
+	return nil
+}
+
+func TestWatcherManager_Merge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-file-watcher-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a, b := filepath.Join(dir, "a.access.log"), filepath.Join(dir, "b.access.log")
+	appendFile(t, a, "")
+
+	var mu sync.Mutex
+	files := []string{a, b}
+	list := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return files
+	}
+
+	wm := NewWatcherManager(NewConfig())
+	defer wm.StopAll()
+	ctx, cancel := context.WithCancel(context.Background())
+	m, err := wm.Merge(ctx, list, time.Millisecond*100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Files(); len(got) != 1 || got[0] != a {
+		t.Errorf("Files() = %v, want only %s before %s is created", got, a, b)
+	}
+
+	appendFile(t, a, "a1\n")
+	if line := nextMergedLine(t, m); string(line.Text) != "a1" || line.File != a {
+		t.Errorf("merged line = %s of %s, want a1 of %s", line.Text, line.File, a)
+	}
+
+	// the file created later is picked up
+	appendFile(t, b, "")
+	deadline := time.Now().Add(5 * time.Second)
+	for len(m.Files()) != 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s is not subscribed to after created", b)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	appendFile(t, b, "b1\n")
+	if line := nextMergedLine(t, m); string(line.Text) != "b1" || line.File != b {
+		t.Errorf("merged line = %s of %s, want b1 of %s", line.Text, line.File, b)
+	}
+
+	// the file no longer listed is unsubscribed from
+	mu.Lock()
+	files = []string{b}
+	mu.Unlock()
+	deadline = time.Now().Add(5 * time.Second)
+	for len(m.Files()) != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s is still subscribed to after unlisted", a)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	appendFile(t, a, "a2\n")
+	appendFile(t, b, "b2\n")
+	if line := nextMergedLine(t, m); string(line.Text) != "b2" {
+		t.Errorf("merged line = %s of %s, want b2", line.Text, line.File)
+	}
+
+	cancel()
+	for range m.Lines() {
+	}
+
+	if _, err = wm.Merge(context.Background(), func() []string { return []string{filepath.Join(dir, "none.log")} }, time.Second); err == nil {
+		t.Errorf("Merge() without any existing file should fail")
+	}
+}
@@ -0,0 +1,256 @@
+package file_watcher
+
+import (
+	"context"
+	"github.com/marmotedu/errors"
+	"sync"
+	"time"
+)
+
+// SlowConsumerPolicy decides what happens when a subscriber falls behind the ring buffer by the whole buffer.
+type SlowConsumerPolicy string
+
+const (
+	// DropOldest overwrites the oldest lines not read by the slow subscriber, which are counted as dropped.
+	DropOldest SlowConsumerPolicy = "drop-oldest"
+	// Disconnect closes the output of the slow subscriber, the lines not read are counted as dropped.
+	Disconnect SlowConsumerPolicy = "disconnect"
+	// Block blocks the watching of the file until the slow subscriber reads the oldest line, or its output is closed.
+	Block SlowConsumerPolicy = "block"
+)
+
+// SlowConsumerPolicies are the valid slow consumer policies.
+var SlowConsumerPolicies = []SlowConsumerPolicy{DropOldest, Disconnect, Block}
+
+// maxReadBatch is the max number of the lines read by a subscriber from the ring buffer at a time.
+const maxReadBatch = 64
+
+// Subscription is an output of a file watcher. The lines are sent to the channel until the context of the subscription
+// is done, its timeout is reached, or the watcher is stopped, and the channel is closed then.
+type Subscription struct {
+	ring  *ringBuffer
+	lines chan *Line
+	start Cursor
+	gap   bool
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	cursor  uint64
+	dropped uint64
+	closed  bool
+}
+
+// Lines returns the channel of the lines of the file with their cursors.
+func (s *Subscription) Lines() <-chan *Line {
+	return s.lines
+}
+
+// Start returns the cursor before the first line of the subscription.
+func (s *Subscription) Start() Cursor {
+	return s.start
+}
+
+// Gap returns whether there is a gap before the lines of the subscription resumed from a cursor, that is the lines
+// after the cursor are not found, and the file is read from the beginning.
+func (s *Subscription) Gap() bool {
+	return s.gap
+}
+
+// Dropped returns the number of the lines dropped for the subscription, since it fell behind the ring buffer.
+func (s *Subscription) Dropped() uint64 {
+	s.ring.mu.Lock()
+	defer s.ring.mu.Unlock()
+	return s.dropped
+}
+
+// ringBuffer is a bounded buffer of the lines of a file shared by all the subscribers, each of which reads the lines
+// with its own cursor. The sequence of a line is the total number of the lines written before it.
+type ringBuffer struct {
+	mu            sync.Mutex
+	readable      *sync.Cond // signaled when a line is written or a subscription is closed
+	writable      *sync.Cond // signaled when the blocked writer may go on
+	writerBlocked bool
+	lines         []*Line
+	last          Cursor // the cursor of the last line written
+	next          uint64
+	closed        bool
+	done          chan struct{}
+
+	policy         SlowConsumerPolicy
+	maxSubscribers int
+	subscribers    map[*Subscription]struct{}
+}
+
+// write writes the line to the buffer, the slow subscribers are handled by the policy before the oldest line is
+// overwritten. It returns false if the buffer is closed.
+func (r *ringBuffer) write(line *Line) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	size := uint64(len(r.lines))
+	for !r.closed {
+		full := false
+		for s := range r.subscribers {
+			if s.closed || r.next-s.cursor < size {
+				continue
+			}
+			switch r.policy {
+			case Disconnect:
+				s.dropped += r.next - s.cursor
+				r.closeSubscription(s)
+			case Block:
+				full = true
+			}
+		}
+		if !full {
+			break
+		}
+		r.writerBlocked = true
+		r.writable.Wait()
+		r.writerBlocked = false
+	}
+	if r.closed {
+		return false
+	}
+	r.lines[r.next%size] = line
+	r.last = line.Cursor
+	r.next++
+	r.readable.Broadcast()
+	return true
+}
+
+// read reads the lines after the cursor of the subscriber into the batch, and waits for them if there are none. The
+// lines overwritten before being read are counted as dropped. It returns false if the subscription is closed.
+func (r *ringBuffer) read(s *Subscription, batch []*Line) ([]*Line, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for s.cursor == r.next && !s.closed && !r.closed {
+		r.readable.Wait()
+	}
+	if s.closed || r.closed {
+		return nil, false
+	}
+	size := uint64(len(r.lines))
+	if r.next-s.cursor > size {
+		s.dropped += r.next - s.cursor - size
+		s.cursor = r.next - size
+	}
+	batch = batch[:0]
+	for s.cursor < r.next && len(batch) < maxReadBatch {
+		batch = append(batch, r.lines[s.cursor%size])
+		s.cursor++
+	}
+	if r.writerBlocked {
+		r.writable.Signal()
+	}
+	return batch, true
+}
+
+// subscribe adds a subscriber reading the lines written afterwards, whose output is closed after the timeout.
+func (r *ringBuffer) subscribe(ctx context.Context, timeout time.Duration) (*Subscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, errors.New("ring buffer is closed")
+	}
+	if r.maxSubscribers <= len(r.subscribers) {
+		return nil, errors.Errorf("the number of connections has reached the maximum (%d/%d)", len(r.subscribers), r.maxSubscribers)
+	}
+	cctx, cancel := context.WithTimeout(ctx, timeout)
+	s := &Subscription{
+		ring:   r,
+		lines:  make(chan *Line),
+		ctx:    cctx,
+		cancel: cancel,
+		cursor: r.next,
+		start:  r.last,
+	}
+	r.subscribers[s] = struct{}{}
+
+	// wake up the subscriber waiting for the lines when its context is done
+	go func() {
+		select {
+		case <-cctx.Done():
+		case <-r.done:
+		}
+		r.mu.Lock()
+		r.closeSubscription(s)
+		r.mu.Unlock()
+	}()
+	go r.output(s)
+	return s, nil
+}
+
+func (r *ringBuffer) output(s *Subscription) {
+	defer r.unsubscribe(s)
+	defer close(s.lines)
+	batch := make([]*Line, 0, maxReadBatch)
+	for {
+		var ok bool
+		batch, ok = r.read(s, batch)
+		if !ok {
+			return
+		}
+		for _, line := range batch {
+			select {
+			case s.lines <- line:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// closeSubscription closes the subscription, with the lock of the buffer held.
+func (r *ringBuffer) closeSubscription(s *Subscription) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.cancel()
+	r.readable.Broadcast()
+	r.writable.Signal()
+}
+
+// unsubscribe removes the subscriber, and closes the buffer if it is the last one.
+func (r *ringBuffer) unsubscribe(s *Subscription) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closeSubscription(s)
+	delete(r.subscribers, s)
+	if len(r.subscribers) == 0 {
+		r.close()
+	}
+}
+
+// close closes the buffer and all the subscriptions, with the lock of the buffer held.
+func (r *ringBuffer) close() {
+	if r.closed {
+		return
+	}
+	r.closed = true
+	close(r.done)
+	for s := range r.subscribers {
+		r.closeSubscription(s)
+	}
+	r.readable.Broadcast()
+	r.writable.Signal()
+}
+
+func (r *ringBuffer) isClosed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closed
+}
+
+func newRingBuffer(size, maxSubscribers int, policy SlowConsumerPolicy) *ringBuffer {
+	r := &ringBuffer{
+		lines:          make([]*Line, size),
+		done:           make(chan struct{}),
+		policy:         policy,
+		maxSubscribers: maxSubscribers,
+		subscribers:    make(map[*Subscription]struct{}),
+	}
+	r.readable = sync.NewCond(&r.mu)
+	r.writable = sync.NewCond(&r.mu)
+	return r
+}
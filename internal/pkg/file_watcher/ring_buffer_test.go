@@ -0,0 +1,183 @@
+package file_watcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func readLines(t *testing.T, s *Subscription, n int) []string {
+	lines := make([]string, 0, n)
+	timeout := time.After(time.Second * 5)
+	for len(lines) < n {
+		select {
+		case line, ok := <-s.Lines():
+			if !ok {
+				return lines
+			}
+			lines = append(lines, string(line.Text))
+		case <-timeout:
+			t.Fatalf("read %d lines timeout, got %v", n, lines)
+		}
+	}
+	return lines
+}
+
+func TestRingBuffer_FanOut(t *testing.T) {
+	r := newRingBuffer(8, 2, DropOldest)
+	s1, err := r.subscribe(context.Background(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := r.subscribe(context.Background(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.subscribe(context.Background(), time.Minute); err == nil {
+		t.Errorf("subscribe() beyond the max subscribers should fail")
+	}
+	for i := 0; i < 5; i++ {
+		r.write(&Line{Text: []byte(fmt.Sprint(i))})
+	}
+	for _, s := range []*Subscription{s1, s2} {
+		if got := fmt.Sprint(readLines(t, s, 5)); got != "[0 1 2 3 4]" {
+			t.Errorf("lines = %s, want [0 1 2 3 4]", got)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s3, err := r.subscribe(ctx, time.Minute)
+	if err == nil {
+		t.Errorf("subscribe() beyond the max subscribers should fail, got %v", s3)
+	}
+	cancel()
+	s1.cancel()
+	if _, ok := <-s1.Lines(); ok {
+		t.Errorf("lines of the canceled subscription should be closed")
+	}
+	s2.cancel()
+	<-s2.Lines()
+	select {
+	case <-r.done:
+	case <-time.After(time.Second):
+		t.Errorf("ring buffer should be closed after all the subscribers are gone")
+	}
+	if r.write(&Line{Text: []byte("closed")}) {
+		t.Errorf("write() to the closed ring buffer should fail")
+	}
+}
+
+func TestRingBuffer_SlowConsumerPolicies(t *testing.T) {
+	// the subscriber holds one line in its output goroutine, and the others are held in the buffer
+	waitBlocked := func(r *ringBuffer, s *Subscription) {
+		for i := 0; i < 100; i++ {
+			r.mu.Lock()
+			cursor := s.cursor
+			r.mu.Unlock()
+			if cursor > 0 {
+				return
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+	}
+
+	t.Run("drop oldest", func(t *testing.T) {
+		r := newRingBuffer(4, 1, DropOldest)
+		s, _ := r.subscribe(context.Background(), time.Minute)
+		r.write(&Line{Text: []byte("0")})
+		waitBlocked(r, s)
+		for i := 1; i < 10; i++ {
+			r.write(&Line{Text: []byte(fmt.Sprint(i))})
+		}
+		if got := fmt.Sprint(readLines(t, s, 5)); got != "[0 6 7 8 9]" {
+			t.Errorf("lines = %s, want [0 6 7 8 9]", got)
+		}
+		if s.Dropped() != 5 {
+			t.Errorf("Dropped() = %d, want 5", s.Dropped())
+		}
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		r := newRingBuffer(4, 1, Disconnect)
+		s, _ := r.subscribe(context.Background(), time.Minute)
+		r.write(&Line{Text: []byte("0")})
+		waitBlocked(r, s)
+		// the writes are not blocked, and fail after the buffer is closed without any subscriber
+		for i := 1; i < 10; i++ {
+			r.write(&Line{Text: []byte(fmt.Sprint(i))})
+		}
+		// the line held by the output goroutine may be sent before the output is closed
+		if got := readLines(t, s, 10); len(got) > 1 {
+			t.Errorf("lines of the disconnected subscriber = %v, want the first one at most", got)
+		}
+		if s.Dropped() != 4 {
+			t.Errorf("Dropped() = %d, want 4", s.Dropped())
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		r := newRingBuffer(4, 1, Block)
+		s, _ := r.subscribe(context.Background(), time.Minute)
+		written := make(chan struct{})
+		go func() {
+			defer close(written)
+			for i := 0; i < 10; i++ {
+				r.write(&Line{Text: []byte(fmt.Sprint(i))})
+			}
+		}()
+		select {
+		case <-written:
+			t.Fatalf("write() should be blocked by the slow subscriber")
+		case <-time.After(time.Millisecond * 100):
+		}
+		if got := fmt.Sprint(readLines(t, s, 10)); got != "[0 1 2 3 4 5 6 7 8 9]" {
+			t.Errorf("lines = %s, want all of them", got)
+		}
+		<-written
+		if s.Dropped() != 0 {
+			t.Errorf("Dropped() = %d, want 0", s.Dropped())
+		}
+	})
+}
+
+func benchmarkRingBuffer(b *testing.B, subscribers int, policy SlowConsumerPolicy) {
+	r := newRingBuffer(4096, subscribers, policy)
+	wg := new(sync.WaitGroup)
+	for i := 0; i < subscribers; i++ {
+		s, err := r.subscribe(context.Background(), time.Hour)
+		if err != nil {
+			b.Fatal(err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range s.Lines() {
+			}
+		}()
+	}
+	line := &Line{Text: []byte(`127.0.0.1 - - [10/Oct/2020:13:55:36 +0800] "GET /index.html HTTP/1.1" 200 612 "-" "curl/7.68.0"`)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.write(line)
+	}
+	b.StopTimer()
+	r.mu.Lock()
+	r.close()
+	r.mu.Unlock()
+	wg.Wait()
+}
+
+func BenchmarkRingBuffer_1Subscriber(b *testing.B) {
+	benchmarkRingBuffer(b, 1, Block)
+}
+
+func BenchmarkRingBuffer_16Subscribers(b *testing.B) {
+	benchmarkRingBuffer(b, 16, Block)
+}
+
+func BenchmarkRingBuffer_16SubscribersDropOldest(b *testing.B) {
+	benchmarkRingBuffer(b, 16, DropOldest)
+}
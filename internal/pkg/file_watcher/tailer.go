@@ -0,0 +1,161 @@
+package file_watcher
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/ClessLi/bifrost/internal/pkg/log_query"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// tailPollInterval is the interval of polling the file for the new lines, the rotation and the truncation.
+const tailPollInterval = time.Millisecond * 250
+
+// tailer reads the lines of a file from a position and follows the file by polling, like `tail -F`. The rotated files
+// in the queue are read before the file, when the lines are replayed from a cursor in a rotated file.
+type tailer struct {
+	path    string
+	file    *os.File
+	reader  *bufio.Reader
+	inode   uint64
+	offset  int64
+	partial []byte
+	queue   []string
+}
+
+// newTailer opens the file to read the lines after the cursor, which may be in a rotated sibling of the file, such as
+// `access.log.1`, or the lines written afterwards if the cursor is nil. If the cursor is not found, e.g. the rotated
+// file is removed or compressed, the file is read from the beginning, and the gap is reported by true.
+func newTailer(path string, from *Cursor) (*tailer, bool, error) {
+	t := &tailer{path: path}
+	if from == nil {
+		return t, false, t.open(path, -1)
+	}
+	files, err := log_query.Files(path)
+	if err != nil {
+		return nil, false, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		if strings.HasSuffix(files[i], ".gz") {
+			continue
+		}
+		info, err := os.Stat(files[i])
+		if err != nil || fileInode(info) != from.Inode {
+			continue
+		}
+		if info.Size() < from.Offset { // truncated
+			break
+		}
+		// the newer rotated files and the file itself are read after the file of the cursor
+		for _, file := range files[i+1:] {
+			if !strings.HasSuffix(file, ".gz") {
+				t.queue = append(t.queue, file)
+			}
+		}
+		return t, false, t.open(files[i], from.Offset)
+	}
+	return t, true, t.open(path, 0)
+}
+
+// open opens the file at the offset, or at the end if the offset is negative or beyond the end.
+func (t *tailer) open(path string, offset int64) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	if offset < 0 || offset > info.Size() {
+		offset = info.Size()
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return err
+	}
+	t.file, t.reader, t.inode, t.offset = file, bufio.NewReader(file), fileInode(info), offset
+	t.partial = t.partial[:0]
+	return nil
+}
+
+// next returns the next line, and waits for it until the stop channel is closed, then nil is returned.
+func (t *tailer) next(stop <-chan struct{}) (*Line, error) {
+	for {
+		data, err := t.reader.ReadBytes('\n')
+		t.partial = append(t.partial, data...)
+		if err == nil {
+			return t.line(), nil
+		}
+		if err != io.EOF {
+			return nil, err
+		}
+		line, switched, err := t.follow()
+		if err != nil {
+			return nil, err
+		}
+		if line != nil {
+			return line, nil
+		}
+		if switched {
+			continue
+		}
+		select {
+		case <-stop:
+			return nil, nil
+		case <-time.After(tailPollInterval):
+		}
+	}
+}
+
+// line returns the partial line read, and moves the offset after it.
+func (t *tailer) line() *Line {
+	t.offset += int64(len(t.partial))
+	line := &Line{
+		Text:   append([]byte(nil), bytes.TrimSuffix(t.partial, []byte{'\n'})...),
+		Cursor: Cursor{Inode: t.inode, Offset: t.offset},
+		File:   t.path,
+	}
+	t.partial = t.partial[:0]
+	return line
+}
+
+// follow switches to the next file at the end of the current one, which is the next rotated file in the queue, or the
+// file at the path if the current one is rotated. The file truncated is read from the beginning. The partial line at
+// the end of the rotated file is returned as a line before switching.
+func (t *tailer) follow() (*Line, bool, error) {
+
+	next := t.path
+	if len(t.queue) > 0 {
+		next = t.queue[0]
+	} else {
+		info, err := os.Stat(t.path)
+		if os.IsNotExist(err) { // wait for the file to be recreated
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if fileInode(info) == t.inode && info.Size() >= t.offset+int64(len(t.partial)) {
+			return nil, false, nil
+		}
+		if fileInode(info) == t.inode { // truncated
+			t.partial = t.partial[:0]
+		}
+	}
+	if len(t.partial) > 0 {
+		return t.line(), false, nil
+	}
+	if len(t.queue) > 0 {
+		t.queue = t.queue[1:]
+	}
+	t.file.Close()
+	return nil, true, t.open(next, 0)
+}
+
+func (t *tailer) close() error {
+	return t.file.Close()
+}
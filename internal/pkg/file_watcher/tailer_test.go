@@ -0,0 +1,122 @@
+package file_watcher
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func appendFile(t *testing.T, path, content string) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err = f.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func nextLine(t *testing.T, s *Subscription) *Line {
+	t.Helper()
+	select {
+	case line, ok := <-s.Lines():
+		if !ok {
+			t.Fatalf("lines are closed")
+		}
+		return line
+	case <-time.After(time.Second * 5):
+		t.Fatalf("next line timeout")
+	}
+	return nil
+}
+
+func TestWatcherManager_Resume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-file-watcher-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "access.log")
+	appendFile(t, path, "old\n")
+
+	wm := NewWatcherManager(NewConfig())
+	defer wm.StopAll()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := wm.Subscribe(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendFile(t, path, "a\nb\n")
+	a, b := nextLine(t, s), nextLine(t, s)
+	if string(a.Text) != "a" || string(b.Text) != "b" || a.Cursor.Offset != 6 || b.Cursor.Offset != 8 {
+		t.Fatalf("lines = %s at %s and %s at %s, want a at offset 6 and b at offset 8", a.Text, a.Cursor, b.Text, b.Cursor)
+	}
+	if s.Gap() {
+		t.Errorf("Gap() of the subscription not resumed should be false")
+	}
+
+	// rotate by renaming, and the lines of the new file are followed
+	appendFile(t, path, "c\n")
+	if string(nextLine(t, s).Text) != "c" {
+		t.Fatalf("line before rotation should be c")
+	}
+	if err = os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendFile(t, path, "d\n")
+	d := nextLine(t, s)
+	if string(d.Text) != "d" || d.Cursor.Inode == a.Cursor.Inode && a.Cursor.Inode != 0 || d.Cursor.Offset != 2 {
+		t.Fatalf("line after rotation = %s at %s, want d at offset 2 of the new file", d.Text, d.Cursor)
+	}
+
+	// resume from the cursor in the rotated file
+	appendFile(t, path, "e\n")
+	if string(nextLine(t, s).Text) != "e" {
+		t.Fatalf("line after rotation should be e")
+	}
+	cursor, err := ParseCursor(a.Cursor.String())
+	if err != nil || cursor != a.Cursor {
+		t.Fatalf("ParseCursor(%s) = %s, %v", a.Cursor, cursor, err)
+	}
+	resumed, err := wm.Resume(ctx, path, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Gap() {
+		t.Errorf("Gap() of the subscription resumed from the rotated file should be false")
+	}
+	for _, want := range []string{"b", "c", "d", "e"} {
+		if line := nextLine(t, resumed); string(line.Text) != want {
+			t.Errorf("resumed line = %s at %s, want %s", line.Text, line.Cursor, want)
+		}
+	}
+
+	// the rotated file of the cursor is removed, the file is read from the beginning
+	if err = os.Remove(path + ".1"); err != nil {
+		t.Fatal(err)
+	}
+	resumed, err = wm.Resume(ctx, path, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resumed.Gap() {
+		t.Errorf("Gap() of the subscription resumed from the removed file should be true")
+	}
+	if line := nextLine(t, resumed); string(line.Text) != "d" {
+		t.Errorf("line resumed after the gap = %s, want d", line.Text)
+	}
+
+	// truncate, and the file is read from the beginning
+	if err = ioutil.WriteFile(path, []byte("f\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if line := nextLine(t, s); string(line.Text) != "f" || line.Cursor.Offset != 2 {
+		t.Errorf("line after truncation = %s at %s, want f at offset 2", line.Text, line.Cursor)
+	}
+}
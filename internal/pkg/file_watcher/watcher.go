@@ -3,141 +3,136 @@ package file_watcher
 import (
 	"context"
 	log "github.com/ClessLi/bifrost/pkg/log/v1"
-	"github.com/hpcloud/tail"
 	"github.com/marmotedu/errors"
-	"os"
 	"sync"
 	"time"
 )
 
 type FileWatcher struct {
-	filePath    string
-	ctx         context.Context
-	cancel      context.CancelFunc
-	startLocker sync.Locker
+	filePath      string
+	ctx           context.Context
+	cancel        context.CancelFunc
+	startLocker   sync.Locker
+	outputTimeout time.Duration
 
-	shuntPipe ShuntPipe
+	tailer *tailer
+	gap    bool
+	ring   *ringBuffer
 }
 
 func (f *FileWatcher) Output(ctx context.Context) (<-chan []byte, error) {
-	return f.shuntPipe.Output(ctx)
+	s, err := f.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return textsOf(s), nil
+}
+
+// Subscribe subscribes the lines of the file, the lines are sent until the context is done or the output timeout of
+// the watcher is reached.
+func (f *FileWatcher) Subscribe(ctx context.Context) (*Subscription, error) {
+	s, err := f.ring.subscribe(ctx, f.outputTimeout)
+	if err != nil {
+		return nil, errors.Errorf("failed to subscribe '%s' file watcher. %s", f.filePath, err.Error())
+	}
+	s.gap = f.gap
+	return s, nil
 }
 
 func (f *FileWatcher) Start() error {
-	if f.shuntPipe.IsClosed() {
+	if f.ring.isClosed() {
 		f.cancel()
-		return errors.Errorf("failed to start '%s' file watcher, shunt pipe is already closed", f.filePath)
+		return errors.Errorf("failed to start '%s' file watcher, ring buffer is already closed", f.filePath)
 	}
 
 	f.startLocker.Lock()
 	defer f.startLocker.Unlock()
 	defer f.cancel()
-	go func() {
-		err := f.shuntPipe.Start()
-		if err != nil {
-			log.Warnf("file '%s' watching error. %s", f.filePath, err.Error())
-		}
-	}()
 
 	log.Debugf("tail '%s' starting...", f.filePath)
-	t, err := tail.TailFile(f.filePath, tail.Config{
-		Logger: log.StdInfoLogger(),
-		Location: &tail.SeekInfo{
-			Offset: 0,
-			Whence: os.SEEK_END,
-		},
-		ReOpen:    true,
-		MustExist: true,
-		Poll:      true,
-		Follow:    true,
-	})
-	if err != nil {
-		return err
-	}
-
-	// defer to stop tail
-	defer func(t *tail.Tail) {
+	defer func() {
 		log.Debugf("tail '%s' stopping...", f.filePath)
-		err := t.Stop()
-		if err != nil {
+		if err := f.tailer.close(); err != nil {
 			log.Warnf("tail stop error. %s", err.Error())
 		}
-	}(t)
+	}()
 
-	// recover panic for watching loop
-	defer func() {
-		pInfo := recover()
-		if pInfo == nil {
-			return
-		}
-		if !f.shuntPipe.IsClosed() {
-			log.Warnf("panic transferring data to shunt pipe. %s", pInfo)
-			err := f.shuntPipe.Close()
-			if err != nil {
-				log.Warnf("failed to stop shunt pipe. %s", err.Error())
-			}
+	// stop waiting for the lines, when the watcher is stopped or all the subscribers are gone
+	stop := make(chan struct{})
+	go func() {
+		defer close(stop)
+		select {
+		case <-f.ring.done:
+		case <-f.ctx.Done():
 		}
 	}()
+
 	// FileWatcher watching
-	for !f.shuntPipe.IsClosed() {
-		select {
-		case line := <-t.Lines: // receive tail line
-			select {
-			case f.shuntPipe.Input() <- []byte(line.Text): // send to shut pipe
-			case <-time.After(time.Second * 30): // send to shut pipe timeout(30s)
-				err = errors.Errorf("send data to shut pipe timeout(30s), file: %s", f.filePath)
-				return err
-			}
-		case <-f.ctx.Done(): // FileWatcher Close method has been called
-			log.Debugf("watching file '%s' completed")
+	for {
+		line, err := f.tailer.next(stop)
+		if err != nil {
+			f.stopRing()
+			return errors.Errorf("failed to tail file '%s'. %s", f.filePath, err.Error())
+		}
+		if line == nil || !f.ring.write(line) {
+			log.Debugf("watching file '%s' completed", f.filePath)
 			return nil
-		default: // sleep 1ms and return to loop with shut pipe closed check
-			time.Sleep(time.Millisecond)
 		}
 	}
-	return nil
 }
 
 func (f *FileWatcher) Stop() error {
 	defer f.cancel()
-	if f.shuntPipe.IsClosed() {
-		return errors.Errorf("failed to stop '%s' file watcher, shunt pipe is already closed", f.filePath)
-	}
-
-	err := f.closePipe()
-	if err != nil {
-		return errors.Errorf("failed to stop '%s' file watcher. %s", f.filePath, err.Error())
+	if f.ring.isClosed() {
+		return errors.Errorf("failed to stop '%s' file watcher, ring buffer is already closed", f.filePath)
 	}
+	f.stopRing()
 	return nil
 }
 
 func (f *FileWatcher) IsClosed() bool {
-	return f.shuntPipe.IsClosed()
+	return f.ring.isClosed()
 }
 
-func (f *FileWatcher) closePipe() error {
-	errC := make(chan error, 1)
-	select {
-	case errC <- f.shuntPipe.Close():
-		err := <-errC
-		return err
-	case <-time.After(time.Second * 30):
-		return errors.New("close pipe timeout(30s)")
-	}
+func (f *FileWatcher) stopRing() {
+	f.ring.mu.Lock()
+	defer f.ring.mu.Unlock()
+	f.ring.close()
 }
 
-func newFileWatcher(ctx context.Context, config *CompletedConfig) (*FileWatcher, error) {
-	cctx, cancel := context.WithCancel(ctx)
-	sp, err := NewShuntPipe(config.MaxConnections, config.OutputTimeout)
+// textsOf returns the channel of the texts of the lines of the subscription.
+func textsOf(s *Subscription) <-chan []byte {
+	texts := make(chan []byte)
+	go func() {
+		defer close(texts)
+		for line := range s.Lines() {
+			select {
+			case texts <- line.Text:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+	return texts
+}
+
+// newFileWatcher opens the file to watch the lines after the cursor, or the lines written afterwards if it is nil.
+func newFileWatcher(ctx context.Context, config *CompletedConfig, from *Cursor) (*FileWatcher, error) {
+	t, gap, err := newTailer(config.filePath, from)
 	if err != nil {
-		cancel()
 		return nil, err
 	}
+	cctx, cancel := context.WithCancel(ctx)
+	ring := newRingBuffer(config.BufferSize, config.MaxConnections, config.SlowConsumerPolicy)
+	ring.last = Cursor{Inode: t.inode, Offset: t.offset}
 	return &FileWatcher{
-		filePath:    config.filePath,
-		ctx:         cctx,
-		cancel:      cancel,
-		startLocker: new(sync.Mutex),
-		shuntPipe:   sp,
+		filePath:      config.filePath,
+		ctx:           cctx,
+		cancel:        cancel,
+		startLocker:   new(sync.Mutex),
+		outputTimeout: config.OutputTimeout,
+		tailer:        t,
+		gap:           gap,
+		ring:          ring,
 	}, nil
 }
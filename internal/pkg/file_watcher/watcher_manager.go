@@ -11,17 +11,28 @@ type WatcherManager struct {
 	mu     sync.RWMutex
 
 	watchers map[string]*FileWatcher
+	resumed  map[*FileWatcher]struct{}
 }
 
 func (wm *WatcherManager) Watch(ctx context.Context, file string) (<-chan []byte, error) {
-	wm.mu.RLock()
-	defer wm.mu.RUnlock()
+	s, err := wm.Subscribe(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	return textsOf(s), nil
+}
+
+// Subscribe subscribes the lines written to the file afterwards with their cursors, and the number of the lines
+// dropped for the subscription. The watcher of the file is shared by all the subscriptions.
+func (wm *WatcherManager) Subscribe(ctx context.Context, file string) (*Subscription, error) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
 	cconf, err := wm.config.Complete(file)
 	if err != nil {
 		return nil, err
 	}
 	if watcher, has := wm.watchers[cconf.filePath]; has && !watcher.IsClosed() {
-		return watcher.Output(ctx)
+		return watcher.Subscribe(ctx)
 	}
 	watcher, output, err := cconf.NewFileWatcher(ctx)
 	if err != nil {
@@ -31,6 +42,36 @@ func (wm *WatcherManager) Watch(ctx context.Context, file string) (<-chan []byte
 	return output, nil
 }
 
+// Resume subscribes the lines of the file after the cursor, which are replayed from the file or its rotated siblings if
+// they still exist, or else the file is read from the beginning and the gap is reported by the subscription. The
+// watcher of the resumed subscription is not shared.
+func (wm *WatcherManager) Resume(ctx context.Context, file string, from Cursor) (*Subscription, error) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	cconf, err := wm.config.Complete(file)
+	if err != nil {
+		return nil, err
+	}
+	for watcher := range wm.resumed {
+		if watcher.IsClosed() {
+			delete(wm.resumed, watcher)
+		}
+	}
+	watcher, output, err := cconf.NewResumedFileWatcher(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	wm.resumed[watcher] = struct{}{}
+	return output, nil
+}
+
+// SetConfig replaces the config of the watchers created afterwards, the running watchers and their outputs are kept.
+func (wm *WatcherManager) SetConfig(config *Config) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	wm.config = config
+}
+
 func (wm *WatcherManager) StopAll() error {
 	var errs []error
 	wm.mu.Lock()
@@ -43,6 +84,12 @@ func (wm *WatcherManager) StopAll() error {
 			delete(wm.watchers, filePath)
 		}
 	}
+	for watcher := range wm.resumed {
+		if !watcher.IsClosed() {
+			_ = watcher.Stop()
+		}
+		delete(wm.resumed, watcher)
+	}
 	return errors.NewAggregate(errs)
 }
 
@@ -51,5 +98,6 @@ func NewWatcherManager(config *Config) *WatcherManager {
 		config:   config,
 		mu:       sync.RWMutex{},
 		watchers: make(map[string]*FileWatcher),
+		resumed:  make(map[*FileWatcher]struct{}),
 	}
 }
@@ -0,0 +1,131 @@
+package log_alert
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"sync"
+)
+
+// the number of the alerts queued for a sink or a subscriber, the alerts beyond it are dropped while the sink or the
+// subscriber is slow, so that the evaluation of the rules is never blocked.
+const (
+	sinkQueueSize       = 64
+	subscriberQueueSize = 64
+)
+
+type sinkWorker struct {
+	sink  Sink
+	queue chan *v1.LogAlert
+}
+
+func (s *sinkWorker) run() {
+	for alert := range s.queue {
+		if err := s.sink.Send(alert); err != nil {
+			log.Warnf("failed to send the alert of rule '%s' on web server '%s' to sink '%s'. %s", alert.Rule, alert.ServerName, s.sink.Name(), err.Error())
+		}
+	}
+}
+
+type subscriber struct {
+	serverName string
+	rule       string
+	alerts     chan *v1.LogAlert
+}
+
+// Dispatcher sends the alerts fired to the sinks, and to the subscribers.
+type Dispatcher struct {
+	sinks map[string]*sinkWorker
+
+	mu          sync.Mutex
+	closed      bool
+	subscribers map[*subscriber]struct{}
+}
+
+// Fire sends the alert to the sinks, or all the sinks if the sinks are empty, and to the subscribers of the alert.
+func (d *Dispatcher) Fire(alert *v1.LogAlert, sinks []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	send := func(worker *sinkWorker) {
+		select {
+		case worker.queue <- alert:
+		default:
+			log.Warnf("the alert of rule '%s' on web server '%s' is dropped, since sink '%s' is busy", alert.Rule, alert.ServerName, worker.sink.Name())
+		}
+	}
+	if len(sinks) == 0 {
+		for _, worker := range d.sinks {
+			send(worker)
+		}
+	}
+	for _, name := range sinks {
+		if worker, has := d.sinks[name]; has {
+			send(worker)
+		}
+	}
+	for s := range d.subscribers {
+		if s.serverName != "" && s.serverName != alert.ServerName || s.rule != "" && s.rule != alert.Rule {
+			continue
+		}
+		select {
+		case s.alerts <- alert:
+		default:
+		}
+	}
+}
+
+// Subscribe returns the alerts of the web server and the rule, or all the web servers or all the rules if the server
+// name or the rule is empty. The alerts are closed when the context is done, or the dispatcher is closed.
+func (d *Dispatcher) Subscribe(ctx context.Context, serverName, rule string) <-chan *v1.LogAlert {
+	s := &subscriber{serverName: serverName, rule: rule, alerts: make(chan *v1.LogAlert, subscriberQueueSize)}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		close(s.alerts)
+		return s.alerts
+	}
+	d.subscribers[s] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if _, has := d.subscribers[s]; has {
+			delete(d.subscribers, s)
+			close(s.alerts)
+		}
+	}()
+	return s.alerts
+}
+
+// Close stops sending the alerts, the alerts queued are still sent to the sinks.
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	d.closed = true
+	for _, worker := range d.sinks {
+		close(worker.queue)
+	}
+	for s := range d.subscribers {
+		delete(d.subscribers, s)
+		close(s.alerts)
+	}
+}
+
+func NewDispatcher(sinks []Sink) *Dispatcher {
+	d := &Dispatcher{
+		sinks:       make(map[string]*sinkWorker),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+	for _, sink := range sinks {
+		worker := &sinkWorker{sink: sink, queue: make(chan *v1.LogAlert, sinkQueueSize)}
+		d.sinks[sink.Name()] = worker
+		go worker.run()
+	}
+	return d
+}
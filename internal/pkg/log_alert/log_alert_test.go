@@ -0,0 +1,152 @@
+package log_alert
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	rule, err := NewRule("bad-gateway", nil, "access.log", "", "status=502", 10*time.Second, 3, time.Minute, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(line []byte) (access_log.Record, bool) {
+		fields := strings.Fields(string(line))
+		if len(fields) != 2 {
+			return nil, false
+		}
+		return access_log.Record{"request": fields[0], "status": fields[1]}, true
+	}
+	e := NewEvaluator(rule, "web", parse)
+	now := time.Unix(1600000000, 0)
+
+	steps := []struct {
+		line  string
+		after time.Duration
+		fire  bool
+	}{
+		{line: "/a 502"},
+		{line: "/b 200", after: time.Second},
+		{line: "invalid", after: time.Second},
+		{line: "/c 502", after: 2 * time.Second},
+		// the first one is out of the window
+		{line: "/d 502", after: 12 * time.Second},
+		{line: "/e 502", after: 13 * time.Second},
+		{line: "/f 502", after: 14 * time.Second, fire: true},
+		// the counts are reset, and the rule does not fire within the cooldown
+		{line: "/g 502", after: 15 * time.Second},
+		{line: "/h 502", after: 16 * time.Second},
+		{line: "/i 502", after: 17 * time.Second},
+		{line: "/j 502", after: 75 * time.Second},
+		{line: "/k 502", after: 76 * time.Second},
+		{line: "/l 502", after: 77 * time.Second, fire: true},
+	}
+	for _, step := range steps {
+		alert := e.Evaluate([]byte(step.line), now.Add(step.after))
+		if (alert != nil) != step.fire {
+			t.Fatalf("Evaluate(%s) = %+v, want fired %v", step.line, alert, step.fire)
+		}
+		if alert == nil {
+			continue
+		}
+		if alert.Rule != "bad-gateway" || alert.ServerName != "web" || alert.LogName != "access.log" || alert.WindowSeconds != 10 || alert.Count < 3 {
+			t.Errorf("Evaluate(%s) = %+v", step.line, alert)
+		}
+		if last := alert.Samples[len(alert.Samples)-1]; last != step.line {
+			t.Errorf("the last sample of the alert = %s, want %s", last, step.line)
+		}
+	}
+
+	if _, err = NewRule("empty", nil, "access.log", "", "", time.Minute, 1, 0, nil); err == nil {
+		t.Errorf("NewRule() without the regexp and the filter should fail")
+	}
+	if _, err = NewRule("invalid", nil, "access.log", "(", "", time.Minute, 1, 0, nil); err == nil {
+		t.Errorf("NewRule() with the invalid regexp should fail")
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	received := make(chan *v1.LogAlert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		alert := new(v1.LogAlert)
+		if err := json.NewDecoder(r.Body).Decode(alert); err != nil || r.Header.Get("X-Token") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- alert
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "bifrost-log-alert-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	alertsFile := filepath.Join(dir, "alerts.log")
+	sinks := []Sink{
+		NewWebhookSink("webhook", server.URL, map[string]string{"X-Token": "secret"}, time.Second),
+		NewFileSink("file", alertsFile),
+	}
+	execFile := filepath.Join(dir, "exec.log")
+	if runtime.GOOS != "windows" {
+		sinks = append(sinks, NewExecSink("exec", []string{"sh", "-c", `cat > "$0"; echo " $BIFROST_ALERT_RULE" >> "$0"`, execFile}, time.Second))
+	}
+	d := NewDispatcher(sinks)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	all := d.Subscribe(ctx, "", "")
+	other := d.Subscribe(ctx, "other", "")
+	alert := &v1.LogAlert{Rule: "bad-gateway", ServerName: "web", Count: 3}
+	d.Fire(alert, nil)
+
+	select {
+	case got := <-received:
+		if got.Rule != alert.Rule || got.Count != alert.Count {
+			t.Errorf("webhook received %+v, want %+v", got, alert)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("webhook received nothing")
+	}
+	if got := <-all; got != alert {
+		t.Errorf("subscriber received %+v, want %+v", got, alert)
+	}
+	select {
+	case got := <-other:
+		t.Errorf("subscriber of the other server received %+v", got)
+	default:
+	}
+	cancel()
+	if _, ok := <-all; ok {
+		t.Errorf("alerts should be closed after the context is done")
+	}
+
+	// the queued alerts are sent after the dispatcher is closed, wait for the file and the command
+	d.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		data, _ := ioutil.ReadFile(alertsFile)
+		done := strings.Contains(string(data), `"rule":"bad-gateway"`)
+		if runtime.GOOS != "windows" {
+			data, _ = ioutil.ReadFile(execFile)
+			done = done && strings.HasSuffix(string(data), "} bad-gateway\n")
+		}
+		if done {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the alert is not sent to the file or the command")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
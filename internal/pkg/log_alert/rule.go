@@ -0,0 +1,161 @@
+package log_alert
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"regexp"
+	"time"
+)
+
+// maxSamples is the number of the last matched lines sent with the alert.
+const maxSamples = 5
+
+// Rule fires the alert when the lines of the log matched by the regexp or the field filter, or both of them, reach the
+// threshold in the window. The rule is evaluated on the log of the web servers, or all the web servers having the log
+// if the server names are empty, and does not fire again within the cooldown after it fired. The alerts are sent to
+// the sinks, or all the sinks if the sinks are empty.
+type Rule struct {
+	Name        string
+	ServerNames []string
+	LogName     string
+	Pattern     *regexp.Regexp
+	Filter      access_log.Filter
+	Window      time.Duration
+	Threshold   int
+	Cooldown    time.Duration
+	Sinks       []string
+}
+
+// AppliesTo returns whether the rule is evaluated on the web server.
+func (r *Rule) AppliesTo(serverName string) bool {
+	if len(r.ServerNames) == 0 {
+		return true
+	}
+	for _, name := range r.ServerNames {
+		if name == serverName {
+			return true
+		}
+	}
+	return false
+}
+
+func NewRule(name string, serverNames []string, logName, regexpRule, fieldRule string, window time.Duration, threshold int, cooldown time.Duration, sinks []string) (*Rule, error) {
+	if regexpRule == "" && fieldRule == "" {
+		return nil, errors.WithCode(code.ErrInvalidLogFilter, "neither the regexp nor the filter of log alert rule '%s' is set", name)
+	}
+	rule := &Rule{
+		Name:        name,
+		ServerNames: serverNames,
+		LogName:     logName,
+		Window:      window,
+		Threshold:   threshold,
+		Cooldown:    cooldown,
+		Sinks:       sinks,
+	}
+	var err error
+	if regexpRule != "" {
+		rule.Pattern, err = regexp.Compile(regexpRule)
+		if err != nil {
+			return nil, errors.WithCode(code.ErrInvalidLogFilter, "invalid regexp '%s' of log alert rule '%s'. %s", regexpRule, name, err.Error())
+		}
+	}
+	if fieldRule != "" {
+		rule.Filter, err = access_log.ParseFilter(fieldRule)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rule, nil
+}
+
+// Evaluator evaluates the rule on the lines of a log of a web server. The lines are parsed into the records by parse
+// for the field filter, and the lines not parsed never match the filter.
+//
+// The matched lines are counted in the per-second buckets of the window. The counts are reset when the rule fires, so
+// that the rule fires again only if the lines matched after the alert reach the threshold, after the cooldown.
+type Evaluator struct {
+	rule       *Rule
+	serverName string
+	parse      func(line []byte) (access_log.Record, bool)
+
+	seconds []int64
+	counts  []int
+	samples []string
+	fired   time.Time
+}
+
+// Evaluate counts the line at now, and returns the alert if the rule fires, or nil.
+func (e *Evaluator) Evaluate(line []byte, now time.Time) *v1.LogAlert {
+	if !e.match(line) {
+		return nil
+	}
+	second := now.Unix()
+	i := int(second % int64(len(e.seconds)))
+	if e.seconds[i] != second {
+		e.seconds[i] = second
+		e.counts[i] = 0
+	}
+	e.counts[i]++
+	if len(e.samples) == maxSamples {
+		e.samples = append(e.samples[:0], e.samples[1:]...)
+	}
+	e.samples = append(e.samples, string(line))
+
+	count := 0
+	for i, s := range e.seconds {
+		if s > second-int64(len(e.seconds)) && s <= second {
+			count += e.counts[i]
+		}
+	}
+	if count < e.rule.Threshold || !e.fired.IsZero() && now.Sub(e.fired) < e.rule.Cooldown {
+		return nil
+	}
+
+	e.fired = now
+	alert := &v1.LogAlert{
+		Rule:          e.rule.Name,
+		ServerName:    e.serverName,
+		LogName:       e.rule.LogName,
+		Time:          now,
+		WindowSeconds: int64(len(e.seconds)),
+		Threshold:     e.rule.Threshold,
+		Count:         count,
+		Samples:       e.samples,
+	}
+	for i := range e.counts {
+		e.counts[i] = 0
+	}
+	e.samples = make([]string, 0, maxSamples)
+	return alert
+}
+
+func (e *Evaluator) match(line []byte) bool {
+	if e.rule.Pattern != nil && !e.rule.Pattern.Match(line) {
+		return false
+	}
+	if e.rule.Filter == nil {
+		return true
+	}
+	if e.parse == nil {
+		return false
+	}
+	record, ok := e.parse(line)
+	return ok && e.rule.Filter.Match(record)
+}
+
+func NewEvaluator(rule *Rule, serverName string, parse func(line []byte) (access_log.Record, bool)) *Evaluator {
+	n := int(rule.Window / time.Second)
+	if n < 1 {
+		n = 1
+	}
+	return &Evaluator{
+		rule:       rule,
+		serverName: serverName,
+		parse:      parse,
+		seconds:    make([]int64, n),
+		counts:     make([]int, n),
+		samples:    make([]string, 0, maxSamples),
+	}
+}
@@ -0,0 +1,155 @@
+package log_alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/marmotedu/errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type SinkType string
+
+const ( // SinkType
+	// SinkTypeWebhook posts the alert in json to the url.
+	SinkTypeWebhook SinkType = "webhook"
+	// SinkTypeExec runs the command with the alert in json as the stdin, and the environment variables of the alert.
+	SinkTypeExec SinkType = "exec"
+	// SinkTypeFile appends the alert to the file as a line of json.
+	SinkTypeFile SinkType = "file"
+)
+
+var SinkTypes = []SinkType{SinkTypeWebhook, SinkTypeExec, SinkTypeFile}
+
+// DefaultSinkTimeout is the timeout of the webhook and the command by default.
+const DefaultSinkTimeout = 10 * time.Second
+
+// Sink sends the alerts fired to somewhere out of bifrost.
+type Sink interface {
+	Name() string
+	Send(alert *v1.LogAlert) error
+}
+
+type webhookSink struct {
+	name    string
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (w *webhookSink) Name() string {
+	return w.name
+}
+
+func (w *webhookSink) Send(alert *v1.LogAlert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range w.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook '%s' responded %s", w.url, resp.Status)
+	}
+	return nil
+}
+
+func NewWebhookSink(name, url string, headers map[string]string, timeout time.Duration) Sink {
+	if timeout <= 0 {
+		timeout = DefaultSinkTimeout
+	}
+	return &webhookSink{
+		name:    name,
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+type execSink struct {
+	name    string
+	command []string
+	timeout time.Duration
+}
+
+func (e *execSink) Name() string {
+	return e.name
+}
+
+func (e *execSink) Send(alert *v1.LogAlert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, e.command[0], e.command[1:]...)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Env = append(os.Environ(),
+		"BIFROST_ALERT_RULE="+alert.Rule,
+		"BIFROST_ALERT_SERVER_NAME="+alert.ServerName,
+		"BIFROST_ALERT_LOG_NAME="+alert.LogName,
+		"BIFROST_ALERT_COUNT="+strconv.Itoa(alert.Count),
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.Errorf("command %v failed, %s. %s", e.command, err.Error(), bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+func NewExecSink(name string, command []string, timeout time.Duration) Sink {
+	if timeout <= 0 {
+		timeout = DefaultSinkTimeout
+	}
+	return &execSink{name: name, command: command, timeout: timeout}
+}
+
+type fileSink struct {
+	name string
+	path string
+	mu   sync.Mutex
+}
+
+func (f *fileSink) Name() string {
+	return f.name
+}
+
+// Send opens the file for each alert, so that the file can be rotated.
+func (f *fileSink) Send(alert *v1.LogAlert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func NewFileSink(name, path string) Sink {
+	return &fileSink{name: name, path: path}
+}
@@ -0,0 +1,192 @@
+package log_download
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/marmotedu/errors"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ChunkSize is the size of the chunks read from the downloaded file, which are split again by the chunk size of the
+// stream if it is smaller.
+const ChunkSize = 32 * 1024
+
+// checksumPrefix is the prefix of the checksum, which is the name of the hash algorithm.
+const checksumPrefix = "sha256:"
+
+// Checksum is the running checksum of a downloaded file.
+type Checksum struct {
+	hash hash.Hash
+	size int64
+}
+
+func NewChecksum() *Checksum {
+	return &Checksum{hash: sha256.New()}
+}
+
+func (c *Checksum) Write(p []byte) (int, error) {
+	c.size += int64(len(p))
+	return c.hash.Write(p)
+}
+
+// Size returns the number of the bytes written.
+func (c *Checksum) Size() int64 {
+	return c.size
+}
+
+// String returns the checksum of the bytes written like `sha256:<hex>`.
+func (c *Checksum) String() string {
+	return checksumPrefix + hex.EncodeToString(c.hash.Sum(nil))
+}
+
+// Verify returns an error if the checksum is not the expected one.
+func (c *Checksum) Verify(expected string) error {
+	if expected == "" {
+		return errors.New("checksum is not received")
+	}
+	if !strings.HasPrefix(expected, checksumPrefix) {
+		return errors.Errorf("unsupported checksum '%s'", expected)
+	}
+	if actual := c.String(); actual != expected {
+		return errors.Errorf("checksum mismatch, expected %s, got %s", expected, actual)
+	}
+	return nil
+}
+
+// Stream is the chunks of a downloaded file. Checksum and Err are only valid after the chunks are closed.
+type Stream struct {
+	chunks   chan []byte
+	checksum *Checksum
+	err      error
+}
+
+// NewStream reads the reader in chunks until the end or the context is done, then the reader is closed.
+func NewStream(ctx context.Context, r io.ReadCloser) *Stream {
+	s := &Stream{chunks: make(chan []byte), checksum: NewChecksum()}
+	go func() {
+		defer close(s.chunks)
+		defer r.Close()
+		for {
+			buf := make([]byte, ChunkSize)
+			n, err := io.ReadFull(r, buf)
+			if n > 0 {
+				s.checksum.Write(buf[:n])
+				select {
+				case s.chunks <- buf[:n]:
+				case <-ctx.Done():
+					s.err = ctx.Err()
+					return
+				}
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return
+			}
+			if err != nil {
+				s.err = err
+				return
+			}
+		}
+	}()
+	return s
+}
+
+func (s *Stream) Chunks() <-chan []byte {
+	return s.chunks
+}
+
+func (s *Stream) Checksum() string {
+	return s.checksum.String()
+}
+
+func (s *Stream) Err() error {
+	return s.err
+}
+
+// ArchiveFile is a file added into the archive by the name, which is the file at Path, or the Data if Path is empty.
+type ArchiveFile struct {
+	Name string
+	Path string
+	Data []byte
+}
+
+// Archive returns the reader of the tar.gz archive of the files, which is written while it is read. The file written
+// after it is added is cut at the size when it is opened, and the file removed is skipped.
+func Archive(files []ArchiveFile) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(writeArchive(w, files))
+	}()
+	return r
+}
+
+func writeArchive(w io.Writer, files []ArchiveFile) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, file := range files {
+		if err := addFile(tw, file); err != nil {
+			return errors.Wrapf(err, "failed to archive '%s'", file.Name)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func addFile(tw *tar.Writer, file ArchiveFile) error {
+	name := ArchiveName(file.Name)
+	if file.Path == "" {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(file.Data)),
+			ModTime: time.Now(),
+		}); err != nil {
+			return err
+		}
+		_, err := tw.Write(file.Data)
+		return err
+	}
+
+	f, err := os.Open(file.Path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return errors.Errorf("'%s' is not a regular file", file.Path)
+	}
+	if err = tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    int64(info.Mode().Perm()),
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}); err != nil {
+		return err
+	}
+	_, err = io.CopyN(tw, f, info.Size())
+	return err
+}
+
+// ArchiveName returns the slash separated relative name of the file in the archive, the leading slashes and the parent
+// references are removed, e.g. `/var/log/nginx/access.log` is `var/log/nginx/access.log`.
+func ArchiveName(name string) string {
+	name = path.Clean("/" + filepath.ToSlash(name))
+	return strings.TrimPrefix(name, "/")
+}
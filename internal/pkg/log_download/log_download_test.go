@@ -0,0 +1,114 @@
+package log_download
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func readStream(t *testing.T, s *Stream) []byte {
+	buf := bytes.NewBuffer(nil)
+	for chunk := range s.Chunks() {
+		if len(chunk) > ChunkSize {
+			t.Errorf("chunk size = %d, want <= %d", len(chunk), ChunkSize)
+		}
+		buf.Write(chunk)
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestStream(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), ChunkSize/8+1)
+	s := NewStream(context.Background(), ioutil.NopCloser(bytes.NewReader(data)))
+	if got := readStream(t, s); !bytes.Equal(got, data) {
+		t.Fatalf("streamed %d bytes, want %d bytes", len(got), len(data))
+	}
+
+	checksum := NewChecksum()
+	checksum.Write(data)
+	if err := checksum.Verify(s.Checksum()); err != nil {
+		t.Errorf("Verify(%s) = %v", s.Checksum(), err)
+	}
+	if checksum.Size() != int64(len(data)) {
+		t.Errorf("Size() = %d, want %d", checksum.Size(), len(data))
+	}
+	checksum.Write([]byte("x"))
+	if err := checksum.Verify(s.Checksum()); err == nil {
+		t.Errorf("Verify of the modified data should fail")
+	}
+	if err := NewChecksum().Verify(""); err == nil {
+		t.Errorf("Verify of the empty checksum should fail")
+	}
+}
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-log-download-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logPath := filepath.Join(dir, "access.log")
+	if err = ioutil.WriteFile(logPath, []byte("a\nb\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewStream(context.Background(), Archive([]ArchiveFile{
+		{Name: "logs/access.log", Path: logPath},
+		{Name: "logs/removed.log", Path: filepath.Join(dir, "removed.log")},
+		{Name: "config//etc/nginx/../nginx/nginx.conf", Data: []byte("events {}\n")},
+	}))
+	gr, err := gzip.NewReader(bytes.NewReader(readStream(t, s)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+	got := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[header.Name] = string(data)
+	}
+	want := map[string]string{
+		"logs/access.log":             "a\nb\n",
+		"config/etc/nginx/nginx.conf": "events {}\n",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("archived files = %v, want %v", got, want)
+	}
+	for name, data := range want {
+		if got[name] != data {
+			t.Errorf("archived file %s = %q, want %q", name, got[name], data)
+		}
+	}
+}
+
+func TestArchiveName(t *testing.T) {
+	for name, want := range map[string]string{
+		"access.log":                   "access.log",
+		"/var/log/nginx/access.log":    "var/log/nginx/access.log",
+		"logs/../../etc/passwd":        "etc/passwd",
+		"config//etc/nginx/nginx.conf": "config/etc/nginx/nginx.conf",
+	} {
+		if got := ArchiveName(name); got != want {
+			t.Errorf("ArchiveName(%s) = %s, want %s", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,387 @@
+package log_query
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/marmotedu/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxLineSize is the max size of a log line, the query stops at a longer line.
+const maxLineSize = 1024 * 1024
+
+// readBlockSize is the size of the blocks read backwards from the end of a log file.
+const readBlockSize = 32 * 1024
+
+// Query is a query for the lines of a log and its rotated siblings. The zero Since and Until mean the time range is not
+// bounded, and the zero Limit means all the lines are returned.
+//
+// The positive Offset is a byte offset of the log itself, the rotated siblings are not read in that case. The lines
+// are read forwards from the offset, or backwards from it if Reverse is set.
+type Query struct {
+	Since   time.Time
+	Until   time.Time
+	Offset  int64
+	Limit   int
+	Reverse bool
+	Filter  string
+	// Transform converts the lines matched by the time range and the filter, the lines are skipped if it returns
+	// false, and only the lines not skipped are counted in the limit.
+	Transform func(line []byte) ([]byte, bool)
+}
+
+func (q *Query) validate() (*regexp.Regexp, error) {
+	if q.Offset < 0 {
+		return nil, errors.WithCode(code.ErrInvalidLogQuery, "invalid offset %d", q.Offset)
+	}
+	if q.Limit < 0 {
+		return nil, errors.WithCode(code.ErrInvalidLogQuery, "invalid limit %d", q.Limit)
+	}
+	if !q.Since.IsZero() && !q.Until.IsZero() && q.Until.Before(q.Since) {
+		return nil, errors.WithCode(code.ErrInvalidLogQuery, "the end of the time range %s is before the start %s", q.Until, q.Since)
+	}
+	if q.Filter == "" {
+		return nil, nil
+	}
+	filter, err := regexp.Compile(q.Filter)
+	if err != nil {
+		return nil, errors.WithCode(code.ErrInvalidLogQuery, "invalid filtering regexp rule '%s'. %s", q.Filter, err.Error())
+	}
+	return filter, nil
+}
+
+// Files returns the log and its rotated siblings from the oldest to the newest, that is `access.log.2.gz`,
+// `access.log.1` and `access.log` for the `access.log`.
+func Files(path string) ([]string, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.WithCode(code.ErrLogNotFound, "log '%s' not found. %s", filepath.Base(path), err.Error())
+	}
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	type sibling struct {
+		path string
+		num  int
+	}
+	siblings := make([]sibling, 0)
+	for _, match := range matches {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(match, path+"."), ".gz")
+		num, err := strconv.Atoi(suffix)
+		if err != nil || num < 1 {
+			continue
+		}
+		siblings = append(siblings, sibling{path: match, num: num})
+	}
+	sort.Slice(siblings, func(i, j int) bool {
+		return siblings[i].num > siblings[j].num
+	})
+	files := make([]string, 0, len(siblings)+1)
+	for _, s := range siblings {
+		files = append(files, s.path)
+	}
+	return append(files, path), nil
+}
+
+// Lines queries the lines of the log at the path, the lines are sent without the line breaks, and the channel is
+// closed after the last line is sent, or the context is done.
+func Lines(ctx context.Context, path string, query Query) (<-chan []byte, error) {
+	filter, err := query.validate()
+	if err != nil {
+		return nil, err
+	}
+	files, err := Files(path)
+	if err != nil {
+		return nil, err
+	}
+	if query.Offset > 0 {
+		files = files[len(files)-1:]
+	}
+	if query.Reverse {
+		for i, j := 0, len(files)-1; i < j; i, j = i+1, j-1 {
+			files[i], files[j] = files[j], files[i]
+		}
+	}
+
+	outputC := make(chan []byte)
+	q := &lineQuery{ctx: ctx, query: query, filter: filter, output: outputC}
+	go func() {
+		defer close(outputC)
+		for i, file := range files {
+			offset := int64(0)
+			if i == 0 {
+				offset = query.Offset
+			}
+			var err error
+			if query.Reverse {
+				err = q.readBackwards(file, offset)
+			} else {
+				err = q.readForwards(file, offset, q.handle)
+			}
+			if err == errQueryDone {
+				return
+			}
+			if err != nil {
+				log.Warnf("failed to query log '%s'. %s", file, err.Error())
+				return
+			}
+		}
+	}()
+	return outputC, nil
+}
+
+var errQueryDone = errors.New("log query done")
+
+type lineQuery struct {
+	ctx    context.Context
+	query  Query
+	filter *regexp.Regexp
+	output chan<- []byte
+	sent   int
+	// lastTime is the time of the last line with a timestamp, the lines without timestamps, such as the stack lines of
+	// an error, are in the time of it. The last line is the next line in the file, when the lines are read backwards.
+	lastTime time.Time
+}
+
+// handle sends the line if it is matched, errQueryDone is returned if there are no more lines to query.
+func (q *lineQuery) handle(line []byte) error {
+	out, err := q.match(line, q.query.Reverse)
+	if err != nil || out == nil {
+		return err
+	}
+	return q.send(out)
+}
+
+// match returns the line to send, or nil if the line is not matched. errQueryDone is returned if the line is out of
+// the time range in the reading direction.
+func (q *lineQuery) match(line []byte, reverse bool) ([]byte, error) {
+	if t, ok := ParseTime(line); ok {
+		q.lastTime = t
+	}
+	if !q.query.Since.IsZero() || !q.query.Until.IsZero() {
+		if q.lastTime.IsZero() {
+			return nil, nil
+		}
+		if !q.query.Since.IsZero() && q.lastTime.Before(q.query.Since) {
+			if reverse {
+				return nil, errQueryDone
+			}
+			return nil, nil
+		}
+		if !q.query.Until.IsZero() && q.lastTime.After(q.query.Until) {
+			if !reverse {
+				return nil, errQueryDone
+			}
+			return nil, nil
+		}
+	}
+	if q.filter != nil && !q.filter.Match(line) {
+		return nil, nil
+	}
+	if q.query.Transform != nil {
+		out, ok := q.query.Transform(line)
+		if !ok {
+			return nil, nil
+		}
+		return out, nil
+	}
+	return line, nil
+}
+
+func (q *lineQuery) send(line []byte) error {
+	select {
+	case q.output <- line:
+	case <-q.ctx.Done():
+		return errQueryDone
+	}
+	q.sent++
+	if q.query.Limit > 0 && q.sent >= q.query.Limit {
+		return errQueryDone
+	}
+	return nil
+}
+
+func (q *lineQuery) readForwards(path string, offset int64, handle func(line []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var reader io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		reader = gr
+	} else if offset > 0 {
+		// the offset in the middle of a line starts from the next line
+		if _, err = f.Seek(offset-1, io.SeekStart); err != nil {
+			return err
+		}
+		br := bufio.NewReader(f)
+		// the partial line may be longer than the buffer, which is skipped until the end of it
+		for {
+			if _, err = br.ReadSlice('\n'); err != bufio.ErrBufferFull {
+				break
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		reader = br
+	}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := make([]byte, len(scanner.Bytes()))
+		copy(line, scanner.Bytes())
+		if err = handle(line); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// readBackwards reads the lines before the offset from the last to the first, the offset 0 means the end of the file.
+// The compressed files can not be read backwards, so that they are decompressed and read forwards, and the matched
+// lines are kept in memory, at most the limit of them.
+func (q *lineQuery) readBackwards(path string, offset int64) error {
+	if strings.HasSuffix(path, ".gz") {
+		return q.readCompressedBackwards(path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	end := info.Size()
+	if offset > 0 && offset < end {
+		end = offset
+	}
+
+	// the line at the offset is not read, unless the offset is at the end of it
+	skipPartial := end < info.Size()
+	var rest []byte
+	for end > 0 {
+		size := int64(readBlockSize)
+		if end < size {
+			size = end
+		}
+		end -= size
+		block := make([]byte, size, int(size)+len(rest))
+		if _, err = f.ReadAt(block, end); err != nil {
+			return err
+		}
+		rest = append(block, rest...)
+		for {
+			i := bytes.LastIndexByte(rest, '\n')
+			if i < 0 {
+				break
+			}
+			line := rest[i+1:]
+			rest = rest[:i]
+			if skipPartial {
+				skipPartial = false
+				continue
+			}
+			if len(line) == 0 {
+				continue
+			}
+			if err = q.handle(line); err != nil {
+				return err
+			}
+		}
+		if len(rest) > maxLineSize {
+			return errors.Errorf("line before offset %d is longer than %d bytes", end, maxLineSize)
+		}
+	}
+	if len(rest) > 0 && !skipPartial {
+		return q.handle(rest)
+	}
+	return nil
+}
+
+func (q *lineQuery) readCompressedBackwards(path string) error {
+	lines := make([][]byte, 0)
+	sinceReached := false
+	err := q.readForwards(path, 0, func(line []byte) error {
+		select {
+		case <-q.ctx.Done():
+			return errQueryDone
+		default:
+		}
+		out, err := q.match(line, false)
+		if !q.query.Since.IsZero() && !q.lastTime.IsZero() && q.lastTime.Before(q.query.Since) {
+			sinceReached = true
+		}
+		if err != nil || out == nil {
+			return err
+		}
+		lines = append(lines, out)
+		if limit := q.query.Limit - q.sent; limit > 0 && len(lines) > limit {
+			lines = lines[1:]
+		}
+		return nil
+	})
+	if err != nil && err != errQueryDone {
+		return err
+	}
+	for i := len(lines) - 1; i >= 0; i-- {
+		if err = q.send(lines[i]); err != nil {
+			return err
+		}
+	}
+	// the older files are out of the time range
+	if sinceReached {
+		return errQueryDone
+	}
+	return nil
+}
+
+var timeLayouts = []struct {
+	pattern *regexp.Regexp
+	layout  string
+}{
+	// $time_local of the access log, e.g. `[10/Oct/2000:13:55:36 -0700]`
+	{regexp.MustCompile(`\[(\d{2}/[A-Za-z]{3}/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4})]`), "02/Jan/2006:15:04:05 -0700"},
+	// $time_iso8601 of the access log, e.g. `2000-10-10T13:55:36-07:00`
+	{regexp.MustCompile(`(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:Z|[+-]\d{2}:\d{2}))`), time.RFC3339},
+	// the error log, e.g. `2000/10/10 13:55:36 [error] ...`
+	{regexp.MustCompile(`^(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2})`), "2006/01/02 15:04:05"},
+}
+
+// ParseTime parses the time of a line of the nginx access log or error log, the time of the error log is in the local
+// time zone.
+func ParseTime(line []byte) (time.Time, bool) {
+	for _, tl := range timeLayouts {
+		m := tl.pattern.FindSubmatch(line)
+		if m == nil {
+			continue
+		}
+		t, err := time.ParseInLocation(tl.layout, string(m[1]), time.Local)
+		if err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
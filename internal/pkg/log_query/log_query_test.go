@@ -0,0 +1,166 @@
+package log_query
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func accessLine(minute int, path string) string {
+	return fmt.Sprintf("127.0.0.1 - - [10/Oct/2020:13:%02d:00 +0800] \"GET %s HTTP/1.1\" 200 612\n", minute, path)
+}
+
+// skip returns a transform skipping the lines containing the path.
+func skip(path string) func(line []byte) ([]byte, bool) {
+	return func(line []byte) ([]byte, bool) {
+		return line, !strings.Contains(string(line), path+" ")
+	}
+}
+
+func TestLines(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-log-query-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "access.log")
+	// access.log.2.gz: minutes 0-2, access.log.1: minutes 3-5, access.log: minutes 6-8
+	content := func(from int) string {
+		lines := ""
+		for m := from; m < from+3; m++ {
+			lines += accessLine(m, fmt.Sprintf("/p%02d", m))
+		}
+		return lines
+	}
+	gz, err := os.Create(path + ".2.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gw := gzip.NewWriter(gz)
+	if _, err = gw.Write([]byte(content(0))); err != nil {
+		t.Fatal(err)
+	}
+	if err = gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	gz.Close()
+	if err = ioutil.WriteFile(path+".1", []byte(content(3)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, []byte(content(6)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path+".bak", []byte("ignored\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(minute int) time.Time {
+		return time.Date(2020, 10, 10, 13, minute, 0, 0, time.FixedZone("", 8*3600))
+	}
+	lineOffset := int64(len(accessLine(6, "/p06")))
+	tests := []struct {
+		name  string
+		query Query
+		want  string
+	}{
+		{name: "all", query: Query{}, want: "00 01 02 03 04 05 06 07 08"},
+		{name: "reverse limit", query: Query{Reverse: true, Limit: 4}, want: "08 07 06 05"},
+		{name: "time range", query: Query{Since: at(2), Until: at(4)}, want: "02 03 04"},
+		{name: "reverse time range", query: Query{Since: at(1), Until: at(7), Reverse: true}, want: "07 06 05 04 03 02 01"},
+		{name: "reverse compressed limit", query: Query{Until: at(1), Reverse: true, Limit: 1}, want: "01"},
+		{name: "filter", query: Query{Filter: `/p0[258]`}, want: "02 05 08"},
+		{name: "transform", query: Query{Reverse: true, Limit: 2, Transform: skip("/p08")}, want: "07 06"},
+		{name: "reverse compressed transform", query: Query{Until: at(2), Reverse: true, Limit: 2, Transform: skip("/p01")}, want: "02 00"},
+		{name: "offset", query: Query{Offset: lineOffset}, want: "07 08"},
+		{name: "offset in line", query: Query{Offset: lineOffset - 1}, want: "07 08"},
+		{name: "reverse offset", query: Query{Offset: lineOffset + 1, Reverse: true}, want: "06"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := Lines(context.Background(), path, tt.query)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			got := make([]string, 0)
+			for line := range lines {
+				i := strings.Index(string(line), "/p")
+				got = append(got, string(line[i+2:i+4]))
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("Lines() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+
+	invalids := []struct {
+		path  string
+		query Query
+		code  int
+	}{
+		{path: filepath.Join(dir, "error.log"), code: code.ErrLogNotFound},
+		{path: path, query: Query{Limit: -1}, code: code.ErrInvalidLogQuery},
+		{path: path, query: Query{Since: at(2), Until: at(1)}, code: code.ErrInvalidLogQuery},
+		{path: path, query: Query{Filter: "("}, code: code.ErrInvalidLogQuery},
+	}
+	for _, tt := range invalids {
+		if _, err = Lines(context.Background(), tt.path, tt.query); !errors.IsCode(err, tt.code) {
+			t.Errorf("Lines(%s, %+v) error = %v, want code %d", tt.path, tt.query, err, tt.code)
+		}
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		line string
+		want time.Time
+	}{
+		{line: accessLine(5, "/"), want: time.Date(2020, 10, 10, 13, 5, 0, 0, time.FixedZone("", 8*3600))},
+		{line: `{"time": "2020-10-10T13:05:00+08:00"}`, want: time.Date(2020, 10, 10, 13, 5, 0, 0, time.FixedZone("", 8*3600))},
+		{line: "2020/10/10 13:05:00 [error] 1#1: *1 open() failed", want: time.Date(2020, 10, 10, 13, 5, 0, 0, time.Local)},
+	}
+	for _, tt := range tests {
+		got, ok := ParseTime([]byte(tt.line))
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q) = %s, %v, want %s", tt.line, got, ok, tt.want)
+		}
+	}
+	if _, ok := ParseTime([]byte("    at stack line")); ok {
+		t.Errorf("ParseTime() of a line without time should fail")
+	}
+}
+
+func TestLines_OffsetInLongLine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-log-query-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the first line is longer than the buffer of the reader
+	path := filepath.Join(dir, "access.log")
+	content := accessLine(0, "/p00?q="+strings.Repeat("x", 16*1024)) + accessLine(1, "/p01") + accessLine(2, "/p02")
+	if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	lines, err := Lines(context.Background(), path, Query{Offset: 100})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	got := make([]string, 0)
+	for line := range lines {
+		i := strings.Index(string(line), "/p")
+		got = append(got, string(line[i+2:i+4]))
+	}
+	if strings.Join(got, " ") != "01 02" {
+		t.Errorf("Lines() = %v, want 01 02", got)
+	}
+}
@@ -0,0 +1,41 @@
+package options
+
+import (
+	"github.com/marmotedu/errors"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+type WebServerCertificateOptions struct {
+	ExpiryWarning time.Duration `json:"expiry-warning" mapstructure:"expiry-warning"`
+	CheckInterval time.Duration `json:"check-interval" mapstructure:"check-interval"`
+}
+
+func NewWebServerCertificateOptions() *WebServerCertificateOptions {
+	return &WebServerCertificateOptions{
+		ExpiryWarning: time.Hour * 24 * 30,
+		CheckInterval: time.Hour,
+	}
+}
+
+func (c *WebServerCertificateOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.ExpiryWarning, "web-server-certificate.expiry-warning", c.ExpiryWarning, ""+
+		"Warn about the web server certificates that will expire within the duration.")
+
+	fs.DurationVar(&c.CheckInterval, "web-server-certificate.check-interval", c.CheckInterval, ""+
+		"Set the interval for checking the expiry of web server certificates while watching.")
+}
+
+func (c *WebServerCertificateOptions) Validate() []error {
+	var errs []error
+
+	if c.ExpiryWarning <= 0 {
+		errs = append(errs, errors.Errorf("--web-server-certificate.expiry-warning %s must great than 0", c.ExpiryWarning))
+	}
+
+	if c.CheckInterval < time.Second {
+		errs = append(errs, errors.Errorf("--web-server-certificate.check-interval %s must great than or equal to 1s", c.CheckInterval))
+	}
+
+	return errs
+}
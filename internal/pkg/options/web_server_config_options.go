@@ -1,12 +1,18 @@
 package options
 
 import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/linter"
 	"github.com/marmotedu/errors"
 	"github.com/spf13/pflag"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type WebServerConfigOptions struct {
@@ -18,6 +24,17 @@ type WebServerConfigOptions struct {
 	BackupDir      string `json:"backup-dir" mapstructure:"backup-dir"`
 	BackupCycle    int    `json:"backup-cycle" mapstructure:"backup-cycle"`
 	BackupSaveTime int    `json:"backup-save-time" mapstructure:"backup-save-time"`
+
+	Prefix    string   `json:"prefix" mapstructure:"prefix"`
+	ExtraArgs []string `json:"extra-args" mapstructure:"extra-args"`
+
+	ReloadInterval time.Duration `json:"reload-interval" mapstructure:"reload-interval"`
+	ReloadDebounce time.Duration `json:"reload-debounce" mapstructure:"reload-debounce"`
+	SaveInterval   time.Duration `json:"save-interval" mapstructure:"save-interval"`
+	ConflictPolicy string        `json:"conflict-policy" mapstructure:"conflict-policy"`
+	FileMode       string        `json:"file-mode" mapstructure:"file-mode"`
+
+	LintRules map[string]string `json:"lint-rules" mapstructure:"lint-rules"`
 }
 
 func NewWebServerConfigOptions() *WebServerConfigOptions {
@@ -43,6 +60,15 @@ func (c *WebServerConfigOptions) AddFlags(fs *pflag.FlagSet) {
 		"Set the path of the web server configuration verification binary file."+
 		" It cannot be empty and the file exists.")
 
+	fs.StringVar(&c.Prefix, "web-server-config.prefix", c.Prefix, ""+
+		"Set the prefix path of the web server instance, which is passed to the binary file with `-p`,"+
+		" and the relative config path, logs dir path and pid file are resolved with it."+
+		" Set empty path to use the parent directory of the directory of `verify-exec-path`.")
+
+	fs.StringSliceVar(&c.ExtraArgs, "web-server-config.extra-args", c.ExtraArgs, ""+
+		"Set the extra arguments passed to the web server binary file, e.g. `-g,daemon off;`."+
+		" The arguments managed by bifrost, e.g. `-c`, `-p`, `-s` and `-t`, are not allowed.")
+
 	fs.StringVar(&c.LogsDirPath, "web-server-config.logs-dir-path", filepath.Join(filepath.Dir(filepath.Dir(c.VerifyExecPath)), "logs"), ""+
 		"Set the path of the web server logs dir path.")
 
@@ -58,6 +84,31 @@ func (c *WebServerConfigOptions) AddFlags(fs *pflag.FlagSet) {
 		"Set the save time of the web server configuration backup file."+
 		" The unit is daily."+
 		" Set zero to disable backup.")
+
+	fs.DurationVar(&c.ReloadInterval, "web-server-config.reload-interval", c.ReloadInterval, ""+
+		"Set the interval to poll the web server configuration files, which is only used when the files can not be watched."+
+		" Set zero to use the default 30s.")
+
+	fs.DurationVar(&c.ReloadDebounce, "web-server-config.reload-debounce", c.ReloadDebounce, ""+
+		"Set the quiet time after the last change of the web server configuration files, before reloading them."+
+		" Set zero to use the default 1s.")
+
+	fs.DurationVar(&c.SaveInterval, "web-server-config.save-interval", c.SaveInterval, ""+
+		"Set the interval to save the changed web server configuration to the files."+
+		" Set zero to use the default 10s.")
+
+	fs.StringVar(&c.ConflictPolicy, "web-server-config.conflict-policy", c.ConflictPolicy, ""+
+		"Specify how to resolve the conflict when the web server configuration files and the configuration changed by the api are both changed,"+
+		" `disk-wins`, `api-wins` or `merge`. The discarded changes are saved to a snapshot file in the backup directory."+
+		" Set empty to use the default `merge`, which falls back to `disk-wins` if the changes can not be merged.")
+
+	fs.StringVar(&c.FileMode, "web-server-config.file-mode", c.FileMode, ""+
+		"Set the permission of the saved web server configuration files in octal, e.g. `0644`."+
+		" Set empty to keep the permission of the existing files, and the new files are created with `0644`.")
+
+	fs.StringToStringVar(&c.LintRules, "web-server-config.lint-rules", c.LintRules, ""+
+		"Set the lint rules of the web server configuration, e.g. `server-tokens-on=off,autoindex-on=error`."+
+		" The value can be `off`, `info`, `warning` or `error`, and the rules not set are enabled with their default severities.")
 }
 
 func (c *WebServerConfigOptions) Validate() []error {
@@ -73,11 +124,29 @@ func (c *WebServerConfigOptions) Validate() []error {
 		errs = append(errs, errors.Errorf("--web-server-config.server-type %s can only be `nginx`", c.ServerType))
 	}
 
+	// validate prefix
+	if len(strings.TrimSpace(c.Prefix)) > 0 {
+		prefixf, err := os.Stat(c.Prefix)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "--web-server-config.prefix %s check failed.", c.Prefix))
+		} else if !prefixf.IsDir() {
+			errs = append(errs, errors.Errorf("--web-server-config.prefix %s can only be a directory.", c.Prefix))
+		}
+	}
+
+	// validate extra-args
+	for _, arg := range c.ExtraArgs {
+		switch arg {
+		case "-c", "-p", "-s", "-t", "-T", "-q", "-v", "-V", "-h", "-?":
+			errs = append(errs, errors.Errorf("--web-server-config.extra-args %s is managed by bifrost and not allowed.", arg))
+		}
+	}
+
 	// validate config-path
 	if len(strings.TrimSpace(c.ConfigPath)) == 0 {
 		errs = append(errs, errors.New("--web-server-config.config-path cannot be empty."))
 	} else {
-		conff, err := os.Stat(c.ConfigPath)
+		conff, err := os.Stat(c.GetConfigPath())
 		if err != nil {
 			errs = append(errs, errors.Wrapf(err, "--web-server-config.config-path %s check failed.", c.ConfigPath))
 		} else {
@@ -112,24 +181,112 @@ func (c *WebServerConfigOptions) Validate() []error {
 			}
 		}
 	}
+
+	// validate intervals
+	if c.ReloadInterval < 0 {
+		errs = append(errs, errors.Errorf("--web-server-config.reload-interval %s cannot be negative.", c.ReloadInterval))
+	}
+	if c.ReloadDebounce < 0 {
+		errs = append(errs, errors.Errorf("--web-server-config.reload-debounce %s cannot be negative.", c.ReloadDebounce))
+	}
+	if c.SaveInterval < 0 {
+		errs = append(errs, errors.Errorf("--web-server-config.save-interval %s cannot be negative.", c.SaveInterval))
+	}
+
+	// validate conflict-policy
+	switch v1.ConfigConflictPolicy(c.ConflictPolicy) {
+	case "", v1.ConfigConflictDiskWins, v1.ConfigConflictAPIWins, v1.ConfigConflictMerge:
+	default:
+		errs = append(errs, errors.Errorf("--web-server-config.conflict-policy %s can only be `disk-wins`, `api-wins` or `merge`.", c.ConflictPolicy))
+	}
+
+	// validate file-mode
+	if _, err := c.GetFileMode(); err != nil {
+		errs = append(errs, errors.Errorf("--web-server-config.file-mode %s can only be an octal permission, e.g. `0644`.", c.FileMode))
+	}
+
+	// validate lint-rules
+	if err := linter.ValidateSettings(linter.DefaultRegistry(), c.LintRules); err != nil {
+		errs = append(errs, errors.Wrap(err, "--web-server-config.lint-rules check failed."))
+	}
 	return errs
 }
 
+// GetPrefix returns the prefix path of the web server instance, which defaults to the parent directory of the
+// directory of the verify binary file, as nginx compiled with the default prefix does.
+func (c *WebServerConfigOptions) GetPrefix() string {
+	if len(strings.TrimSpace(c.Prefix)) > 0 {
+		return c.Prefix
+	}
+	return filepath.Dir(filepath.Dir(c.VerifyExecPath))
+}
+
+// GetConfigPath returns the config path, which is resolved with the prefix if it is relative and the prefix is set.
+func (c *WebServerConfigOptions) GetConfigPath() string {
+	if len(strings.TrimSpace(c.Prefix)) > 0 && !filepath.IsAbs(c.ConfigPath) {
+		return filepath.Join(c.Prefix, c.ConfigPath)
+	}
+	return c.ConfigPath
+}
+
+// GetLogsDirPath returns the logs dir path, which defaults to `logs` and is resolved with the prefix if it is relative.
+func (c *WebServerConfigOptions) GetLogsDirPath() string {
+	logsDir := c.LogsDirPath
+	if len(strings.TrimSpace(logsDir)) == 0 {
+		logsDir = "logs"
+	}
+	if !filepath.IsAbs(logsDir) {
+		return filepath.Join(c.GetPrefix(), logsDir)
+	}
+	return logsDir
+}
+
+// GetFileMode returns the permission of the saved config files, zero means keeping the permission of the existing files.
+func (c *WebServerConfigOptions) GetFileMode() (os.FileMode, error) {
+	if len(strings.TrimSpace(c.FileMode)) == 0 {
+		return 0, nil
+	}
+	mode, err := strconv.ParseUint(strings.TrimSpace(c.FileMode), 8, 32)
+	if err != nil {
+		return 0, err
+	}
+	if mode == 0 || mode > uint64(os.ModePerm) {
+		return 0, errors.Errorf("invalid permission %s", c.FileMode)
+	}
+	return os.FileMode(mode), nil
+}
+
 type WebServerConfigsOptions struct {
 	WebServerConfigs []*WebServerConfigOptions `json:"items" mapstructure:"items"`
+	StateFile        string                    `json:"state-file" mapstructure:"state-file"`
 }
 
 func NewWebServerConfigsOptions() *WebServerConfigsOptions {
 	return &WebServerConfigsOptions{WebServerConfigs: make([]*WebServerConfigOptions, 0)}
 }
 
+func (cs *WebServerConfigsOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&cs.StateFile, "web-server-configs.state-file", cs.StateFile, ""+
+		"Set the path of the state file, which persists the web servers registered, reconfigured or unregistered at runtime."+
+		" The file is initialized with `web-server-configs.items` if it does not exist, afterwards the web servers recorded in it"+
+		" take the place of `web-server-configs.items`, which are ignored at startup and reloading."+
+		" Set empty path to disable the persistence.")
+}
+
 func (cs *WebServerConfigsOptions) Validate() []error {
 	var errs []error
 
-	if len(cs.WebServerConfigs) == 0 {
+	if len(cs.WebServerConfigs) == 0 && !cs.hasStateFile() {
 		errs = append(errs, errors.New("web server configs options is null."))
 	}
 
+	// validate state-file
+	if len(strings.TrimSpace(cs.StateFile)) > 0 {
+		if f, err := os.Stat(cs.StateFile); err == nil && f.IsDir() {
+			errs = append(errs, errors.Errorf("--web-server-configs.state-file %s cannot be a directory.", cs.StateFile))
+		}
+	}
+
 	for i, c := range cs.WebServerConfigs {
 		suberrs := c.Validate()
 		if len(suberrs) > 0 {
@@ -141,3 +298,50 @@ func (cs *WebServerConfigsOptions) Validate() []error {
 
 	return errs
 }
+
+// Diff returns the sorted names of the web servers added, changed and removed in the new options compared with the
+// options, the nil options have no web server.
+func (cs *WebServerConfigsOptions) Diff(newOpts *WebServerConfigsOptions) (added, changed, removed []string) {
+	oldItems, newItems := cs.byName(), newOpts.byName()
+	for servername, newItem := range newItems {
+		oldItem, has := oldItems[servername]
+		if !has {
+			added = append(added, servername)
+		} else if !reflect.DeepEqual(oldItem, newItem) {
+			changed = append(changed, servername)
+		}
+	}
+	for servername := range oldItems {
+		if _, has := newItems[servername]; !has {
+			removed = append(removed, servername)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(changed)
+	sort.Strings(removed)
+	return added, changed, removed
+}
+
+// Item returns the options of the web server by the name, nil will be returned if it is not found.
+func (cs *WebServerConfigsOptions) Item(servername string) *WebServerConfigOptions {
+	return cs.byName()[servername]
+}
+
+func (cs *WebServerConfigsOptions) byName() map[string]*WebServerConfigOptions {
+	items := make(map[string]*WebServerConfigOptions)
+	if cs == nil {
+		return items
+	}
+	for _, item := range cs.WebServerConfigs {
+		items[item.ServerName] = item
+	}
+	return items
+}
+
+func (cs *WebServerConfigsOptions) hasStateFile() bool {
+	if len(strings.TrimSpace(cs.StateFile)) == 0 {
+		return false
+	}
+	f, err := os.Stat(cs.StateFile)
+	return err == nil && !f.IsDir()
+}
@@ -0,0 +1,60 @@
+package options
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWebServerConfigsOptions_Diff(t *testing.T) {
+	item := func(servername string, backupCycle int) *WebServerConfigOptions {
+		opts := NewWebServerConfigOptions()
+		opts.ServerName = servername
+		opts.BackupCycle = backupCycle
+		return opts
+	}
+	items := func(items ...*WebServerConfigOptions) *WebServerConfigsOptions {
+		return &WebServerConfigsOptions{WebServerConfigs: items}
+	}
+	tests := []struct {
+		name                    string
+		old, new                *WebServerConfigsOptions
+		added, changed, removed []string
+	}{
+		{
+			name: "not changed",
+			old:  items(item("a", 1), item("b", 1)),
+			new:  items(item("b", 1), item("a", 1)),
+		},
+		{
+			name:    "added, changed and removed",
+			old:     items(item("a", 1), item("b", 1), item("c", 1)),
+			new:     items(item("e", 1), item("b", 2), item("d", 1), item("a", 1)),
+			added:   []string{"d", "e"},
+			changed: []string{"b"},
+			removed: []string{"c"},
+		},
+		{
+			name:  "from nil",
+			new:   items(item("a", 1)),
+			added: []string{"a"},
+		},
+		{
+			name:    "to nil",
+			old:     items(item("a", 1)),
+			removed: []string{"a"},
+		},
+		{
+			name: "state file only",
+			old:  &WebServerConfigsOptions{StateFile: "a.json"},
+			new:  &WebServerConfigsOptions{StateFile: "b.json"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, changed, removed := tt.old.Diff(tt.new)
+			if !reflect.DeepEqual(added, tt.added) || !reflect.DeepEqual(changed, tt.changed) || !reflect.DeepEqual(removed, tt.removed) {
+				t.Errorf("Diff() = %v, %v, %v, want %v, %v, %v", added, changed, removed, tt.added, tt.changed, tt.removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package options
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/log_alert"
+	"github.com/marmotedu/errors"
+	"github.com/spf13/pflag"
+	"strings"
+	"time"
+)
+
+// LogAlertRuleOptions fires the alert when the lines of the log matched by the regexp or the filter, or both of them,
+// reach the threshold in the window, see log_alert.Rule.
+type LogAlertRuleOptions struct {
+	Name        string        `json:"name" mapstructure:"name"`
+	ServerNames []string      `json:"server-names" mapstructure:"server-names"`
+	Log         string        `json:"log" mapstructure:"log"`
+	Regexp      string        `json:"regexp" mapstructure:"regexp"`
+	Filter      string        `json:"filter" mapstructure:"filter"`
+	Window      time.Duration `json:"window" mapstructure:"window"`
+	Threshold   int           `json:"threshold" mapstructure:"threshold"`
+	Cooldown    time.Duration `json:"cooldown" mapstructure:"cooldown"`
+	Sinks       []string      `json:"sinks" mapstructure:"sinks"`
+}
+
+// LogAlertSinkOptions sends the alerts to the webhook url, the command or the file by the type.
+type LogAlertSinkOptions struct {
+	Name    string            `json:"name" mapstructure:"name"`
+	Type    string            `json:"type" mapstructure:"type"`
+	URL     string            `json:"url" mapstructure:"url"`
+	Headers map[string]string `json:"headers" mapstructure:"headers"`
+	Command []string          `json:"command" mapstructure:"command"`
+	Path    string            `json:"path" mapstructure:"path"`
+	Timeout time.Duration     `json:"timeout" mapstructure:"timeout"`
+}
+
+type WebServerLogAlertOptions struct {
+	Rules          []*LogAlertRuleOptions `json:"rules" mapstructure:"rules"`
+	Sinks          []*LogAlertSinkOptions `json:"sinks" mapstructure:"sinks"`
+	ResyncInterval time.Duration          `json:"resync-interval" mapstructure:"resync-interval"`
+}
+
+func NewWebServerLogAlertOptions() *WebServerLogAlertOptions {
+	return &WebServerLogAlertOptions{
+		Rules:          make([]*LogAlertRuleOptions, 0),
+		Sinks:          make([]*LogAlertSinkOptions, 0),
+		ResyncInterval: time.Minute,
+	}
+}
+
+func (a *WebServerLogAlertOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&a.ResyncInterval, "web-server-log-alert.resync-interval", a.ResyncInterval, ""+
+		"Set the interval to resync the logs evaluated by the log alert rules with the web server configs, which can not be less than 1s.")
+}
+
+func (a *WebServerLogAlertOptions) Validate() []error {
+	var errs []error
+
+	if a.ResyncInterval < time.Second {
+		errs = append(errs, errors.New("--web-server-log-alert.resync-interval can not be less than 1s"))
+	}
+
+	sinks := make(map[string]bool)
+	for i, s := range a.Sinks {
+		if len(strings.TrimSpace(s.Name)) == 0 {
+			errs = append(errs, errors.Errorf("the name of the %dst log alert sink cannot be empty", i+1))
+		} else if sinks[s.Name] {
+			errs = append(errs, errors.Errorf("log alert sink '%s' is duplicated", s.Name))
+		}
+		sinks[s.Name] = true
+		if _, err := s.sink(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	rules := make(map[string]bool)
+	for i, r := range a.Rules {
+		if len(strings.TrimSpace(r.Name)) == 0 {
+			errs = append(errs, errors.Errorf("the name of the %dst log alert rule cannot be empty", i+1))
+		} else if rules[r.Name] {
+			errs = append(errs, errors.Errorf("log alert rule '%s' is duplicated", r.Name))
+		}
+		rules[r.Name] = true
+		if len(strings.TrimSpace(r.Log)) == 0 {
+			errs = append(errs, errors.Errorf("the log of log alert rule '%s' cannot be empty", r.Name))
+		}
+		if r.Window < time.Second {
+			errs = append(errs, errors.Errorf("the window %s of log alert rule '%s' can not be less than 1s", r.Window, r.Name))
+		}
+		if r.Threshold < 1 {
+			errs = append(errs, errors.Errorf("the threshold %d of log alert rule '%s' must great than 0", r.Threshold, r.Name))
+		}
+		if r.Cooldown < 0 {
+			errs = append(errs, errors.Errorf("the cooldown %s of log alert rule '%s' can not be negative", r.Cooldown, r.Name))
+		}
+		for _, name := range r.Sinks {
+			if !sinks[name] {
+				errs = append(errs, errors.Errorf("the sink '%s' of log alert rule '%s' is not defined", name, r.Name))
+			}
+		}
+		if _, err := r.rule(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// Enabled returns whether any log alert rule is defined.
+func (a *WebServerLogAlertOptions) Enabled() bool {
+	return len(a.Rules) > 0
+}
+
+// LogAlertRules returns the log alert rules.
+func (a *WebServerLogAlertOptions) LogAlertRules() ([]*log_alert.Rule, error) {
+	rules := make([]*log_alert.Rule, 0, len(a.Rules))
+	for _, r := range a.Rules {
+		rule, err := r.rule()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// LogAlertSinks returns the log alert sinks.
+func (a *WebServerLogAlertOptions) LogAlertSinks() ([]log_alert.Sink, error) {
+	sinks := make([]log_alert.Sink, 0, len(a.Sinks))
+	for _, s := range a.Sinks {
+		sink, err := s.sink()
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func (r *LogAlertRuleOptions) rule() (*log_alert.Rule, error) {
+	return log_alert.NewRule(r.Name, r.ServerNames, r.Log, r.Regexp, r.Filter, r.Window, r.Threshold, r.Cooldown, r.Sinks)
+}
+
+func (s *LogAlertSinkOptions) sink() (log_alert.Sink, error) {
+	switch log_alert.SinkType(s.Type) {
+	case log_alert.SinkTypeWebhook:
+		if !strings.HasPrefix(s.URL, "http://") && !strings.HasPrefix(s.URL, "https://") {
+			return nil, errors.Errorf("the url '%s' of log alert sink '%s' must be a http or https url", s.URL, s.Name)
+		}
+		return log_alert.NewWebhookSink(s.Name, s.URL, s.Headers, s.Timeout), nil
+	case log_alert.SinkTypeExec:
+		if len(s.Command) == 0 {
+			return nil, errors.Errorf("the command of log alert sink '%s' cannot be empty", s.Name)
+		}
+		return log_alert.NewExecSink(s.Name, s.Command, s.Timeout), nil
+	case log_alert.SinkTypeFile:
+		if len(strings.TrimSpace(s.Path)) == 0 {
+			return nil, errors.Errorf("the path of log alert sink '%s' cannot be empty", s.Name)
+		}
+		return log_alert.NewFileSink(s.Name, s.Path), nil
+	default:
+		return nil, errors.Errorf("the type '%s' of log alert sink '%s' must be one of %v", s.Type, s.Name, log_alert.SinkTypes)
+	}
+}
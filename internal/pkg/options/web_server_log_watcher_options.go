@@ -8,15 +8,19 @@ import (
 )
 
 type WebServerLogWatcherOptions struct {
-	MaxConnections int           `json:"max-connections" mapstructure:"max-connections"`
-	WatchTimeout   time.Duration `json:"watch-timeout" mapstructure:"watch-timeout"`
+	MaxConnections     int           `json:"max-connections" mapstructure:"max-connections"`
+	WatchTimeout       time.Duration `json:"watch-timeout" mapstructure:"watch-timeout"`
+	BufferSize         int           `json:"buffer-size" mapstructure:"buffer-size"`
+	SlowConsumerPolicy string        `json:"slow-consumer-policy" mapstructure:"slow-consumer-policy"`
 }
 
 func NewWebServerLogWatcherOptions() *WebServerLogWatcherOptions {
 	defaults := file_watcher.NewConfig()
 	return &WebServerLogWatcherOptions{
-		MaxConnections: defaults.MaxConnections,
-		WatchTimeout:   defaults.OutputTimeout,
+		MaxConnections:     defaults.MaxConnections,
+		WatchTimeout:       defaults.OutputTimeout,
+		BufferSize:         defaults.BufferSize,
+		SlowConsumerPolicy: string(defaults.SlowConsumerPolicy),
 	}
 }
 
@@ -26,6 +30,12 @@ func (w *WebServerLogWatcherOptions) AddFlags(fs *pflag.FlagSet) {
 
 	fs.DurationVar(&w.WatchTimeout, "web-server-log-watcher.watch-timeout", w.WatchTimeout, ""+
 		"")
+
+	fs.IntVar(&w.BufferSize, "web-server-log-watcher.buffer-size", w.BufferSize, ""+
+		"Set the number of the lines buffered for the watching clients of a log file.")
+
+	fs.StringVar(&w.SlowConsumerPolicy, "web-server-log-watcher.slow-consumer-policy", w.SlowConsumerPolicy, ""+
+		"Set the policy for the watching clients falling behind the buffer, one of drop-oldest, disconnect and block.")
 }
 
 func (w *WebServerLogWatcherOptions) Validate() []error {
@@ -35,11 +45,27 @@ func (w *WebServerLogWatcherOptions) Validate() []error {
 		errs = append(errs, errors.Errorf("--web-server-log-watcher.max-connections %d must great than 0", w.MaxConnections))
 	}
 
+	if w.BufferSize < 1 {
+		errs = append(errs, errors.Errorf("--web-server-log-watcher.buffer-size %d must great than 0", w.BufferSize))
+	}
+
+	valid := false
+	for _, policy := range file_watcher.SlowConsumerPolicies {
+		if w.SlowConsumerPolicy == string(policy) {
+			valid = true
+		}
+	}
+	if !valid {
+		errs = append(errs, errors.Errorf("--web-server-log-watcher.slow-consumer-policy '%s' must be one of %v", w.SlowConsumerPolicy, file_watcher.SlowConsumerPolicies))
+	}
+
 	return errs
 }
 
 func (w *WebServerLogWatcherOptions) ApplyTo(c *file_watcher.Config) error {
 	c.MaxConnections = w.MaxConnections
 	c.OutputTimeout = w.WatchTimeout
+	c.BufferSize = w.BufferSize
+	c.SlowConsumerPolicy = file_watcher.SlowConsumerPolicy(w.SlowConsumerPolicy)
 	return nil
 }
@@ -0,0 +1,53 @@
+package options
+
+import (
+	"github.com/marmotedu/errors"
+	"github.com/spf13/pflag"
+	"os"
+	"time"
+)
+
+type WebServerReconcilerOptions struct {
+	Dir      string        `json:"dir" mapstructure:"dir"`
+	Interval time.Duration `json:"interval" mapstructure:"interval"`
+	PlanOnly bool          `json:"plan-only" mapstructure:"plan-only"`
+}
+
+func NewWebServerReconcilerOptions() *WebServerReconcilerOptions {
+	return &WebServerReconcilerOptions{
+		Dir:      "",
+		Interval: 0,
+		PlanOnly: false,
+	}
+}
+
+func (r *WebServerReconcilerOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&r.Dir, "web-server-reconciler.dir", r.Dir, ""+
+		"Set the directory of the site definitions, the server and upstream blocks of the web servers are reconciled "+
+		"to the yaml and json definitions in it. The reconciler is disabled if it is empty.")
+
+	fs.DurationVar(&r.Interval, "web-server-reconciler.interval", r.Interval, ""+
+		"Set the interval to reconcile the web servers regularly, which can not be less than 10s. "+
+		"The web servers are only reconciled on demand if it is 0.")
+
+	fs.BoolVar(&r.PlanOnly, "web-server-reconciler.plan-only", r.PlanOnly, ""+
+		"Only report the changes and the drift found by the regular reconciling, without applying them.")
+}
+
+func (r *WebServerReconcilerOptions) Validate() []error {
+	var errs []error
+
+	if r.Dir != "" {
+		if info, err := os.Stat(r.Dir); err != nil {
+			errs = append(errs, errors.Errorf("--web-server-reconciler.dir %s is not accessible, %v", r.Dir, err))
+		} else if !info.IsDir() {
+			errs = append(errs, errors.Errorf("--web-server-reconciler.dir %s is not a directory", r.Dir))
+		}
+	}
+
+	if r.Interval < 0 || (r.Interval > 0 && r.Interval < 10*time.Second) {
+		errs = append(errs, errors.New("--web-server-reconciler.interval must be 0 or not less than 10s"))
+	}
+
+	return errs
+}
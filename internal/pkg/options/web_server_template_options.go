@@ -0,0 +1,34 @@
+package options
+
+import (
+	"github.com/marmotedu/errors"
+	"github.com/spf13/pflag"
+	"os"
+)
+
+type WebServerTemplateOptions struct {
+	Dir string `json:"dir" mapstructure:"dir"`
+}
+
+func NewWebServerTemplateOptions() *WebServerTemplateOptions {
+	return &WebServerTemplateOptions{
+		Dir: "configs/templates",
+	}
+}
+
+func (t *WebServerTemplateOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&t.Dir, "web-server-template.dir", t.Dir, ""+
+		"Set the directory of the web server config templates, the templates are loaded from the yaml and json files in it.")
+}
+
+func (t *WebServerTemplateOptions) Validate() []error {
+	var errs []error
+
+	if t.Dir == "" {
+		errs = append(errs, errors.New("--web-server-template.dir cannot be empty"))
+	} else if info, err := os.Stat(t.Dir); err == nil && !info.IsDir() {
+		errs = append(errs, errors.Errorf("--web-server-template.dir %s is not a directory", t.Dir))
+	}
+
+	return errs
+}
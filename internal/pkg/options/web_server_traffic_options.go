@@ -0,0 +1,46 @@
+package options
+
+import (
+	"github.com/marmotedu/errors"
+	"github.com/spf13/pflag"
+	"time"
+)
+
+type WebServerTrafficOptions struct {
+	Enabled        bool          `json:"enabled" mapstructure:"enabled"`
+	Window         time.Duration `json:"window" mapstructure:"window"`
+	ResyncInterval time.Duration `json:"resync-interval" mapstructure:"resync-interval"`
+}
+
+func NewWebServerTrafficOptions() *WebServerTrafficOptions {
+	return &WebServerTrafficOptions{
+		Enabled:        false,
+		Window:         time.Minute,
+		ResyncInterval: time.Minute,
+	}
+}
+
+func (t *WebServerTrafficOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&t.Enabled, "web-server-traffic.enabled", t.Enabled, ""+
+		"Enable the traffic stats of the web servers, which are aggregated from the access logs tailed.")
+
+	fs.DurationVar(&t.Window, "web-server-traffic.window", t.Window, ""+
+		"Set the rolling window of the traffic stats, which can not be less than 1s or more than 1h.")
+
+	fs.DurationVar(&t.ResyncInterval, "web-server-traffic.resync-interval", t.ResyncInterval, ""+
+		"Set the interval to resync the access logs tailed with the web server configs, which can not be less than 1s.")
+}
+
+func (t *WebServerTrafficOptions) Validate() []error {
+	var errs []error
+
+	if t.Window < time.Second || t.Window > time.Hour {
+		errs = append(errs, errors.New("--web-server-traffic.window must be between 1s and 1h"))
+	}
+
+	if t.ResyncInterval < time.Second {
+		errs = append(errs, errors.New("--web-server-traffic.resync-interval can not be less than 1s"))
+	}
+
+	return errs
+}
@@ -44,3 +44,29 @@ func RequestShutdown() bool {
 
 	return false
 }
+
+// SetupReloadSignalHandler registered for SIGHUP. A reload channel is returned which receives a value on these signals,
+// the signals caught during a reload are merged into one. The handler is stopped when the stop channel is closed.
+func SetupReloadSignalHandler(stop <-chan struct{}) <-chan struct{} {
+	reloadHandler := make(chan os.Signal, 1)
+	reload := make(chan struct{}, 1)
+
+	signal.Notify(reloadHandler, reloadSignals...)
+
+	go func() {
+		defer signal.Stop(reloadHandler)
+		for {
+			select {
+			case <-stop:
+				return
+			case <-reloadHandler:
+				select {
+				case reload <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	return reload
+}
@@ -0,0 +1,189 @@
+package traffic_stats
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxNamesPerScope is the max number of the server names or the upstream addresses of a web server, since the server
+// names come from the `Host` headers of the clients. The requests of the others are counted in the name OtherName.
+const MaxNamesPerScope = 1000
+
+// OtherName is the name of the stats of the server names or the upstream addresses beyond MaxNamesPerScope.
+const OtherName = "~other"
+
+var scopeOrder = map[v1.TrafficStatsScope]int{
+	v1.TrafficStatsScopeServer:     0,
+	v1.TrafficStatsScopeServerName: 1,
+	v1.TrafficStatsScopeUpstream:   2,
+}
+
+type statsKey struct {
+	serverName string
+	scope      v1.TrafficStatsScope
+	name       string
+}
+
+type scopeKey struct {
+	serverName string
+	scope      v1.TrafficStatsScope
+}
+
+// Aggregator aggregates the requests of the access logs into the rolling windows per web server, server name and
+// upstream address.
+type Aggregator struct {
+	mu         sync.Mutex
+	windowSize time.Duration
+	windows    map[statsKey]*window
+	names      map[scopeKey]int
+}
+
+// Add adds the request of the access log record of the web server. The server name is the `$server_name` or `$host`
+// field of the record, or the only server name of the server writing the access log, and the upstream addresses are
+// the `$upstream_addr` field. The record without the `$status` field is ignored.
+func (a *Aggregator) Add(serverName string, record access_log.Record, serverNames []string, now time.Time) bool {
+	sample, ok := SampleOf(record)
+	if !ok {
+		return false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.add(statsKey{serverName: serverName, scope: v1.TrafficStatsScopeServer}, now, sample)
+
+	name := firstValue(record, "server_name", "host")
+	if name == "" && len(serverNames) == 1 {
+		name = serverNames[0]
+	}
+	if name != "" {
+		a.add(statsKey{serverName: serverName, scope: v1.TrafficStatsScopeServerName, name: strings.ToLower(name)}, now, sample)
+	}
+	for _, addr := range splitValues(record["upstream_addr"]) {
+		a.add(statsKey{serverName: serverName, scope: v1.TrafficStatsScopeUpstream, name: addr}, now, sample)
+	}
+	return true
+}
+
+func (a *Aggregator) add(key statsKey, now time.Time, sample Sample) {
+	w, has := a.windows[key]
+	if !has {
+		sk := scopeKey{serverName: key.serverName, scope: key.scope}
+		if key.scope != v1.TrafficStatsScopeServer && a.names[sk] >= MaxNamesPerScope {
+			key.name = OtherName
+			w, has = a.windows[key]
+		}
+		if !has {
+			w = newWindow(a.windowSize)
+			a.windows[key] = w
+			a.names[sk]++
+		}
+	}
+	w.add(now, sample)
+}
+
+// Stats returns the stats of the web server in the window before now, ordered by the scopes and the names, the stats
+// of all the web servers are returned if the server name is empty. The windows without any request are dropped.
+func (a *Aggregator) Stats(serverName string, now time.Time) []*v1.TrafficStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	list := make([]*v1.TrafficStats, 0)
+	for key, w := range a.windows {
+		if serverName != "" && key.serverName != serverName {
+			continue
+		}
+		stats := w.stats(now)
+		if stats.Requests == 0 {
+			delete(a.windows, key)
+			a.names[scopeKey{serverName: key.serverName, scope: key.scope}]--
+			continue
+		}
+		stats.ServerName = key.serverName
+		stats.Scope = key.scope
+		stats.Name = key.name
+		list = append(list, stats)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].ServerName != list[j].ServerName {
+			return list[i].ServerName < list[j].ServerName
+		}
+		if list[i].Scope != list[j].Scope {
+			return scopeOrder[list[i].Scope] < scopeOrder[list[j].Scope]
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Remove drops the stats of the web server.
+func (a *Aggregator) Remove(serverName string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for key := range a.windows {
+		if key.serverName == serverName {
+			delete(a.windows, key)
+		}
+	}
+	for key := range a.names {
+		if key.serverName == serverName {
+			delete(a.names, key)
+		}
+	}
+}
+
+// SampleOf converts the access log record into a sample. The bytes are the `$body_bytes_sent` or `$bytes_sent`
+// field, and the upstream response time is the sum of the times of all the upstreams tried.
+func SampleOf(record access_log.Record) (Sample, bool) {
+	status, err := strconv.Atoi(record["status"])
+	if err != nil {
+		return Sample{}, false
+	}
+	sample := Sample{Status: status, RequestTime: -1, UpstreamResponseTime: -1}
+	if bytes, err := strconv.ParseInt(firstValue(record, "body_bytes_sent", "bytes_sent"), 10, 64); err == nil {
+		sample.Bytes = bytes
+	}
+	if requestTime, err := strconv.ParseFloat(record["request_time"], 64); err == nil {
+		sample.RequestTime = requestTime
+	}
+	for _, t := range splitValues(record["upstream_response_time"]) {
+		if upstreamTime, err := strconv.ParseFloat(t, 64); err == nil {
+			if sample.UpstreamResponseTime < 0 {
+				sample.UpstreamResponseTime = 0
+			}
+			sample.UpstreamResponseTime += upstreamTime
+		}
+	}
+	return sample, true
+}
+
+func firstValue(record access_log.Record, fields ...string) string {
+	for _, field := range fields {
+		if value := record[field]; value != "" && value != "-" {
+			return value
+		}
+	}
+	return ""
+}
+
+// splitValues splits the values of the upstream variables, which are separated by `, ` for the upstreams tried and
+// by ` : ` for the internal redirects, the empty values `-` are dropped.
+func splitValues(value string) []string {
+	values := make([]string, 0)
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if part != "-" && part != ":" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+func NewAggregator(windowSize time.Duration) *Aggregator {
+	return &Aggregator{
+		windowSize: windowSize,
+		windows:    make(map[statsKey]*window),
+		names:      make(map[scopeKey]int),
+	}
+}
@@ -0,0 +1,87 @@
+package traffic_stats
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/access_log"
+	"testing"
+	"time"
+)
+
+func TestAggregator(t *testing.T) {
+	a := NewAggregator(10 * time.Second)
+	now := time.Unix(1600000000, 0)
+	records := []access_log.Record{
+		{"status": "200", "body_bytes_sent": "100", "request_time": "0.100", "host": "Shop.example.com", "upstream_addr": "10.0.0.1:80", "upstream_response_time": "0.090"},
+		{"status": "502", "body_bytes_sent": "0", "request_time": "0.300", "host": "shop.example.com", "upstream_addr": "10.0.0.1:80, 10.0.0.2:80", "upstream_response_time": "0.100, 0.150"},
+		{"status": "404", "body_bytes_sent": "50", "request_time": "0.001", "upstream_addr": "-", "upstream_response_time": "-"},
+		{"request": "invalid"},
+	}
+	for i, record := range records {
+		a.Add("web", record, []string{"www.example.com"}, now.Add(time.Duration(i)*time.Second))
+	}
+	a.Add("web", records[0], nil, now.Add(-20*time.Second))
+
+	got := make(map[string]*v1.TrafficStats)
+	list := a.Stats("", now.Add(3*time.Second))
+	if len(list) > 0 && list[0].Scope != v1.TrafficStatsScopeServer {
+		t.Errorf("Stats()[0] = %+v, want the server scope first", list[0])
+	}
+	for _, stats := range list {
+		got[fmt.Sprintf("%s/%s/%s", stats.ServerName, stats.Scope, stats.Name)] = stats
+	}
+	if len(got) != 5 {
+		t.Fatalf("Stats() = %v, want 5 stats", got)
+	}
+	server := got["web/server/"]
+	if server.Requests != 3 || server.Bytes != 150 || server.RequestRate != 0.3 {
+		t.Errorf("server stats = %+v", server)
+	}
+	if server.StatusClasses["2xx"] != 1 || server.StatusClasses["4xx"] != 1 || server.StatusClasses["5xx"] != 1 {
+		t.Errorf("server status classes = %v", server.StatusClasses)
+	}
+	if server.RequestTime == nil || server.RequestTime.P50 != 0.1 || server.RequestTime.Max != 0.3 {
+		t.Errorf("server request time = %+v", server.RequestTime)
+	}
+	if server.UpstreamResponseTime == nil || server.UpstreamResponseTime.Max != 0.25 {
+		t.Errorf("server upstream response time = %+v", server.UpstreamResponseTime)
+	}
+	if shop := got["web/server-name/shop.example.com"]; shop == nil || shop.Requests != 2 {
+		t.Errorf("server name stats = %+v", shop)
+	}
+	if www := got["web/server-name/www.example.com"]; www == nil || www.Requests != 1 {
+		t.Errorf("default server name stats = %+v", www)
+	}
+	if upstream := got["web/upstream/10.0.0.1:80"]; upstream == nil || upstream.Requests != 2 {
+		t.Errorf("upstream stats = %+v", upstream)
+	}
+	if upstream := got["web/upstream/10.0.0.2:80"]; upstream == nil || upstream.Requests != 1 {
+		t.Errorf("upstream stats = %+v", upstream)
+	}
+
+	if stats := a.Stats("web", now.Add(time.Minute)); len(stats) != 0 {
+		t.Errorf("Stats() of the expired window = %v, want empty", stats)
+	}
+	a.Add("web", records[0], nil, now)
+	a.Remove("web")
+	if stats := a.Stats("", now); len(stats) != 0 {
+		t.Errorf("Stats() of the removed server = %v, want empty", stats)
+	}
+}
+
+func TestAggregator_MaxNames(t *testing.T) {
+	a := NewAggregator(time.Second)
+	now := time.Unix(1600000000, 0)
+	for i := 0; i < MaxNamesPerScope+10; i++ {
+		a.Add("web", access_log.Record{"status": "200", "host": fmt.Sprintf("h%d", i)}, nil, now)
+	}
+	stats := a.Stats("web", now)
+	if len(stats) != MaxNamesPerScope+2 {
+		t.Fatalf("Stats() = %d stats, want %d", len(stats), MaxNamesPerScope+2)
+	}
+	for _, s := range stats {
+		if s.Name == OtherName && s.Requests != 10 {
+			t.Errorf("stats of %s = %d requests, want 10", OtherName, s.Requests)
+		}
+	}
+}
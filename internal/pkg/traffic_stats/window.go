@@ -0,0 +1,146 @@
+package traffic_stats
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// maxSamplesPerSecond is the max number of the latencies kept for a second, the latencies of the busier seconds are
+// sampled.
+const maxSamplesPerSecond = 256
+
+// Sample is a request logged in the access log, the negative latencies mean they are not logged.
+type Sample struct {
+	Status               int
+	Bytes                int64
+	RequestTime          float64
+	UpstreamResponseTime float64
+}
+
+// latencies is a reservoir of the latencies of a second.
+type latencies struct {
+	seen    int64
+	samples []float64
+}
+
+func (l *latencies) add(latency float64) {
+	if latency < 0 {
+		return
+	}
+	l.seen++
+	if len(l.samples) < maxSamplesPerSecond {
+		l.samples = append(l.samples, latency)
+		return
+	}
+	if i := rand.Int63n(l.seen); i < maxSamplesPerSecond {
+		l.samples[i] = latency
+	}
+}
+
+type bucket struct {
+	second        int64
+	requests      int64
+	statusClasses [6]int64
+	bytes         int64
+	requestTimes  latencies
+	upstreamTimes latencies
+}
+
+// window is a rolling window of the per-second buckets.
+type window struct {
+	buckets []*bucket
+}
+
+func newWindow(size time.Duration) *window {
+	n := int(size / time.Second)
+	if n < 1 {
+		n = 1
+	}
+	return &window{buckets: make([]*bucket, n)}
+}
+
+func (w *window) add(now time.Time, sample Sample) {
+	second := now.Unix()
+	i := int(second % int64(len(w.buckets)))
+	b := w.buckets[i]
+	if b != nil && b.second > second { // the sample older than the window
+		return
+	}
+	if b == nil || b.second != second {
+		b = &bucket{second: second}
+		w.buckets[i] = b
+	}
+	b.requests++
+	class := sample.Status / 100
+	if class < 1 || class > 5 {
+		class = 0
+	}
+	b.statusClasses[class]++
+	b.bytes += sample.Bytes
+	b.requestTimes.add(sample.RequestTime)
+	b.upstreamTimes.add(sample.UpstreamResponseTime)
+}
+
+// stats returns the stats of the buckets in the window before now, the requests of the stats are 0 if the window is
+// empty.
+func (w *window) stats(now time.Time) *v1.TrafficStats {
+	size := int64(len(w.buckets))
+	stats := &v1.TrafficStats{
+		Time:          now,
+		WindowSeconds: size,
+		StatusClasses: make(map[string]int64),
+	}
+	var statusClasses [6]int64
+	var requestTimes, upstreamTimes []float64
+	for _, b := range w.buckets {
+		if b == nil || b.second > now.Unix() || b.second <= now.Unix()-size {
+			continue
+		}
+		stats.Requests += b.requests
+		stats.Bytes += b.bytes
+		for class, count := range b.statusClasses {
+			statusClasses[class] += count
+		}
+		requestTimes = append(requestTimes, b.requestTimes.samples...)
+		upstreamTimes = append(upstreamTimes, b.upstreamTimes.samples...)
+	}
+	for class, count := range statusClasses {
+		if count == 0 {
+			continue
+		}
+		if class == 0 {
+			stats.StatusClasses["other"] = count
+		} else {
+			stats.StatusClasses[fmt.Sprintf("%dxx", class)] = count
+		}
+	}
+	stats.RequestRate = float64(stats.Requests) / float64(size)
+	stats.RequestTime = percentiles(requestTimes)
+	stats.UpstreamResponseTime = percentiles(upstreamTimes)
+	return stats
+}
+
+// percentiles returns the nearest-rank percentiles of the latencies, or nil if there are no latencies.
+func percentiles(values []float64) *v1.LatencyPercentiles {
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Float64s(values)
+	rank := func(p float64) float64 {
+		i := int(math.Ceil(p*float64(len(values)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return values[i]
+	}
+	return &v1.LatencyPercentiles{
+		P50: rank(0.5),
+		P90: rank(0.9),
+		P99: rank(0.99),
+		Max: values[len(values)-1],
+	}
+}
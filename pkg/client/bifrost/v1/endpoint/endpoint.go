@@ -10,6 +10,13 @@ type Factory interface {
 	WebServerStatistics() epv1.WebServerStatisticsEndpoints
 	WebServerStatus() epv1.WebServerStatusEndpoints
 	WebServerLogWatcher() epv1.WebServerLogWatcherEndpoints
+	WebServerCertificate() epv1.WebServerCertificateEndpoints
+	WebServerLinter() epv1.WebServerLinterEndpoints
+	WebServerRouteSimulator() epv1.WebServerRouteSimulatorEndpoints
+	WebServerManager() epv1.WebServerManagerEndpoints
+	WebServerTemplate() epv1.WebServerTemplateEndpoints
+	WebServerReconciler() epv1.WebServerReconcilerEndpoints
+	WebServerUpstream() epv1.WebServerUpstreamEndpoints
 }
 
 type factory struct {
@@ -32,6 +39,34 @@ func (f *factory) WebServerLogWatcher() epv1.WebServerLogWatcherEndpoints {
 	return newWebServerLogWatcherEndpoints(f)
 }
 
+func (f *factory) WebServerCertificate() epv1.WebServerCertificateEndpoints {
+	return newWebServerCertificateEndpoints(f)
+}
+
+func (f *factory) WebServerLinter() epv1.WebServerLinterEndpoints {
+	return newWebServerLinterEndpoints(f)
+}
+
+func (f *factory) WebServerRouteSimulator() epv1.WebServerRouteSimulatorEndpoints {
+	return newWebServerRouteSimulatorEndpoints(f)
+}
+
+func (f *factory) WebServerManager() epv1.WebServerManagerEndpoints {
+	return newWebServerManagerEndpoints(f)
+}
+
+func (f *factory) WebServerTemplate() epv1.WebServerTemplateEndpoints {
+	return newWebServerTemplateEndpoints(f)
+}
+
+func (f *factory) WebServerReconciler() epv1.WebServerReconcilerEndpoints {
+	return newWebServerReconcilerEndpoints(f)
+}
+
+func (f *factory) WebServerUpstream() epv1.WebServerUpstreamEndpoints {
+	return newWebServerUpstreamEndpoints(f)
+}
+
 func New(transport txpclient.Factory) Factory {
 	return &factory{transport: transport}
 }
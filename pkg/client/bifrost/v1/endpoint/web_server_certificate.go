@@ -0,0 +1,23 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerCertificateEndpoints struct {
+	transport txpclient.WebServerCertificateTransport
+}
+
+func (w *webServerCertificateEndpoints) EndpointGet() endpoint.Endpoint {
+	return w.transport.Get().Endpoint()
+}
+
+func (w *webServerCertificateEndpoints) EndpointWatchExpiry() endpoint.Endpoint {
+	return w.transport.WatchExpiry().Endpoint()
+}
+
+func newWebServerCertificateEndpoints(factory *factory) epv1.WebServerCertificateEndpoints {
+	return &webServerCertificateEndpoints{transport: factory.transport.WebServerCertificate()}
+}
@@ -0,0 +1,19 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerLinterEndpoints struct {
+	transport txpclient.WebServerLinterTransport
+}
+
+func (w *webServerLinterEndpoints) EndpointLint() endpoint.Endpoint {
+	return w.transport.Lint().Endpoint()
+}
+
+func newWebServerLinterEndpoints(factory *factory) epv1.WebServerLinterEndpoints {
+	return &webServerLinterEndpoints{transport: factory.transport.WebServerLinter()}
+}
@@ -14,6 +14,22 @@ func (w *webServerLogWatcherEndpoints) EndpointWatch() endpoint.Endpoint {
 	return w.transport.Watch().Endpoint()
 }
 
+func (w *webServerLogWatcherEndpoints) EndpointQueryLogs() endpoint.Endpoint {
+	return w.transport.QueryLogs().Endpoint()
+}
+
+func (w *webServerLogWatcherEndpoints) EndpointListLogs() endpoint.Endpoint {
+	return w.transport.ListLogs().Endpoint()
+}
+
+func (w *webServerLogWatcherEndpoints) EndpointAlerts() endpoint.Endpoint {
+	return w.transport.Alerts().Endpoint()
+}
+
+func (w *webServerLogWatcherEndpoints) EndpointDownloadLog() endpoint.Endpoint {
+	return w.transport.DownloadLog().Endpoint()
+}
+
 func newWebServerLogWatcherEndpoints(factory *factory) epv1.WebServerLogWatcherEndpoints {
 	return &webServerLogWatcherEndpoints{transport: factory.transport.WebServerLogWatcher()}
 }
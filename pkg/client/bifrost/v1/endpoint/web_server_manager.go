@@ -0,0 +1,35 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerManagerEndpoints struct {
+	transport txpclient.WebServerManagerTransport
+}
+
+func (w *webServerManagerEndpoints) EndpointList() endpoint.Endpoint {
+	return w.transport.List().Endpoint()
+}
+
+func (w *webServerManagerEndpoints) EndpointRegister() endpoint.Endpoint {
+	return w.transport.Register().Endpoint()
+}
+
+func (w *webServerManagerEndpoints) EndpointReconfigure() endpoint.Endpoint {
+	return w.transport.Reconfigure().Endpoint()
+}
+
+func (w *webServerManagerEndpoints) EndpointUnregister() endpoint.Endpoint {
+	return w.transport.Unregister().Endpoint()
+}
+
+func (w *webServerManagerEndpoints) EndpointGetStates() endpoint.Endpoint {
+	return w.transport.GetStates().Endpoint()
+}
+
+func newWebServerManagerEndpoints(factory *factory) epv1.WebServerManagerEndpoints {
+	return &webServerManagerEndpoints{transport: factory.transport.WebServerManager()}
+}
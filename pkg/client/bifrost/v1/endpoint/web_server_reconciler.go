@@ -0,0 +1,23 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerReconcilerEndpoints struct {
+	transport txpclient.WebServerReconcilerTransport
+}
+
+func (w *webServerReconcilerEndpoints) EndpointPlan() endpoint.Endpoint {
+	return w.transport.Plan().Endpoint()
+}
+
+func (w *webServerReconcilerEndpoints) EndpointApply() endpoint.Endpoint {
+	return w.transport.Apply().Endpoint()
+}
+
+func newWebServerReconcilerEndpoints(factory *factory) epv1.WebServerReconcilerEndpoints {
+	return &webServerReconcilerEndpoints{transport: factory.transport.WebServerReconciler()}
+}
@@ -0,0 +1,19 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerRouteSimulatorEndpoints struct {
+	transport txpclient.WebServerRouteSimulatorTransport
+}
+
+func (w *webServerRouteSimulatorEndpoints) EndpointSimulate() endpoint.Endpoint {
+	return w.transport.Simulate().Endpoint()
+}
+
+func newWebServerRouteSimulatorEndpoints(factory *factory) epv1.WebServerRouteSimulatorEndpoints {
+	return &webServerRouteSimulatorEndpoints{transport: factory.transport.WebServerRouteSimulator()}
+}
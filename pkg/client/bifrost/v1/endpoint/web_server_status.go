@@ -14,6 +14,10 @@ func (w *webServerStatusEndpoints) EndpointGet() endpoint.Endpoint {
 	return w.transport.Get().Endpoint()
 }
 
+func (w *webServerStatusEndpoints) EndpointTrafficStats() endpoint.Endpoint {
+	return w.transport.TrafficStats().Endpoint()
+}
+
 func newWebServerStatusEndpoints(factory *factory) epv1.WebServerStatusEndpoints {
 	return &webServerStatusEndpoints{transport: factory.transport.WebServerStatus()}
 }
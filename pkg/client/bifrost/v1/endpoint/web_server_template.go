@@ -0,0 +1,23 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerTemplateEndpoints struct {
+	transport txpclient.WebServerTemplateTransport
+}
+
+func (w *webServerTemplateEndpoints) EndpointList() endpoint.Endpoint {
+	return w.transport.List().Endpoint()
+}
+
+func (w *webServerTemplateEndpoints) EndpointApply() endpoint.Endpoint {
+	return w.transport.Apply().Endpoint()
+}
+
+func newWebServerTemplateEndpoints(factory *factory) epv1.WebServerTemplateEndpoints {
+	return &webServerTemplateEndpoints{transport: factory.transport.WebServerTemplate()}
+}
@@ -0,0 +1,35 @@
+package endpoint
+
+import (
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	txpclient "github.com/ClessLi/bifrost/pkg/client/bifrost/v1/transport"
+	"github.com/go-kit/kit/endpoint"
+)
+
+type webServerUpstreamEndpoints struct {
+	transport txpclient.WebServerUpstreamTransport
+}
+
+func (w *webServerUpstreamEndpoints) EndpointList() endpoint.Endpoint {
+	return w.transport.List().Endpoint()
+}
+
+func (w *webServerUpstreamEndpoints) EndpointAddMember() endpoint.Endpoint {
+	return w.transport.AddMember().Endpoint()
+}
+
+func (w *webServerUpstreamEndpoints) EndpointRemoveMember() endpoint.Endpoint {
+	return w.transport.RemoveMember().Endpoint()
+}
+
+func (w *webServerUpstreamEndpoints) EndpointSetWeight() endpoint.Endpoint {
+	return w.transport.SetWeight().Endpoint()
+}
+
+func (w *webServerUpstreamEndpoints) EndpointSetState() endpoint.Endpoint {
+	return w.transport.SetState().Endpoint()
+}
+
+func newWebServerUpstreamEndpoints(factory *factory) epv1.WebServerUpstreamEndpoints {
+	return &webServerUpstreamEndpoints{transport: factory.transport.WebServerUpstream()}
+}
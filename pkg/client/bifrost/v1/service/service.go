@@ -12,6 +12,13 @@ type Factory interface {
 	WebServerStatistics() WebServerStatisticsService
 	WebServerStatus() WebServerStatusService
 	WebServerLogWatcher() WebServerLogWatcherService
+	WebServerCertificate() WebServerCertificateService
+	WebServerLinter() WebServerLinterService
+	WebServerRouteSimulator() WebServerRouteSimulatorService
+	WebServerManager() WebServerManagerService
+	WebServerTemplate() WebServerTemplateService
+	WebServerReconciler() WebServerReconcilerService
+	WebServerUpstream() WebServerUpstreamService
 }
 
 type factory struct {
@@ -34,6 +41,34 @@ func (f *factory) WebServerLogWatcher() WebServerLogWatcherService {
 	return newWebServerLogWatcherService(f)
 }
 
+func (f *factory) WebServerCertificate() WebServerCertificateService {
+	return newWebServerCertificateService(f)
+}
+
+func (f *factory) WebServerLinter() WebServerLinterService {
+	return newWebServerLinterService(f)
+}
+
+func (f *factory) WebServerRouteSimulator() WebServerRouteSimulatorService {
+	return newWebServerRouteSimulatorService(f)
+}
+
+func (f *factory) WebServerManager() WebServerManagerService {
+	return newWebServerManagerService(f)
+}
+
+func (f *factory) WebServerTemplate() WebServerTemplateService {
+	return newWebServerTemplateService(f)
+}
+
+func (f *factory) WebServerReconciler() WebServerReconcilerService {
+	return newWebServerReconcilerService(f)
+}
+
+func (f *factory) WebServerUpstream() WebServerUpstreamService {
+	return newWebServerUpstreamService(f)
+}
+
 func New(endpoint epclient.Factory) Factory {
 	return &factory{eps: endpoint}
 }
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"time"
+)
+
+type WebServerCertificateService interface {
+	Get(servername string) (*v1.Certificates, error)
+	WatchExpiry(servername string, expiryWarning time.Duration) (<-chan *v1.CertificateWarning, context.CancelFunc, error)
+}
+
+type webServerCertificateService struct {
+	eps epv1.WebServerCertificateEndpoints
+}
+
+func (w *webServerCertificateService) Get(servername string) (*v1.Certificates, error) {
+	resp, err := w.eps.EndpointGet()(GetContext(), &v1.ServerName{Name: servername})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.Certificates), nil
+}
+
+// WatchExpiry watches the certificate expiry warnings of the web server, or all web servers if the servername is empty.
+// The server side default threshold is used, if the expiryWarning is not greater than 0.
+func (w *webServerCertificateService) WatchExpiry(servername string, expiryWarning time.Duration) (<-chan *v1.CertificateWarning, context.CancelFunc, error) {
+	reqCtx, cancel := context.WithCancel(GetContext())
+	resp, err := w.eps.EndpointWatchExpiry()(reqCtx, &v1.WebServerCertificateWatchRequest{
+		ServerName:    &v1.ServerName{Name: servername},
+		ExpiryWarning: expiryWarning,
+	})
+	if err != nil {
+		cancel()
+		return nil, cancel, err
+	}
+	return resp.(*v1.WebServerCertificateWarnings).Warnings, cancel, nil
+}
+
+func newWebServerCertificateService(factory *factory) WebServerCertificateService {
+	return &webServerCertificateService{eps: factory.eps.WebServerCertificate()}
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+)
+
+type WebServerLinterService interface {
+	Lint(servername string) (*v1.LintReport, error)
+}
+
+type webServerLinterService struct {
+	eps epv1.WebServerLinterEndpoints
+}
+
+func (w *webServerLinterService) Lint(servername string) (*v1.LintReport, error) {
+	resp, err := w.eps.EndpointLint()(GetContext(), &v1.ServerName{Name: servername})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.LintReport), nil
+}
+
+func newWebServerLinterService(factory *factory) WebServerLinterService {
+	return &webServerLinterService{eps: factory.eps.WebServerLinter()}
+}
@@ -4,10 +4,22 @@ import (
 	"context"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/log_download"
+	"github.com/marmotedu/errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 )
 
 type WebServerLogWatcherService interface {
 	Watch(request *v1.WebServerLogWatchRequest) (<-chan []byte, context.CancelFunc, error)
+	Subscribe(request *v1.WebServerLogWatchRequest) (*v1.WebServerLog, context.CancelFunc, error)
+	QueryLogs(request *v1.WebServerLogQueryRequest) (<-chan []byte, context.CancelFunc, error)
+	ListLogs(servername string) ([]*v1.LogFile, error)
+	Alerts(servername, rule string) (<-chan *v1.LogAlert, context.CancelFunc, error)
+	DownloadLog(request *v1.WebServerLogDownloadRequest, w io.Writer) (*v1.LogDownload, error)
+	SaveLog(request *v1.WebServerLogDownloadRequest, dir string) (*v1.LogDownload, error)
 }
 
 type webServerLogWatcherService struct {
@@ -24,6 +36,118 @@ func (w *webServerLogWatcherService) Watch(request *v1.WebServerLogWatchRequest)
 	return resp.(*v1.WebServerLog).Lines, cancel, nil
 }
 
+// Subscribe watches the log as Watch does, and the returned log reports the number of the lines dropped by bifrost
+// since the client fell behind, see the `web-server-log-watcher.slow-consumer-policy` option. The watching can be
+// resumed by the request with the Cursor of the returned log after reconnecting.
+func (w *webServerLogWatcherService) Subscribe(request *v1.WebServerLogWatchRequest) (*v1.WebServerLog, context.CancelFunc, error) {
+	reqCtx, cancel := context.WithCancel(GetContext())
+	resp, err := w.eps.EndpointWatch()(reqCtx, request)
+	if err != nil {
+		cancel()
+		return nil, cancel, err
+	}
+	return resp.(*v1.WebServerLog), cancel, nil
+}
+
+// QueryLogs returns the chunks of the queried lines, the channel is closed after the last chunk is received.
+func (w *webServerLogWatcherService) QueryLogs(request *v1.WebServerLogQueryRequest) (<-chan []byte, context.CancelFunc, error) {
+	reqCtx, cancel := context.WithCancel(GetContext())
+	resp, err := w.eps.EndpointQueryLogs()(reqCtx, request)
+	if err != nil {
+		cancel()
+		return nil, cancel, err
+	}
+	return resp.(*v1.WebServerLog).Lines, cancel, nil
+}
+
+// ListLogs lists the logs of the web server, which can be watched and queried by their names.
+func (w *webServerLogWatcherService) ListLogs(servername string) ([]*v1.LogFile, error) {
+	resp, err := w.eps.EndpointListLogs()(GetContext(), &v1.ServerName{Name: servername})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.LogFiles).List, nil
+}
+
+// Alerts watches the alerts fired by the log alert rule on the web server, the alerts of all the web servers or all the
+// rules are sent if the servername or the rule is empty.
+func (w *webServerLogWatcherService) Alerts(servername, rule string) (<-chan *v1.LogAlert, context.CancelFunc, error) {
+	reqCtx, cancel := context.WithCancel(GetContext())
+	resp, err := w.eps.EndpointAlerts()(reqCtx, &v1.WebServerLogAlertsRequest{
+		ServerName: &v1.ServerName{Name: servername},
+		Rule:       rule,
+	})
+	if err != nil {
+		cancel()
+		return nil, cancel, err
+	}
+	return resp.(*v1.WebServerLogAlerts).Alerts, cancel, nil
+}
+
+// DownloadLog downloads the log of the web server, or the tar.gz archive of the logs and the current config, into the
+// writer, and verifies the checksum of the whole file.
+func (w *webServerLogWatcherService) DownloadLog(request *v1.WebServerLogDownloadRequest, writer io.Writer) (*v1.LogDownload, error) {
+	return w.download(request, func(string) (io.Writer, error) { return writer, nil })
+}
+
+// SaveLog downloads the file as DownloadLog does, and saves it in the dir by the name of the downloaded file. The file
+// is written to a `.part` file first, which is removed if the download fails, so that a saved file is always complete.
+func (w *webServerLogWatcherService) SaveLog(request *v1.WebServerLogDownloadRequest, dir string) (*v1.LogDownload, error) {
+	var part *os.File
+	download, err := w.download(request, func(fileName string) (io.Writer, error) {
+		var err error
+		part, err = ioutil.TempFile(dir, "."+filepath.Base(fileName)+".*.part")
+		return part, err
+	})
+	if part == nil {
+		return nil, err
+	}
+	if closeErr := part.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(part.Name())
+		return nil, err
+	}
+	download.Path = filepath.Join(dir, filepath.Base(download.FileName))
+	if err = os.Rename(part.Name(), download.Path); err != nil {
+		os.Remove(part.Name())
+		return nil, err
+	}
+	return download, nil
+}
+
+// download writes the chunks of the downloaded file into the writer opened by the file name.
+func (w *webServerLogWatcherService) download(request *v1.WebServerLogDownloadRequest, open func(fileName string) (io.Writer, error)) (*v1.LogDownload, error) {
+	reqCtx, cancel := context.WithCancel(GetContext())
+	defer cancel()
+	resp, err := w.eps.EndpointDownloadLog()(reqCtx, request)
+	if err != nil {
+		return nil, err
+	}
+	download := resp.(*v1.WebServerLogDownload)
+	writer, err := open(download.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := log_download.NewChecksum()
+	out := io.MultiWriter(writer, checksum)
+	for chunk := range download.Chunks {
+		if _, err = out.Write(chunk); err != nil {
+			return nil, err
+		}
+	}
+	if err = download.Err(); err != nil {
+		return nil, err
+	}
+	if err = checksum.Verify(download.Checksum()); err != nil {
+		return nil, errors.Wrapf(err, "failed to download '%s'", download.FileName)
+	}
+	return &v1.LogDownload{FileName: download.FileName, Size: checksum.Size(), Checksum: checksum.String()}, nil
+}
+
 func newWebServerLogWatcherService(factory *factory) WebServerLogWatcherService {
 	return &webServerLogWatcherService{eps: factory.eps.WebServerLogWatcher()}
 }
@@ -0,0 +1,68 @@
+package service
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type WebServerManagerService interface {
+	List() ([]*v1.ManagedWebServer, error)
+	Register(server *v1.ManagedWebServer) error
+	Reconfigure(server *v1.ManagedWebServer) error
+	Unregister(servername string) error
+	GetStates() ([]*v1.ConfigManagerState, error)
+}
+
+type webServerManagerService struct {
+	eps epv1.WebServerManagerEndpoints
+}
+
+func (w *webServerManagerService) List() ([]*v1.ManagedWebServer, error) {
+	resp, err := w.eps.EndpointList()(GetContext(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.ManagedWebServers).Servers, nil
+}
+
+func (w *webServerManagerService) Register(server *v1.ManagedWebServer) error {
+	resp, err := w.eps.EndpointRegister()(GetContext(), server)
+	if err != nil {
+		return err
+	}
+	log.Infof("Register result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func (w *webServerManagerService) Reconfigure(server *v1.ManagedWebServer) error {
+	resp, err := w.eps.EndpointReconfigure()(GetContext(), server)
+	if err != nil {
+		return err
+	}
+	log.Infof("Reconfigure result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func (w *webServerManagerService) Unregister(servername string) error {
+	resp, err := w.eps.EndpointUnregister()(GetContext(), &v1.ServerName{Name: servername})
+	if err != nil {
+		return err
+	}
+	log.Infof("Unregister result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func (w *webServerManagerService) GetStates() ([]*v1.ConfigManagerState, error) {
+	resp, err := w.eps.EndpointGetStates()(GetContext(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.ConfigManagerStates).States, nil
+}
+
+func newWebServerManagerService(factory *factory) WebServerManagerService {
+	return &webServerManagerService{eps: factory.eps.WebServerManager()}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+)
+
+type WebServerReconcilerService interface {
+	Plan() ([]*v1.ReconcilePlan, error)
+	Apply() ([]*v1.ReconcilePlan, error)
+}
+
+type webServerReconcilerService struct {
+	eps epv1.WebServerReconcilerEndpoints
+}
+
+func (w *webServerReconcilerService) Plan() ([]*v1.ReconcilePlan, error) {
+	resp, err := w.eps.EndpointPlan()(GetContext(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.ReconcilePlans).Plans, nil
+}
+
+func (w *webServerReconcilerService) Apply() ([]*v1.ReconcilePlan, error) {
+	resp, err := w.eps.EndpointApply()(GetContext(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.ReconcilePlans).Plans, nil
+}
+
+func newWebServerReconcilerService(factory *factory) WebServerReconcilerService {
+	return &webServerReconcilerService{eps: factory.eps.WebServerReconciler()}
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+)
+
+type WebServerRouteSimulatorService interface {
+	Simulate(request *v1.RouteRequest) (*v1.RouteResult, error)
+}
+
+type webServerRouteSimulatorService struct {
+	eps epv1.WebServerRouteSimulatorEndpoints
+}
+
+func (w *webServerRouteSimulatorService) Simulate(request *v1.RouteRequest) (*v1.RouteResult, error) {
+	resp, err := w.eps.EndpointSimulate()(GetContext(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.RouteResult), nil
+}
+
+func newWebServerRouteSimulatorService(factory *factory) WebServerRouteSimulatorService {
+	return &webServerRouteSimulatorService{eps: factory.eps.WebServerRouteSimulator()}
+}
@@ -1,13 +1,16 @@
 package service
 
 import (
+	"context"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	"time"
 )
 
 type WebServerStatusService interface {
 	Get() (*v1.Metrics, error)
+	TrafficStats(servername string, interval time.Duration) (<-chan *v1.TrafficStats, context.CancelFunc, error)
 }
 
 type webServerStatusService struct {
@@ -23,6 +26,21 @@ func (w *webServerStatusService) Get() (*v1.Metrics, error) {
 	return resp.(*v1.Metrics), nil
 }
 
+// TrafficStats watches the traffic stats of the web server, or all web servers if the servername is empty, which are
+// sent at the interval. The server side default interval is used, if the interval is not greater than 0.
+func (w *webServerStatusService) TrafficStats(servername string, interval time.Duration) (<-chan *v1.TrafficStats, context.CancelFunc, error) {
+	reqCtx, cancel := context.WithCancel(GetContext())
+	resp, err := w.eps.EndpointTrafficStats()(reqCtx, &v1.WebServerTrafficStatsRequest{
+		ServerName: &v1.ServerName{Name: servername},
+		Interval:   interval,
+	})
+	if err != nil {
+		cancel()
+		return nil, cancel, err
+	}
+	return resp.(*v1.WebServerTrafficStats).Stats, cancel, nil
+}
+
 func newWebServerStatusService(factory *factory) WebServerStatusService {
 	return &webServerStatusService{eps: factory.eps.WebServerStatus()}
 }
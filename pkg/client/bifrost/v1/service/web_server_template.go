@@ -0,0 +1,37 @@
+package service
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+)
+
+type WebServerTemplateService interface {
+	List() ([]*v1.WebServerTemplate, error)
+	Apply(request *v1.WebServerTemplateRequest) (*v1.WebServerTemplateResult, error)
+}
+
+type webServerTemplateService struct {
+	eps epv1.WebServerTemplateEndpoints
+}
+
+func (w *webServerTemplateService) List() ([]*v1.WebServerTemplate, error) {
+	resp, err := w.eps.EndpointList()(GetContext(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.WebServerTemplates).Templates, nil
+}
+
+func (w *webServerTemplateService) Apply(request *v1.WebServerTemplateRequest) (*v1.WebServerTemplateResult, error) {
+	resp, err := w.eps.EndpointApply()(GetContext(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.WebServerTemplateResult), nil
+}
+
+func newWebServerTemplateService(factory *factory) WebServerTemplateService {
+	return &webServerTemplateService{eps: factory.eps.WebServerTemplate()}
+}
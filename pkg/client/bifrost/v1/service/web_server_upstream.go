@@ -0,0 +1,68 @@
+package service
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	epv1 "github.com/ClessLi/bifrost/internal/bifrost/endpoint/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+)
+
+type WebServerUpstreamService interface {
+	List(servername string) ([]*v1.Upstream, error)
+	AddMember(request *v1.UpstreamMemberRequest) error
+	RemoveMember(request *v1.UpstreamMemberRequest) error
+	SetWeight(request *v1.UpstreamMemberRequest) error
+	SetState(request *v1.UpstreamMemberRequest) error
+}
+
+type webServerUpstreamService struct {
+	eps epv1.WebServerUpstreamEndpoints
+}
+
+func (w *webServerUpstreamService) List(servername string) ([]*v1.Upstream, error) {
+	resp, err := w.eps.EndpointList()(GetContext(), &v1.ServerName{Name: servername})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*v1.Upstreams).Upstreams, nil
+}
+
+func (w *webServerUpstreamService) AddMember(request *v1.UpstreamMemberRequest) error {
+	resp, err := w.eps.EndpointAddMember()(GetContext(), request)
+	if err != nil {
+		return err
+	}
+	log.Infof("AddMember result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func (w *webServerUpstreamService) RemoveMember(request *v1.UpstreamMemberRequest) error {
+	resp, err := w.eps.EndpointRemoveMember()(GetContext(), request)
+	if err != nil {
+		return err
+	}
+	log.Infof("RemoveMember result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func (w *webServerUpstreamService) SetWeight(request *v1.UpstreamMemberRequest) error {
+	resp, err := w.eps.EndpointSetWeight()(GetContext(), request)
+	if err != nil {
+		return err
+	}
+	log.Infof("SetWeight result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func (w *webServerUpstreamService) SetState(request *v1.UpstreamMemberRequest) error {
+	resp, err := w.eps.EndpointSetState()(GetContext(), request)
+	if err != nil {
+		return err
+	}
+	log.Infof("SetState result: %s", resp.(*v1.Response).Message)
+	return nil
+}
+
+func newWebServerUpstreamService(factory *factory) WebServerUpstreamService {
+	return &webServerUpstreamService{eps: factory.eps.WebServerUpstream()}
+}
@@ -12,6 +12,13 @@ type Factory interface {
 	WebServerStatistics() Decoder
 	WebServerStatus() Decoder
 	WebServerLogWatcher() Decoder
+	WebServerCertificate() Decoder
+	WebServerLinter() Decoder
+	WebServerRouteSimulator() Decoder
+	WebServerManager() Decoder
+	WebServerTemplate() Decoder
+	WebServerReconciler() Decoder
+	WebServerUpstream() Decoder
 }
 
 type factory struct{}
@@ -32,6 +39,34 @@ func (f factory) WebServerLogWatcher() Decoder {
 	return new(webServerLogWatcher)
 }
 
+func (f factory) WebServerCertificate() Decoder {
+	return new(webServerCertificate)
+}
+
+func (f factory) WebServerLinter() Decoder {
+	return new(webServerLinter)
+}
+
+func (f factory) WebServerRouteSimulator() Decoder {
+	return new(webServerRouteSimulator)
+}
+
+func (f factory) WebServerManager() Decoder {
+	return new(webServerManager)
+}
+
+func (f factory) WebServerTemplate() Decoder {
+	return new(webServerTemplate)
+}
+
+func (f factory) WebServerReconciler() Decoder {
+	return new(webServerReconciler)
+}
+
+func (f factory) WebServerUpstream() Decoder {
+	return new(webServerUpstream)
+}
+
 var _ Factory = factory{}
 
 func New() Factory {
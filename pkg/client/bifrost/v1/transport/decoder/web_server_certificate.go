@@ -0,0 +1,26 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerCertificate struct{}
+
+func (w webServerCertificate) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.Certificates: // decode `Get` response
+		certs := new(v1.Certificates)
+		err := json.Unmarshal(resp.GetJsonData(), certs)
+		return certs, err
+	case *v1.WebServerCertificateWarnings: // return a warnings channel structure(point) from WatchExpiry endpoint, not a *pbv1.Response
+		return resp, nil
+	default:
+		return nil, errors.Errorf("invalid web server certificate response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerCertificate{}
@@ -0,0 +1,24 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerLinter struct{}
+
+func (w webServerLinter) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.LintReport: // decode `Lint` response
+		report := new(v1.LintReport)
+		err := json.Unmarshal(resp.GetJsonData(), report)
+		return report, err
+	default:
+		return nil, errors.Errorf("invalid web server linter response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerLinter{}
@@ -2,7 +2,9 @@ package decoder
 
 import (
 	"context"
+	"encoding/json"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/marmotedu/errors"
 )
 
@@ -12,6 +14,14 @@ func (w webServerLogWatcher) DecodeResponse(ctx context.Context, resp interface{
 	switch resp := resp.(type) {
 	case *v1.WebServerLog: // return a bytes channel structure(point) *v1.WebServerLog from Watch endpoint, not a *pbv1.Response
 		return resp, nil
+	case *pbv1.LogFiles: // decode `ListLogs` response
+		logs := new(v1.LogFiles)
+		err := json.Unmarshal(resp.GetJsonData(), logs)
+		return logs, err
+	case *v1.WebServerLogAlerts: // return an alerts channel structure(point) from Alerts endpoint, not a *pbv1.Response
+		return resp, nil
+	case *v1.WebServerLogDownload: // return a chunks channel structure(point) from DownloadLog endpoint, not a *pbv1.LogChunk
+		return resp, nil
 	default:
 		return nil, errors.Errorf("invalid web server log watcher response: %v", resp)
 	}
@@ -0,0 +1,50 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+	"time"
+)
+
+type webServerManager struct{}
+
+func (w webServerManager) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.ManagedWebServers: // decode `List` response
+		servers := &v1.ManagedWebServers{Servers: make([]*v1.ManagedWebServer, 0, len(resp.GetServers()))}
+		for _, server := range resp.GetServers() {
+			servers.Servers = append(servers.Servers, &v1.ManagedWebServer{
+				ServerName:     server.GetServerName(),
+				ServerType:     server.GetServerType(),
+				ConfigPath:     server.GetConfigPath(),
+				VerifyExecPath: server.GetVerifyExecPath(),
+				LogsDirPath:    server.GetLogsDirPath(),
+				BackupDir:      server.GetBackupDir(),
+				BackupCycle:    int(server.GetBackupCycle()),
+				BackupSaveTime: int(server.GetBackupSaveTime()),
+				ReloadInterval: time.Duration(server.GetReloadInterval()),
+				ReloadDebounce: time.Duration(server.GetReloadDebounce()),
+				SaveInterval:   time.Duration(server.GetSaveInterval()),
+				ConflictPolicy: server.GetConflictPolicy(),
+				FileMode:       server.GetFileMode(),
+				Prefix:         server.GetPrefix(),
+				ExtraArgs:      server.GetExtraArgs(),
+				LintRules:      server.GetLintRules(),
+			})
+		}
+		return servers, nil
+	case *pbv1.ConfigManagerStates: // decode `GetStates` response
+		states := new(v1.ConfigManagerStates)
+		err := json.Unmarshal(resp.GetJsonData(), states)
+		return states, err
+	case *pbv1.Response: // decode `Register`, `Reconfigure` and `Unregister` response
+		return &v1.Response{Message: string(resp.GetMsg())}, nil
+	default:
+		return nil, errors.Errorf("invalid web server manager response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerManager{}
@@ -0,0 +1,24 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerReconciler struct{}
+
+func (w webServerReconciler) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.ReconcilePlans: // decode `Plan` and `Apply` response
+		plans := new(v1.ReconcilePlans)
+		err := json.Unmarshal(resp.GetJsonData(), plans)
+		return plans, err
+	default:
+		return nil, errors.Errorf("invalid web server reconciler response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerReconciler{}
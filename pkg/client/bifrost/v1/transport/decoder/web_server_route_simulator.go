@@ -0,0 +1,24 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerRouteSimulator struct{}
+
+func (w webServerRouteSimulator) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.RouteResult: // decode `Simulate` response
+		result := new(v1.RouteResult)
+		err := json.Unmarshal(resp.GetJsonData(), result)
+		return result, err
+	default:
+		return nil, errors.Errorf("invalid web server route simulator response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerRouteSimulator{}
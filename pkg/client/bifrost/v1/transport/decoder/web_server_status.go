@@ -12,10 +12,12 @@ type webServerStatus struct{}
 
 func (w webServerStatus) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
 	switch resp := resp.(type) {
-	case *pbv1.Metrics:
+	case *pbv1.Metrics: // decode `Get` response
 		metrics := new(v1.Metrics)
 		err := json.Unmarshal(resp.GetJsonData(), metrics)
 		return metrics, err
+	case *v1.WebServerTrafficStats: // return a stats channel structure(point) from TrafficStats endpoint, not a *pbv1.Response
+		return resp, nil
 	default:
 		return nil, errors.Errorf("invalid web server status response: %v", resp)
 	}
@@ -0,0 +1,28 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerTemplate struct{}
+
+func (w webServerTemplate) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.Templates: // decode `List` response
+		templates := new(v1.WebServerTemplates)
+		err := json.Unmarshal(resp.GetJsonData(), templates)
+		return templates, err
+	case *pbv1.TemplateApplyResult: // decode `Apply` response
+		result := new(v1.WebServerTemplateResult)
+		err := json.Unmarshal(resp.GetJsonData(), result)
+		return result, err
+	default:
+		return nil, errors.Errorf("invalid web server template response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerTemplate{}
@@ -0,0 +1,26 @@
+package decoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerUpstream struct{}
+
+func (w webServerUpstream) DecodeResponse(ctx context.Context, resp interface{}) (interface{}, error) {
+	switch resp := resp.(type) {
+	case *pbv1.Upstreams: // decode `List` response
+		upstreams := new(v1.Upstreams)
+		err := json.Unmarshal(resp.GetJsonData(), upstreams)
+		return upstreams, err
+	case *pbv1.Response: // decode `AddMember`, `RemoveMember`, `SetWeight` and `SetState` response
+		return &v1.Response{Message: string(resp.GetMsg())}, nil
+	default:
+		return nil, errors.Errorf("invalid web server upstream response: %v", resp)
+	}
+}
+
+var _ Decoder = webServerUpstream{}
@@ -12,6 +12,13 @@ type Factory interface {
 	WebServerStatistics() Encoder
 	WebServerStatus() Encoder
 	WebServerLogWatcher() Encoder
+	WebServerCertificate() Encoder
+	WebServerLinter() Encoder
+	WebServerRouteSimulator() Encoder
+	WebServerManager() Encoder
+	WebServerTemplate() Encoder
+	WebServerReconciler() Encoder
+	WebServerUpstream() Encoder
 }
 
 type factory struct{}
@@ -32,6 +39,34 @@ func (f factory) WebServerLogWatcher() Encoder {
 	return new(webServerLogWatcher)
 }
 
+func (f factory) WebServerCertificate() Encoder {
+	return new(webServerCertificate)
+}
+
+func (f factory) WebServerLinter() Encoder {
+	return new(webServerLinter)
+}
+
+func (f factory) WebServerRouteSimulator() Encoder {
+	return new(webServerRouteSimulator)
+}
+
+func (f factory) WebServerManager() Encoder {
+	return new(webServerManager)
+}
+
+func (f factory) WebServerTemplate() Encoder {
+	return new(webServerTemplate)
+}
+
+func (f factory) WebServerReconciler() Encoder {
+	return new(webServerReconciler)
+}
+
+func (f factory) WebServerUpstream() Encoder {
+	return new(webServerUpstream)
+}
+
 var _ Factory = factory{}
 
 func New() Factory {
@@ -0,0 +1,28 @@
+package encoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+	"time"
+)
+
+type webServerCertificate struct{}
+
+func (w webServerCertificate) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case *v1.ServerName: // encode `Get` request
+		return &pbv1.ServerName{Name: req.Name}, nil
+	case *v1.WebServerCertificateWatchRequest: // encode `WatchExpiry` request
+		pbreq := &pbv1.CertificateWatchRequest{ExpiryWarningSeconds: int64(req.ExpiryWarning / time.Second)}
+		if req.ServerName != nil {
+			pbreq.ServerName = req.ServerName.Name
+		}
+		return pbreq, nil
+	default:
+		return nil, errors.Errorf("invalid web server certificate request: %v", req)
+	}
+}
+
+var _ Encoder = webServerCertificate{}
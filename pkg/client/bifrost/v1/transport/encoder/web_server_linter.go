@@ -0,0 +1,21 @@
+package encoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerLinter struct{}
+
+func (w webServerLinter) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case *v1.ServerName: // encode `Lint` request
+		return &pbv1.ServerName{Name: req.Name}, nil
+	default:
+		return nil, errors.Errorf("invalid web server linter request: %v", req)
+	}
+}
+
+var _ Encoder = webServerLinter{}
@@ -5,6 +5,7 @@ import (
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/marmotedu/errors"
+	"time"
 )
 
 type webServerLogWatcher struct{}
@@ -13,10 +14,49 @@ func (w webServerLogWatcher) EncodeRequest(ctx context.Context, req interface{})
 	switch req := req.(type) {
 	case *v1.WebServerLogWatchRequest: // encode `Watch` request
 		return &pbv1.LogWatchRequest{
-			ServerName: req.ServerName.Name,
-			LogName:    req.LogName,
-			FilterRule: req.FilteringRegexpRule,
+			ServerName:         req.ServerName.Name,
+			LogName:            req.LogName,
+			LogNames:           req.LogNames,
+			FilterRule:         req.FilteringRegexpRule,
+			FieldFilterRule:    req.FilteringFieldRule,
+			Structured:         req.Structured,
+			MinSeverity:        req.MinSeverity,
+			GroupWindowSeconds: int64(req.GroupWindow / time.Second),
+			UpstreamEvents:     req.UpstreamEvents,
+			Cursor:             req.Cursor,
 		}, nil
+	case *v1.WebServerLogQueryRequest: // encode `QueryLogs` request
+		r := &pbv1.LogQueryRequest{
+			ServerName:      req.ServerName.Name,
+			LogName:         req.LogName,
+			FilterRule:      req.FilteringRegexpRule,
+			Offset:          req.Offset,
+			Limit:           int32(req.Limit),
+			Reverse:         req.Reverse,
+			FieldFilterRule: req.FilteringFieldRule,
+			Structured:      req.Structured,
+		}
+		if !req.Since.IsZero() {
+			r.Since = req.Since.UnixNano()
+		}
+		if !req.Until.IsZero() {
+			r.Until = req.Until.UnixNano()
+		}
+		return r, nil
+	case *v1.ServerName: // encode `ListLogs` request
+		return &pbv1.ServerName{Name: req.Name}, nil
+	case *v1.WebServerLogAlertsRequest: // encode `Alerts` request
+		r := &pbv1.LogAlertsRequest{Rule: req.Rule}
+		if req.ServerName != nil {
+			r.ServerName = req.ServerName.Name
+		}
+		return r, nil
+	case *v1.WebServerLogDownloadRequest: // encode `DownloadLog` request
+		r := &pbv1.LogDownloadRequest{LogNames: req.LogNames, Archive: req.Archive}
+		if req.ServerName != nil {
+			r.ServerName = req.ServerName.Name
+		}
+		return r, nil
 	default:
 		return nil, errors.Errorf("invalid web server log watcher request: %v", req)
 	}
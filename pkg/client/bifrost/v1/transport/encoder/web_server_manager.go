@@ -0,0 +1,42 @@
+package encoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerManager struct{}
+
+func (w webServerManager) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case nil: // encode `List` and `GetStates` request
+		return &pbv1.Null{}, nil
+	case *v1.ManagedWebServer: // encode `Register` and `Reconfigure` request
+		return &pbv1.ManagedWebServer{
+			ServerName:     req.ServerName,
+			ServerType:     req.ServerType,
+			ConfigPath:     req.ConfigPath,
+			VerifyExecPath: req.VerifyExecPath,
+			LogsDirPath:    req.LogsDirPath,
+			BackupDir:      req.BackupDir,
+			BackupCycle:    int32(req.BackupCycle),
+			BackupSaveTime: int32(req.BackupSaveTime),
+			ReloadInterval: int64(req.ReloadInterval),
+			ReloadDebounce: int64(req.ReloadDebounce),
+			SaveInterval:   int64(req.SaveInterval),
+			ConflictPolicy: req.ConflictPolicy,
+			FileMode:       req.FileMode,
+			Prefix:         req.Prefix,
+			ExtraArgs:      req.ExtraArgs,
+			LintRules:      req.LintRules,
+		}, nil
+	case *v1.ServerName: // encode `Unregister` request
+		return &pbv1.ServerName{Name: req.Name}, nil
+	default:
+		return nil, errors.Errorf("invalid web server manager request: %v", req)
+	}
+}
+
+var _ Encoder = webServerManager{}
@@ -0,0 +1,20 @@
+package encoder
+
+import (
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerReconciler struct{}
+
+func (w webServerReconciler) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case nil: // encode `Plan` and `Apply` request
+		return &pbv1.Null{}, nil
+	default:
+		return nil, errors.Errorf("invalid web server reconciler request: %v", req)
+	}
+}
+
+var _ Encoder = webServerReconciler{}
@@ -0,0 +1,27 @@
+package encoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerRouteSimulator struct{}
+
+func (w webServerRouteSimulator) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case *v1.RouteRequest: // encode `Simulate` request
+		return &pbv1.RouteRequest{
+			ServerName: req.ServerName.Name,
+			Host:       req.Host,
+			Port:       int32(req.Port),
+			Scheme:     req.Scheme,
+			URI:        req.URI,
+		}, nil
+	default:
+		return nil, errors.Errorf("invalid web server route simulator request: %v", req)
+	}
+}
+
+var _ Encoder = webServerRouteSimulator{}
@@ -2,16 +2,24 @@ package encoder
 
 import (
 	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
 	"github.com/marmotedu/errors"
+	"time"
 )
 
 type webServerStatus struct{}
 
 func (w webServerStatus) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
 	switch req := req.(type) {
-	case *pbv1.Null:
+	case *pbv1.Null: // encode `Get` request
 		return req, nil
+	case *v1.WebServerTrafficStatsRequest: // encode `TrafficStats` request
+		pbreq := &pbv1.TrafficStatsRequest{IntervalSeconds: int64(req.Interval / time.Second)}
+		if req.ServerName != nil {
+			pbreq.ServerName = req.ServerName.Name
+		}
+		return pbreq, nil
 	default:
 		return nil, errors.Errorf("invalid web server status request: %v", req)
 	}
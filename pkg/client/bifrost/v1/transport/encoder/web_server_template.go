@@ -0,0 +1,29 @@
+package encoder
+
+import (
+	"context"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerTemplate struct{}
+
+func (w webServerTemplate) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case nil: // encode `List` request
+		return &pbv1.Null{}, nil
+	case *v1.WebServerTemplateRequest: // encode `Apply` request
+		return &pbv1.TemplateApplyRequest{
+			ServerName:   req.ServerName,
+			TemplateName: req.TemplateName,
+			Params:       req.Params,
+			Target:       req.Target,
+			DryRun:       req.DryRun,
+		}, nil
+	default:
+		return nil, errors.Errorf("invalid web server template request: %v", req)
+	}
+}
+
+var _ Encoder = webServerTemplate{}
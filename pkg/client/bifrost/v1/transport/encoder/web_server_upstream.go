@@ -0,0 +1,33 @@
+package encoder
+
+import (
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	"github.com/marmotedu/errors"
+)
+
+type webServerUpstream struct{}
+
+func (w webServerUpstream) EncodeRequest(ctx context.Context, req interface{}) (interface{}, error) {
+	switch req := req.(type) {
+	case *v1.ServerName: // encode `List` request
+		return &pbv1.ServerName{Name: req.Name}, nil
+	case *v1.UpstreamMemberRequest: // encode `AddMember`, `RemoveMember`, `SetWeight` and `SetState` request
+		member, err := json.Marshal(req.Member)
+		if err != nil {
+			return nil, err
+		}
+		return &pbv1.UpstreamMemberRequest{
+			ServerName: req.ServerName,
+			Upstream:   req.Upstream,
+			Member:     member,
+			Reload:     req.Reload,
+		}, nil
+	default:
+		return nil, errors.Errorf("invalid web server upstream request: %v", req)
+	}
+}
+
+var _ Encoder = webServerUpstream{}
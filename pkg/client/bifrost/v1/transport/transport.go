@@ -32,6 +32,13 @@ type Factory interface {
 	WebServerStatistics() WebServerStatisticsTransport
 	WebServerStatus() WebServerStatusTransport
 	WebServerLogWatcher() WebServerLogWatcherTransport
+	WebServerCertificate() WebServerCertificateTransport
+	WebServerLinter() WebServerLinterTransport
+	WebServerRouteSimulator() WebServerRouteSimulatorTransport
+	WebServerManager() WebServerManagerTransport
+	WebServerTemplate() WebServerTemplateTransport
+	WebServerReconciler() WebServerReconcilerTransport
+	WebServerUpstream() WebServerUpstreamTransport
 }
 
 var _ Factory = &transport{}
@@ -41,14 +48,28 @@ type transport struct {
 	decoderFactory decoder.Factory
 	encoderFactory encoder.Factory
 
-	onceWebServerConfig     sync.Once
-	onceWebServerStatistics sync.Once
-	onceWebServerStatus     sync.Once
-	onceWebServerLogWatcher sync.Once
-	singletonWSCTXP         WebServerConfigTransport
-	singletonWSSTXP         WebServerStatisticsTransport
-	singletonWSStatusTXP    WebServerStatusTransport
-	singletonWSLWTXP        WebServerLogWatcherTransport
+	onceWebServerConfig         sync.Once
+	onceWebServerStatistics     sync.Once
+	onceWebServerStatus         sync.Once
+	onceWebServerLogWatcher     sync.Once
+	onceWebServerCertificate    sync.Once
+	onceWebServerLinter         sync.Once
+	onceWebServerRouteSimulator sync.Once
+	onceWebServerManager        sync.Once
+	onceWebServerTemplate       sync.Once
+	onceWebServerReconciler     sync.Once
+	onceWebServerUpstream       sync.Once
+	singletonWSCTXP             WebServerConfigTransport
+	singletonWSSTXP             WebServerStatisticsTransport
+	singletonWSStatusTXP        WebServerStatusTransport
+	singletonWSLWTXP            WebServerLogWatcherTransport
+	singletonWSCertTXP          WebServerCertificateTransport
+	singletonWSLintTXP          WebServerLinterTransport
+	singletonWSRouteTXP         WebServerRouteSimulatorTransport
+	singletonWSMgrTXP           WebServerManagerTransport
+	singletonWSTmplTXP          WebServerTemplateTransport
+	singletonWSRecTXP           WebServerReconcilerTransport
+	singletonWSUpsTXP           WebServerUpstreamTransport
 }
 
 func (t *transport) WebServerConfig() WebServerConfigTransport {
@@ -107,14 +128,119 @@ func (t *transport) WebServerLogWatcher() WebServerLogWatcherTransport {
 	return t.singletonWSLWTXP
 }
 
+func (t *transport) WebServerCertificate() WebServerCertificateTransport {
+	t.onceWebServerCertificate.Do(func() {
+		if t.singletonWSCertTXP == nil {
+			t.singletonWSCertTXP = newWebServerCertificateTransport(t)
+		}
+	})
+	if t.singletonWSCertTXP == nil {
+		log.Fatal("web server certificate transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSCertTXP
+}
+
+func (t *transport) WebServerLinter() WebServerLinterTransport {
+	t.onceWebServerLinter.Do(func() {
+		if t.singletonWSLintTXP == nil {
+			t.singletonWSLintTXP = newWebServerLinterTransport(t)
+		}
+	})
+	if t.singletonWSLintTXP == nil {
+		log.Fatal("web server linter transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSLintTXP
+}
+
+func (t *transport) WebServerRouteSimulator() WebServerRouteSimulatorTransport {
+	t.onceWebServerRouteSimulator.Do(func() {
+		if t.singletonWSRouteTXP == nil {
+			t.singletonWSRouteTXP = newWebServerRouteSimulatorTransport(t)
+		}
+	})
+	if t.singletonWSRouteTXP == nil {
+		log.Fatal("web server route simulator transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSRouteTXP
+}
+
+func (t *transport) WebServerManager() WebServerManagerTransport {
+	t.onceWebServerManager.Do(func() {
+		if t.singletonWSMgrTXP == nil {
+			t.singletonWSMgrTXP = newWebServerManagerTransport(t)
+		}
+	})
+	if t.singletonWSMgrTXP == nil {
+		log.Fatal("web server manager transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSMgrTXP
+}
+
+func (t *transport) WebServerTemplate() WebServerTemplateTransport {
+	t.onceWebServerTemplate.Do(func() {
+		if t.singletonWSTmplTXP == nil {
+			t.singletonWSTmplTXP = newWebServerTemplateTransport(t)
+		}
+	})
+	if t.singletonWSTmplTXP == nil {
+		log.Fatal("web server template transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSTmplTXP
+}
+
+func (t *transport) WebServerReconciler() WebServerReconcilerTransport {
+	t.onceWebServerReconciler.Do(func() {
+		if t.singletonWSRecTXP == nil {
+			t.singletonWSRecTXP = newWebServerReconcilerTransport(t)
+		}
+	})
+	if t.singletonWSRecTXP == nil {
+		log.Fatal("web server reconciler transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSRecTXP
+}
+
+func (t *transport) WebServerUpstream() WebServerUpstreamTransport {
+	t.onceWebServerUpstream.Do(func() {
+		if t.singletonWSUpsTXP == nil {
+			t.singletonWSUpsTXP = newWebServerUpstreamTransport(t)
+		}
+	})
+	if t.singletonWSUpsTXP == nil {
+		log.Fatal("web server upstream transport client is nil")
+
+		return nil
+	}
+	return t.singletonWSUpsTXP
+}
+
 func New(conn *grpc.ClientConn) Factory {
 	return &transport{
-		conn:                    conn,
-		decoderFactory:          decoder.New(),
-		encoderFactory:          encoder.New(),
-		onceWebServerConfig:     sync.Once{},
-		onceWebServerStatistics: sync.Once{},
-		onceWebServerStatus:     sync.Once{},
-		onceWebServerLogWatcher: sync.Once{},
+		conn:                        conn,
+		decoderFactory:              decoder.New(),
+		encoderFactory:              encoder.New(),
+		onceWebServerConfig:         sync.Once{},
+		onceWebServerStatistics:     sync.Once{},
+		onceWebServerStatus:         sync.Once{},
+		onceWebServerLogWatcher:     sync.Once{},
+		onceWebServerCertificate:    sync.Once{},
+		onceWebServerLinter:         sync.Once{},
+		onceWebServerRouteSimulator: sync.Once{},
+		onceWebServerManager:        sync.Once{},
+		onceWebServerTemplate:       sync.Once{},
+		onceWebServerReconciler:     sync.Once{},
+		onceWebServerUpstream:       sync.Once{},
 	}
 }
@@ -0,0 +1,128 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
+	"io"
+)
+
+type WebServerCertificateTransport interface {
+	Get() Client
+	WatchExpiry() Client
+}
+
+type webServerCertificateTransport struct {
+	getClient         Client
+	watchExpiryClient Client
+}
+
+func (w *webServerCertificateTransport) Get() Client {
+	return w.getClient
+}
+
+func (w *webServerCertificateTransport) WatchExpiry() Client {
+	return w.watchExpiryClient
+}
+
+func newWebServerCertificateGetClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerCertificateClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.Get(ctx, req.(*pbv1.ServerName))
+		if err != nil {
+			return nil, err
+		}
+		buf := bytes.NewBuffer(nil)
+		for {
+			d, err := stream.Recv()
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			if err == io.EOF {
+				break
+			}
+
+			buf.Write(d.GetJsonData())
+		}
+
+		return responseFunc(ctx, &pbv1.Certificates{JsonData: buf.Bytes()})
+	})
+}
+
+func newWebServerCertificateWatchExpiryClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerCertificateClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.WatchExpiry(ctx, req.(*pbv1.CertificateWatchRequest))
+		if err != nil {
+			return nil, err
+		}
+
+		warningC := make(chan *v1.CertificateWarning)
+
+		go func() {
+			defer close(warningC)
+			buf := bytes.NewBuffer(nil)
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					if err != io.EOF {
+						log.Warnf("stop watching web server certificate expiry, caused by: %v", err)
+					}
+					return
+				}
+				buf.Write(resp.GetMsg())
+
+				// each warning is sent as a line of json data, which may be split into several messages
+				for {
+					i := bytes.IndexByte(buf.Bytes(), '\n')
+					if i < 0 {
+						break
+					}
+					line := buf.Next(i + 1)
+					warning := new(v1.CertificateWarning)
+					if err := json.Unmarshal(line, warning); err != nil {
+						log.Warnf("failed to decode web server certificate warning, caused by: %v", err)
+						continue
+					}
+					select {
+					case warningC <- warning:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+
+		return responseFunc(ctx, &v1.WebServerCertificateWarnings{Warnings: warningC})
+	})
+}
+
+func newWebServerCertificateTransport(transport *transport) WebServerCertificateTransport {
+	return &webServerCertificateTransport{
+		getClient: newWebServerCertificateGetClient(
+			transport.conn,
+			transport.encoderFactory.WebServerCertificate().EncodeRequest,
+			transport.decoderFactory.WebServerCertificate().DecodeResponse,
+		),
+		watchExpiryClient: newWebServerCertificateWatchExpiryClient(
+			transport.conn,
+			transport.encoderFactory.WebServerCertificate().EncodeRequest,
+			transport.decoderFactory.WebServerCertificate().DecodeResponse,
+		),
+	}
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
+	"io"
+)
+
+type WebServerLinterTransport interface {
+	Lint() Client
+}
+
+type webServerLinterTransport struct {
+	lintClient Client
+}
+
+func (w *webServerLinterTransport) Lint() Client {
+	return w.lintClient
+}
+
+func newWebServerLinterClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerLinterClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.Lint(ctx, req.(*pbv1.ServerName))
+		if err != nil {
+			return nil, err
+		}
+		buf := bytes.NewBuffer(nil)
+		for {
+			d, err := stream.Recv()
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			if err == io.EOF {
+				break
+			}
+
+			buf.Write(d.GetJsonData())
+		}
+
+		return responseFunc(ctx, &pbv1.LintReport{JsonData: buf.Bytes()})
+	})
+}
+
+func newWebServerLinterTransport(transport *transport) WebServerLinterTransport {
+	return &webServerLinterTransport{
+		lintClient: newWebServerLinterClient(
+			transport.conn,
+			transport.encoderFactory.WebServerLinter().EncodeRequest,
+			transport.decoderFactory.WebServerLinter().DecodeResponse,
+		),
+	}
+}
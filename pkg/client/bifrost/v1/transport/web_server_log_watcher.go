@@ -1,26 +1,59 @@
 package transport
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
 	"google.golang.org/grpc"
 	"io"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	webServerLogWatcherService = "bifrostpb.WebServerLogWatcher"
 )
 
 type WebServerLogWatcherTransport interface {
 	Watch() Client
+	QueryLogs() Client
+	ListLogs() Client
+	Alerts() Client
+	DownloadLog() Client
 }
 
 type webServerLogWatcherTransport struct {
-	watchClient Client
+	watchClient       Client
+	queryLogsClient   Client
+	listLogsClient    Client
+	alertsClient      Client
+	downloadLogClient Client
 }
 
 func (w *webServerLogWatcherTransport) Watch() Client {
 	return w.watchClient
 }
 
+func (w *webServerLogWatcherTransport) QueryLogs() Client {
+	return w.queryLogsClient
+}
+
+func (w *webServerLogWatcherTransport) ListLogs() Client {
+	return w.listLogsClient
+}
+
+func (w *webServerLogWatcherTransport) Alerts() Client {
+	return w.alertsClient
+}
+
+func (w *webServerLogWatcherTransport) DownloadLog() Client {
+	return w.downloadLogClient
+}
+
 func newWebServerLogWatcherClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
 	cli := pbv1.NewWebServerLogWatcherClient(conn)
 	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
@@ -35,24 +68,225 @@ func newWebServerLogWatcherClient(conn *grpc.ClientConn, requestFunc grpctranspo
 		}
 
 		outputC := make(chan []byte)
+		state := new(watchState)
 
 		go func() {
 			defer close(outputC)
 			needClose := false
 			for !needClose {
+				data := recvWatcherResponse(stream, &needClose, state)
+				if !needClose && len(data) == 0 { // the report of the dropped lines or the cursor
+					state.commit()
+					continue
+				}
 				select {
-				case outputC <- recvWatcherResponse(stream, &needClose):
+				case outputC <- data:
+					state.commit()
 				case <-ctx.Done():
 					return
 				}
 			}
 		}()
 
+		return responseFunc(ctx, &v1.WebServerLog{
+			Lines:   outputC,
+			Dropped: state.getDropped,
+			Cursor:  state.getCursor,
+			Gap:     state.getGap,
+		})
+	})
+}
+
+func newWebServerLogQueryClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerLogWatcherClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.QueryLogs(ctx, req.(*pbv1.LogQueryRequest))
+		if err != nil {
+			return nil, err
+		}
+
+		outputC := make(chan []byte)
+
+		go func() {
+			defer close(outputC)
+			for {
+				needClose := false
+				data := recvWatcherResponse(stream, &needClose, nil)
+				if data != nil {
+					select {
+					case outputC <- data:
+					case <-ctx.Done():
+						return
+					}
+				}
+				if needClose {
+					return
+				}
+			}
+		}()
+
 		return responseFunc(ctx, &v1.WebServerLog{Lines: outputC})
 	})
 }
 
-func recvWatcherResponse(stream pbv1.WebServerLogWatcher_WatchClient, needClose *bool) []byte {
+func newWebServerLogAlertsClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerLogWatcherClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.Alerts(ctx, req.(*pbv1.LogAlertsRequest))
+		if err != nil {
+			return nil, err
+		}
+
+		alertsC := make(chan *v1.LogAlert)
+
+		go func() {
+			defer close(alertsC)
+			buf := bytes.NewBuffer(nil)
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					if err != io.EOF {
+						log.Warnf("stop watching web server log alerts, caused by: %v", err)
+					}
+					return
+				}
+				buf.Write(resp.GetMsg())
+
+				// each alert is sent as a line of json data, which may be split into several messages
+				for {
+					i := bytes.IndexByte(buf.Bytes(), '\n')
+					if i < 0 {
+						break
+					}
+					line := buf.Next(i + 1)
+					alert := new(v1.LogAlert)
+					if err := json.Unmarshal(line, alert); err != nil {
+						log.Warnf("failed to decode web server log alert, caused by: %v", err)
+						continue
+					}
+					select {
+					case alertsC <- alert:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+
+		return responseFunc(ctx, &v1.WebServerLogAlerts{Alerts: alertsC})
+	})
+}
+
+func newWebServerLogDownloadClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerLogWatcherClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.DownloadLog(ctx, req.(*pbv1.LogDownloadRequest))
+		if err != nil {
+			return nil, err
+		}
+		// the first message is the file name, or the error of the request, e.g. the log is not found
+		first, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		chunksC := make(chan []byte)
+		var (
+			checksum string
+			recvErr  error
+		)
+
+		go func() {
+			defer close(chunksC)
+			for {
+				resp, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				if err != nil {
+					recvErr = err
+					return
+				}
+				if resp.GetChecksum() != "" {
+					checksum = resp.GetChecksum()
+				}
+				if len(resp.GetData()) == 0 {
+					continue
+				}
+				select {
+				case chunksC <- resp.GetData():
+				case <-ctx.Done():
+					recvErr = ctx.Err()
+					return
+				}
+			}
+		}()
+
+		return responseFunc(ctx, &v1.WebServerLogDownload{
+			FileName: first.GetFileName(),
+			Chunks:   chunksC,
+			Checksum: func() string { return checksum },
+			Err:      func() error { return recvErr },
+		})
+	})
+}
+
+// responseReceiver is the client stream of the web server log watcher.
+type responseReceiver interface {
+	Recv() (*pbv1.Response, error)
+}
+
+// watchState is the state of the watching reported by the server. The cursor is pending until the chunk with it is
+// received by the client, so that the lines after the committed cursor are never lost.
+type watchState struct {
+	dropped   uint64
+	gap       uint32
+	mu        sync.Mutex
+	pending   string
+	committed string
+}
+
+func (s *watchState) commit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending != "" {
+		s.committed = s.pending
+		s.pending = ""
+	}
+}
+
+func (s *watchState) getDropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+func (s *watchState) getCursor() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.committed
+}
+
+func (s *watchState) getGap() bool {
+	return atomic.LoadUint32(&s.gap) == 1
+}
+
+// recvWatcherResponse receives the message of the stream, and records the number of the lines dropped by the server,
+// the cursor and the gap into state if it is not nil.
+func recvWatcherResponse(stream responseReceiver, needClose *bool, state *watchState) []byte {
 	resp, err := stream.Recv()
 	if err != nil && err != io.EOF {
 		*needClose = true
@@ -62,6 +296,19 @@ func recvWatcherResponse(stream pbv1.WebServerLogWatcher_WatchClient, needClose
 		*needClose = true
 		return nil
 	}
+	if state != nil {
+		if resp.GetDropped() > 0 {
+			atomic.StoreUint64(&state.dropped, resp.GetDropped())
+		}
+		if resp.GetGap() {
+			atomic.StoreUint32(&state.gap, 1)
+		}
+		if resp.GetCursor() != "" {
+			state.mu.Lock()
+			state.pending = resp.GetCursor()
+			state.mu.Unlock()
+		}
+	}
 	return resp.GetMsg()
 }
 
@@ -72,5 +319,28 @@ func newWebServerLogWatcherTransport(transport *transport) WebServerLogWatcherTr
 			transport.encoderFactory.WebServerLogWatcher().EncodeRequest,
 			transport.decoderFactory.WebServerLogWatcher().DecodeResponse,
 		),
+		queryLogsClient: newWebServerLogQueryClient(
+			transport.conn,
+			transport.encoderFactory.WebServerLogWatcher().EncodeRequest,
+			transport.decoderFactory.WebServerLogWatcher().DecodeResponse,
+		),
+		listLogsClient: grpctransport.NewClient(
+			transport.conn,
+			webServerLogWatcherService,
+			"ListLogs",
+			transport.encoderFactory.WebServerLogWatcher().EncodeRequest,
+			transport.decoderFactory.WebServerLogWatcher().DecodeResponse,
+			new(pbv1.LogFiles),
+		),
+		alertsClient: newWebServerLogAlertsClient(
+			transport.conn,
+			transport.encoderFactory.WebServerLogWatcher().EncodeRequest,
+			transport.decoderFactory.WebServerLogWatcher().DecodeResponse,
+		),
+		downloadLogClient: newWebServerLogDownloadClient(
+			transport.conn,
+			transport.encoderFactory.WebServerLogWatcher().EncodeRequest,
+			transport.decoderFactory.WebServerLogWatcher().DecodeResponse,
+		),
 	}
 }
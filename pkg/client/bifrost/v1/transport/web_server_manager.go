@@ -0,0 +1,66 @@
+package transport
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+)
+
+const (
+	webServerManagerService = "bifrostpb.WebServerManager"
+)
+
+type WebServerManagerTransport interface {
+	List() Client
+	Register() Client
+	Reconfigure() Client
+	Unregister() Client
+	GetStates() Client
+}
+
+type webServerManagerTransport struct {
+	listClient        Client
+	registerClient    Client
+	reconfigureClient Client
+	unregisterClient  Client
+	getStatesClient   Client
+}
+
+func (w *webServerManagerTransport) List() Client {
+	return w.listClient
+}
+
+func (w *webServerManagerTransport) Register() Client {
+	return w.registerClient
+}
+
+func (w *webServerManagerTransport) Reconfigure() Client {
+	return w.reconfigureClient
+}
+
+func (w *webServerManagerTransport) Unregister() Client {
+	return w.unregisterClient
+}
+
+func (w *webServerManagerTransport) GetStates() Client {
+	return w.getStatesClient
+}
+
+func newWebServerManagerTransport(transport *transport) WebServerManagerTransport {
+	newUnaryClient := func(method string, reply interface{}) Client {
+		return grpctransport.NewClient(
+			transport.conn,
+			webServerManagerService,
+			method,
+			transport.encoderFactory.WebServerManager().EncodeRequest,
+			transport.decoderFactory.WebServerManager().DecodeResponse,
+			reply,
+		)
+	}
+	return &webServerManagerTransport{
+		listClient:        newUnaryClient("List", new(pbv1.ManagedWebServers)),
+		registerClient:    newUnaryClient("Register", new(pbv1.Response)),
+		reconfigureClient: newUnaryClient("Reconfigure", new(pbv1.Response)),
+		unregisterClient:  newUnaryClient("Unregister", new(pbv1.Response)),
+		getStatesClient:   newUnaryClient("GetStates", new(pbv1.ConfigManagerStates)),
+	}
+}
@@ -0,0 +1,45 @@
+package transport
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+)
+
+const (
+	webServerReconcilerService = "bifrostpb.WebServerReconciler"
+)
+
+type WebServerReconcilerTransport interface {
+	Plan() Client
+	Apply() Client
+}
+
+type webServerReconcilerTransport struct {
+	planClient  Client
+	applyClient Client
+}
+
+func (w *webServerReconcilerTransport) Plan() Client {
+	return w.planClient
+}
+
+func (w *webServerReconcilerTransport) Apply() Client {
+	return w.applyClient
+}
+
+func newWebServerReconcilerTransport(transport *transport) WebServerReconcilerTransport {
+	newUnaryClient := func(method string) Client {
+		return grpctransport.NewClient(
+			transport.conn,
+			webServerReconcilerService,
+			method,
+			transport.encoderFactory.WebServerReconciler().EncodeRequest,
+			transport.decoderFactory.WebServerReconciler().DecodeResponse,
+			new(pbv1.ReconcilePlans),
+		)
+	}
+	return &webServerReconcilerTransport{
+		planClient:  newUnaryClient("Plan"),
+		applyClient: newUnaryClient("Apply"),
+	}
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
+	"io"
+)
+
+type WebServerRouteSimulatorTransport interface {
+	Simulate() Client
+}
+
+type webServerRouteSimulatorTransport struct {
+	simulateClient Client
+}
+
+func (w *webServerRouteSimulatorTransport) Simulate() Client {
+	return w.simulateClient
+}
+
+func newWebServerRouteSimulatorClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerRouteSimulatorClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.Simulate(ctx, req.(*pbv1.RouteRequest))
+		if err != nil {
+			return nil, err
+		}
+		buf := bytes.NewBuffer(nil)
+		for {
+			d, err := stream.Recv()
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			if err == io.EOF {
+				break
+			}
+
+			buf.Write(d.GetJsonData())
+		}
+
+		return responseFunc(ctx, &pbv1.RouteResult{JsonData: buf.Bytes()})
+	})
+}
+
+func newWebServerRouteSimulatorTransport(transport *transport) WebServerRouteSimulatorTransport {
+	return &webServerRouteSimulatorTransport{
+		simulateClient: newWebServerRouteSimulatorClient(
+			transport.conn,
+			transport.encoderFactory.WebServerRouteSimulator().EncodeRequest,
+			transport.decoderFactory.WebServerRouteSimulator().DecodeResponse,
+		),
+	}
+}
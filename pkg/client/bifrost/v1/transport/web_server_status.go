@@ -3,7 +3,10 @@ package transport
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
 	"google.golang.org/grpc"
 	"io"
@@ -11,16 +14,22 @@ import (
 
 type WebServerStatusTransport interface {
 	Get() Client
+	TrafficStats() Client
 }
 
 type webServerStatusTransport struct {
-	getClient Client
+	getClient          Client
+	trafficStatsClient Client
 }
 
 func (w *webServerStatusTransport) Get() Client {
 	return w.getClient
 }
 
+func (w *webServerStatusTransport) TrafficStats() Client {
+	return w.trafficStatsClient
+}
+
 func newWebServerStatusClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
 	cli := pbv1.NewWebServerStatusClient(conn)
 	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
@@ -52,6 +61,59 @@ func newWebServerStatusClient(conn *grpc.ClientConn, requestFunc grpctransport.E
 	})
 }
 
+func newWebServerStatusTrafficStatsClient(conn *grpc.ClientConn, requestFunc grpctransport.EncodeRequestFunc, responseFunc grpctransport.DecodeResponseFunc) Client {
+	cli := pbv1.NewWebServerStatusClient(conn)
+	return newClient(func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, err := requestFunc(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		stream, err := cli.TrafficStats(ctx, req.(*pbv1.TrafficStatsRequest))
+		if err != nil {
+			return nil, err
+		}
+
+		statsC := make(chan *v1.TrafficStats)
+
+		go func() {
+			defer close(statsC)
+			buf := bytes.NewBuffer(nil)
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					if err != io.EOF {
+						log.Warnf("stop watching web server traffic stats, caused by: %v", err)
+					}
+					return
+				}
+				buf.Write(resp.GetMsg())
+
+				// each stats is sent as a line of json data, which may be split into several messages
+				for {
+					i := bytes.IndexByte(buf.Bytes(), '\n')
+					if i < 0 {
+						break
+					}
+					line := buf.Next(i + 1)
+					stats := new(v1.TrafficStats)
+					if err := json.Unmarshal(line, stats); err != nil {
+						log.Warnf("failed to decode web server traffic stats, caused by: %v", err)
+						continue
+					}
+					select {
+					case statsC <- stats:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+
+		return responseFunc(ctx, &v1.WebServerTrafficStats{Stats: statsC})
+	})
+}
+
 func newWebServerStatusTransport(transport *transport) WebServerStatusTransport {
 	return &webServerStatusTransport{
 		getClient: newWebServerStatusClient(
@@ -59,5 +121,10 @@ func newWebServerStatusTransport(transport *transport) WebServerStatusTransport
 			transport.encoderFactory.WebServerStatus().EncodeRequest,
 			transport.decoderFactory.WebServerStatus().DecodeResponse,
 		),
+		trafficStatsClient: newWebServerStatusTrafficStatsClient(
+			transport.conn,
+			transport.encoderFactory.WebServerStatus().EncodeRequest,
+			transport.decoderFactory.WebServerStatus().DecodeResponse,
+		),
 	}
 }
@@ -0,0 +1,45 @@
+package transport
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+)
+
+const (
+	webServerTemplateService = "bifrostpb.WebServerTemplate"
+)
+
+type WebServerTemplateTransport interface {
+	List() Client
+	Apply() Client
+}
+
+type webServerTemplateTransport struct {
+	listClient  Client
+	applyClient Client
+}
+
+func (w *webServerTemplateTransport) List() Client {
+	return w.listClient
+}
+
+func (w *webServerTemplateTransport) Apply() Client {
+	return w.applyClient
+}
+
+func newWebServerTemplateTransport(transport *transport) WebServerTemplateTransport {
+	newUnaryClient := func(method string, reply interface{}) Client {
+		return grpctransport.NewClient(
+			transport.conn,
+			webServerTemplateService,
+			method,
+			transport.encoderFactory.WebServerTemplate().EncodeRequest,
+			transport.decoderFactory.WebServerTemplate().DecodeResponse,
+			reply,
+		)
+	}
+	return &webServerTemplateTransport{
+		listClient:  newUnaryClient("List", new(pbv1.Templates)),
+		applyClient: newUnaryClient("Apply", new(pbv1.TemplateApplyResult)),
+	}
+}
@@ -0,0 +1,66 @@
+package transport
+
+import (
+	pbv1 "github.com/ClessLi/bifrost/api/protobuf-spec/bifrostpb/v1"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+)
+
+const (
+	webServerUpstreamService = "bifrostpb.WebServerUpstream"
+)
+
+type WebServerUpstreamTransport interface {
+	List() Client
+	AddMember() Client
+	RemoveMember() Client
+	SetWeight() Client
+	SetState() Client
+}
+
+type webServerUpstreamTransport struct {
+	listClient         Client
+	addMemberClient    Client
+	removeMemberClient Client
+	setWeightClient    Client
+	setStateClient     Client
+}
+
+func (w *webServerUpstreamTransport) List() Client {
+	return w.listClient
+}
+
+func (w *webServerUpstreamTransport) AddMember() Client {
+	return w.addMemberClient
+}
+
+func (w *webServerUpstreamTransport) RemoveMember() Client {
+	return w.removeMemberClient
+}
+
+func (w *webServerUpstreamTransport) SetWeight() Client {
+	return w.setWeightClient
+}
+
+func (w *webServerUpstreamTransport) SetState() Client {
+	return w.setStateClient
+}
+
+func newWebServerUpstreamTransport(transport *transport) WebServerUpstreamTransport {
+	newUnaryClient := func(method string, reply interface{}) Client {
+		return grpctransport.NewClient(
+			transport.conn,
+			webServerUpstreamService,
+			method,
+			transport.encoderFactory.WebServerUpstream().EncodeRequest,
+			transport.decoderFactory.WebServerUpstream().DecodeResponse,
+			reply,
+		)
+	}
+	return &webServerUpstreamTransport{
+		listClient:         newUnaryClient("List", new(pbv1.Upstreams)),
+		addMemberClient:    newUnaryClient("AddMember", new(pbv1.Response)),
+		removeMemberClient: newUnaryClient("RemoveMember", new(pbv1.Response)),
+		setWeightClient:    newUnaryClient("SetWeight", new(pbv1.Response)),
+		setStateClient:     newUnaryClient("SetState", new(pbv1.Response)),
+	}
+}
@@ -0,0 +1,112 @@
+package configuration
+
+import (
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/fsnotify/fsnotify"
+	"github.com/marmotedu/errors"
+	"path/filepath"
+)
+
+// configFilesWatcher watches the directories of the config files and of the `include` patterns, rather than the files
+// themselves, so that the files replaced by renaming, and the new files matching the `include` patterns are noticed.
+type configFilesWatcher struct {
+	watcher  *fsnotify.Watcher
+	dirs     map[string]bool
+	files    map[string]bool
+	patterns []string
+}
+
+// watch updates the watched directories to cover the config files and the `include` patterns.
+func (w *configFilesWatcher) watch(configPaths, patterns []string) error {
+	dirs := make(map[string]bool)
+	files := make(map[string]bool)
+	for _, path := range configPaths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		files[absPath] = true
+		dirs[filepath.Dir(absPath)] = true
+	}
+	for _, pattern := range patterns {
+		// the directory of the pattern may also be a pattern, like `conf.d/*/*.conf`
+		patternDirs, err := filepath.Glob(filepath.Dir(pattern))
+		if err != nil {
+			return errors.Wrapf(err, "invalid include pattern '%s'", pattern)
+		}
+		for _, dir := range patternDirs {
+			dirs[dir] = true
+		}
+	}
+
+	for dir := range dirs {
+		if w.dirs[dir] {
+			continue
+		}
+		if err := w.watcher.Add(dir); err != nil {
+			return errors.Wrapf(err, "failed to watch directory '%s'", dir)
+		}
+	}
+	for dir := range w.dirs {
+		if !dirs[dir] {
+			_ = w.watcher.Remove(dir)
+		}
+	}
+	w.dirs, w.files, w.patterns = dirs, files, patterns
+	return nil
+}
+
+// isConfigChange reports whether the event changes a config file, or a file matching the `include` patterns.
+func (w *configFilesWatcher) isConfigChange(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	name := filepath.Clean(event.Name)
+	if w.files[name] {
+		return true
+	}
+	for _, pattern := range w.patterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *configFilesWatcher) Close() error {
+	return w.watcher.Close()
+}
+
+func newConfigFilesWatcher() (*configFilesWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &configFilesWatcher{
+		watcher: watcher,
+		dirs:    make(map[string]bool),
+		files:   make(map[string]bool),
+	}, nil
+}
+
+// includeValues returns the values of all the `include` in the context, which are the glob patterns relative to the
+// work directory, the directory of the main config.
+func includeValues(ctx parser.Context) []string {
+	values := make([]string, 0)
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			break
+		}
+		subCtx, ok := child.(parser.Context)
+		if !ok {
+			continue
+		}
+		if child.GetType() == parser_type.TypeInclude {
+			values = append(values, subCtx.GetValue())
+		}
+		values = append(values, includeValues(subCtx)...)
+	}
+	return values
+}
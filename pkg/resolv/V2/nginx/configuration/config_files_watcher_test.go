@@ -0,0 +1,121 @@
+package configuration
+
+import (
+	"fmt"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConfigManager_regularlyReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-watch-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = os.Mkdir(filepath.Join(dir, "conf.d"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err = ioutil.WriteFile(confPath, []byte("http {\n    include conf.d/*.conf;\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	aPath := filepath.Join(dir, "conf.d", "a.conf")
+	if err = ioutil.WriteFile(aPath, []byte("server {\n    listen 80;\n    server_name a.example.com;\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewNginxConfigurationManagerWithOptions(loader.NewLoader(), c, filepath.Join(dir, "nginx"), "", 0, 0, ManagerOptions{
+		ReloadInterval: time.Hour,
+		ReloadDebounce: time.Millisecond * 100,
+		SaveInterval:   time.Hour,
+	}, new(sync.RWMutex))
+	if err = manager.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Stop()
+
+	waitReloaded := func(servername string) {
+		deadline := time.Now().Add(time.Second * 5)
+		for time.Now().Before(deadline) {
+			if _, err := manager.GetConfiguration().Query("key:sep: :reg:server_name " + regexp.QuoteMeta(servername)); err == nil {
+				return
+			}
+			time.Sleep(time.Millisecond * 50)
+		}
+		t.Errorf("the configuration is not reloaded with server %s in 5s", servername)
+	}
+
+	// wait for the watcher being set up
+	time.Sleep(time.Millisecond * 200)
+	if err = ioutil.WriteFile(aPath, []byte("server {\n    listen 80;\n    server_name a.example.org;\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitReloaded("a.example.org")
+
+	// a new file matching the include pattern
+	if err = ioutil.WriteFile(filepath.Join(dir, "conf.d", "b.conf"), []byte("server {\n    listen 80;\n    server_name b.example.com;\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitReloaded("b.example.com")
+}
+
+// TestConfigManager_regularlyReloadWhileChanging changes the configuration by the api, while the watcher is set up and
+// updated with the include patterns of the configuration, which is run with `-race` in the ci.
+func TestConfigManager_regularlyReloadWhileChanging(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-watch-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = os.Mkdir(filepath.Join(dir, "conf.d"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err = ioutil.WriteFile(confPath, []byte("http {\n    include conf.d/*.conf;\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewNginxConfigurationManagerWithOptions(loader.NewLoader(), c, filepath.Join(dir, "nginx"), "", 0, 0, ManagerOptions{
+		ReloadInterval: time.Hour,
+		ReloadDebounce: time.Millisecond * 10,
+		SaveInterval:   time.Hour,
+	}, new(sync.RWMutex))
+	if err = manager.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 20; i++ {
+			path := filepath.Join(dir, "conf.d", fmt.Sprintf("%d.conf", i))
+			if err := ioutil.WriteFile(path, []byte(fmt.Sprintf("server {\n    server_name %d.example.com;\n}\n", i)), 0644); err != nil {
+				t.Error(err)
+				return
+			}
+			time.Sleep(time.Millisecond * 20)
+		}
+	}()
+	for i := 0; i < 200; i++ {
+		if err = manager.GetConfiguration().AppendSnippetByKeyword([]byte("server_tokens off;"), "http"); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond * 2)
+	}
+	<-done
+}
@@ -29,6 +29,10 @@ type Configuration interface {
 	// insert
 	InsertByKeyword(insertParser parser.Parser, keyword string) error
 	InsertByQueryer(insertParser parser.Parser, queryer Querier) error
+	// AppendSnippetByKeyword parses the config snippet, e.g. a server block rendered from a template, and appends the
+	// parsers to the end of the context queried by the keyword, or to the end of the father context of the parser
+	// queried if it is not a context, e.g. `key:sep: server_name example.com` for its server.
+	AppendSnippetByKeyword(snippet []byte, keyword string) error
 	//InsertByIndex(insertParser parser.Parser, targetContext parser.Context, index int) error
 	// remove
 	RemoveByKeyword(keyword string) error
@@ -51,6 +55,7 @@ type Configuration interface {
 	renewConfiguration(Configuration) error
 	//diff(Configuration) bool
 	getMainConfigPath() string
+	getIncludeValues() []string
 	getConfigFingerprinter() utils.ConfigFingerprinter
 }
 
@@ -78,6 +83,53 @@ func (c *configuration) InsertByQueryer(insertParser parser.Parser, queryer Quer
 	return c.insertByIndex(insertParser, queryer.fatherContext(), queryer.index())
 }
 
+func (c *configuration) AppendSnippetByKeyword(snippet []byte, keyword string) error {
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
+	parserKeyword, err := parseKeyword(keyword)
+	if err != nil {
+		return err
+	}
+	target, idx := c.config.Query(parserKeyword)
+	if target == nil {
+		return errors.WithCode(code.ErrParserNotFound, "query father context failed")
+	}
+	if found, err := target.GetChild(idx); err == nil {
+		if ctx, isCtx := found.(parser.Context); isCtx {
+			target = ctx
+		}
+	}
+
+	// the children of a config are as deep as the config, and the others are one level deeper than their context
+	indention := target.GetIndention()
+	if target.GetType() != parser_type.TypeConfig {
+		indention = indention.NextIndention()
+	}
+	parsers, err := loader.LoadSnippet(c.config.GetValue(), snippet, indention)
+	if err != nil {
+		return err
+	}
+	return c.insertAll(parsers, target, target.Len())
+}
+
+// insertAll inserts the parsers into the context from the index in order, the parsers inserted are removed if any of
+// them fails to be inserted, so that the parsers are inserted all or none.
+func (c *configuration) insertAll(parsers []parser.Parser, targetContext parser.Context, index int) error {
+	for i, p := range parsers {
+		err := c.insertByIndex(p, targetContext, index+i)
+		if err == nil {
+			continue
+		}
+		for j := i - 1; j >= 0; j-- {
+			if removeErr := c.removeByIndex(targetContext, index+j); removeErr != nil {
+				return errors.Wrapf(err, "failed to remove the inserted parsers (%v)", removeErr)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
 func (c *configuration) insertByIndex(insertParser parser.Parser, targetContext parser.Context, index int) error {
 	if insertParser.GetType() == parser_type.TypeConfig {
 		err := c.loopPreventer.CheckLoopPrevent(targetContext.GetPosition(), insertParser.GetPosition())
@@ -269,6 +321,13 @@ func (c *configuration) getMainConfigPath() string {
 	return c.config.GetValue()
 }
 
+// getIncludeValues returns the values of all the `include` in the configuration.
+func (c *configuration) getIncludeValues() []string {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	return includeValues(c.config)
+}
+
 func (c *configuration) getConfigFingerprinter() utils.ConfigFingerprinter {
 	c.rwLocker.RLock()
 	defer c.rwLocker.RUnlock()
@@ -9,6 +9,7 @@ import (
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/utils"
+	"github.com/fsnotify/fsnotify"
 	"github.com/marmotedu/errors"
 	"github.com/wxnacy/wgo/arrays"
 	"io/ioutil"
@@ -20,6 +21,8 @@ import (
 	"time"
 )
 
+const maxRecentConflicts = 20
+
 type ConfigManager interface {
 	Start() error
 	Stop() error
@@ -28,6 +31,53 @@ type ConfigManager interface {
 	regularlyReload(duration time.Duration, signalChan chan int) error
 	regularlySave(duration time.Duration, signalChan chan int) error
 	GetServerInfo() *v1.WebServerInfo
+	// SaveWithCheck saves the changed configuration to the config files, and restores the configuration if the web
+	// server rejects the config files.
+	SaveWithCheck() error
+	// ServerReload signals the web server to reload the config files.
+	ServerReload() error
+	// GetConflicts returns the recent conflicts between the config files and the configuration.
+	GetConflicts() []*v1.ConfigConflict
+	// GetState returns the state of the regularly running loops, and whether the configuration is synchronized with
+	// the config files.
+	GetState() *v1.ConfigManagerState
+}
+
+// ManagerOptions defines the intervals and the conflict policy of the config manager, the zero values are replaced by
+// the defaults.
+type ManagerOptions struct {
+	// ReloadInterval is the interval to poll the config files, when they can not be watched.
+	ReloadInterval time.Duration
+	// ReloadDebounce is the quiet time after the last change of the config files, before reloading.
+	ReloadDebounce time.Duration
+	// SaveInterval is the interval to save the changed configuration to the config files.
+	SaveInterval time.Duration
+	// ConflictPolicy decides how to resolve the conflict, when the config files and the configuration are both changed
+	// since they were last synchronized.
+	ConflictPolicy v1.ConfigConflictPolicy
+	// FileMode is the permission of the saved config files, the mode of the existing files is kept if it is zero.
+	FileMode os.FileMode
+	// Prefix is the prefix path of the server instance passed with `-p`, the parent directory of the directory of the
+	// server binary file is used if it is empty.
+	Prefix string
+	// ExtraArgs are passed to the server binary file, e.g. `-g` globals.
+	ExtraArgs []string
+}
+
+func (i ManagerOptions) complete() ManagerOptions {
+	if i.ReloadInterval <= 0 {
+		i.ReloadInterval = time.Second * 30
+	}
+	if i.ReloadDebounce <= 0 {
+		i.ReloadDebounce = time.Second
+	}
+	if i.SaveInterval <= 0 {
+		i.SaveInterval = time.Second * 10
+	}
+	if i.ConflictPolicy == "" {
+		i.ConflictPolicy = v1.ConfigConflictMerge
+	}
+	return i
 }
 
 type configManager struct {
@@ -40,6 +90,11 @@ type configManager struct {
 	backupSaveTime         int
 	backupDir              string
 	serverBinPath          string
+	options                ManagerOptions
+	baseConfiguration      Configuration
+	conflicts              []*v1.ConfigConflict
+	state                  *managerState
+	syncLocker             *sync.Mutex
 	rwLocker               *sync.RWMutex
 	backupSignalChan       chan int
 	reloadSignalChan       chan int
@@ -84,14 +139,14 @@ func (c configManager) serverStatus() (status v1.State) {
 	if err == nil {
 		svrPidFilePath = strings.Split(svrPidQueryer.Self().GetValue(), " ")[1]
 	}
+	// the globals passed with `-g` take the place of the config
+	if globalPid := globalDirective(c.options.ExtraArgs, "pid"); globalPid != "" {
+		svrPidFilePath = globalPid
+	}
 
 	svrPidFilePathAbs := svrPidFilePath
 	if !filepath.IsAbs(svrPidFilePath) {
-		svrBinAbs, absErr := filepath.Abs(c.serverBinPath)
-		if absErr != nil {
-			return
-		}
-		svrWS, wsErr := filepath.Abs(filepath.Join(filepath.Dir(svrBinAbs), ".."))
+		svrWS, wsErr := c.serverPrefix()
 		if wsErr != nil {
 			return
 		}
@@ -121,6 +176,7 @@ func (c *configManager) GetConfiguration() Configuration {
 func (c *configManager) regularlyBackup(duration time.Duration, signalChan chan int) error {
 	// regularly backup is disabled, when c.backupCycle or c.backupSaveTime is less equal zero.
 	if c.backupCycle <= 0 || c.backupSaveTime <= 0 {
+		waitStopSignal(signalChan)
 		return nil
 	}
 
@@ -199,53 +255,173 @@ func (c *configManager) regularlyBackup(duration time.Duration, signalChan chan
 		if isSpecialBackupDir {
 			backupPath := filepath.Join(c.backupDir, backupName)
 			backupErr = os.Rename(archivePath, backupPath)
+			if backupErr != nil {
+				continue
+			}
 		}
+		c.state.backedUp()
 		log.Info("complete configs backup")
 	}
 	return backupErr
 }
 
+// regularlyReload reloads the configuration when the config files are changed. The changes are watched with fsnotify
+// and debounced, the config files are polled at the duration instead if they can not be watched.
 func (c *configManager) regularlyReload(duration time.Duration, signalChan chan int) error {
-	ticker := time.NewTicker(duration)
+	var (
+		ticker    *time.Ticker
+		pollC     <-chan time.Time
+		events    <-chan fsnotify.Event
+		watchErrs <-chan error
+		debounceC <-chan time.Time
+	)
+	fallback := func(err error) {
+		log.Warnf("failed to watch the config files of '%s', fall back to polling every %s. %v", c.mainConfigPath, duration, err)
+		events, watchErrs = nil, nil
+		ticker = time.NewTicker(duration)
+		pollC = ticker.C
+	}
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	watcher, err := newConfigFilesWatcher()
+	if err == nil {
+		defer watcher.Close()
+		err = watcher.watch(c.getConfigPaths(), c.includePatterns())
+	}
+	if err != nil {
+		fallback(err)
+	} else {
+		events, watchErrs = watcher.watcher.Events, watcher.watcher.Errors
+	}
+
 	var reloadErr error
 	for reloadErr == nil {
 		// 等待触发
 		select {
-		case <-ticker.C:
+		case <-pollC:
+		case <-debounceC:
+			debounceC = nil
+		case event, ok := <-events:
+			if !ok {
+				fallback(errors.New("the watcher is closed"))
+				continue
+			}
+			// the reload is delayed until the config files are quiet for the debounce time
+			if watcher.isConfigChange(event) {
+				debounceC = time.After(c.options.ReloadDebounce)
+			}
+			continue
+		case err, ok := <-watchErrs:
+			if !ok {
+				fallback(errors.New("the watcher is closed"))
+				continue
+			}
+			// some changes may be lost, e.g. the event queue overflows, so reload to catch up
+			log.Warnf("watching the config files of '%s' error, reload the configuration. %v", c.mainConfigPath, err)
 		case signal := <-signalChan:
 			if signal == 9 {
 				return reloadErr
 			}
+			continue
 		}
 
-		// 1) load
-		config, configPaths, err := c.load()
+		configPaths, reloaded, err := c.reload()
 		if err != nil {
 			reloadErr = err
 			continue
 		}
 
-		// 2) 判断manager配置指纹与文件指纹是否一致
-		if !c.configFilesFingerprint.Diff(config.getConfigFingerprinter()) {
-			continue
-		}
-		// 2) 不一致则重载文件配置
-		err = c.configuration.renewConfiguration(config)
-		if err != nil {
-			if !errors.IsCode(err, code.ErrSameConfigFingerprint) {
-				reloadErr = err
+		// 更新监听的配置文件及include目录
+		if reloaded && events != nil {
+			if err = watcher.watch(configPaths, c.includePatterns()); err != nil {
+				fallback(err)
 			}
-			continue
 		}
-		c.rwLocker.Lock()
-		c.configPaths = configPaths
-		c.configFilesFingerprint.Renew(config.getConfigFingerprinter())
-		c.rwLocker.Unlock()
 	}
 	return reloadErr
 }
 
-func (c configManager) load() (conf Configuration, configPaths []string, err error) {
+// reload loads the config files into the configuration if the files are changed, or resolves the conflict if the
+// configuration is also changed. The bool reports whether the configuration is synchronized with the changed files.
+func (c *configManager) reload() ([]string, bool, error) {
+	c.syncLocker.Lock()
+	defer c.syncLocker.Unlock()
+
+	// 1) load
+	config, configPaths, err := c.load()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// 2) 判断manager配置指纹与文件指纹是否一致
+	if !c.configFilesFingerprint.Diff(config.getConfigFingerprinter()) {
+		return configPaths, false, nil
+	}
+
+	// 2) 内存配置同时变更时，按冲突策略处理
+	if c.configuration.getConfigFingerprinter().Diff(c.configFilesFingerprint) && c.configuration.getConfigFingerprinter().Diff(config.getConfigFingerprinter()) {
+		err = c.resolveConflict(config, configPaths)
+		return c.getConfigPaths(), err == nil, err
+	}
+
+	// 3) 不一致则重载文件配置
+	err = c.configuration.renewConfiguration(config)
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) {
+		return nil, false, err
+	}
+	c.synchronized(configPaths)
+	return configPaths, true, nil
+}
+
+// synchronized records the configuration as the last synchronized config files.
+func (c *configManager) synchronized(configPaths []string) {
+	c.rwLocker.Lock()
+	c.configPaths = configPaths
+	c.configFilesFingerprint.Renew(c.configuration.getConfigFingerprinter())
+	c.rwLocker.Unlock()
+	c.renewBaseConfiguration()
+	c.state.reloaded()
+}
+
+// renewBaseConfiguration loads the last synchronized config files as the base of merging, which is independent of
+// the configuration changed by the api.
+func (c *configManager) renewBaseConfiguration() {
+	base, _, err := c.load()
+	if err != nil || base.getConfigFingerprinter().Diff(c.configFilesFingerprint) {
+		c.baseConfiguration = nil
+		return
+	}
+	c.baseConfiguration = base
+}
+
+func (c *configManager) getConfigPaths() []string {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	return c.configPaths
+}
+
+// includePatterns returns the absolute glob patterns of all the `include` in the configuration, which are read with the
+// lock of the configuration, as the configuration may be changed by the api at the same time.
+func (c *configManager) includePatterns() []string {
+	c.rwLocker.RLock()
+	mainConfigPath := c.mainConfigPath
+	c.rwLocker.RUnlock()
+	mainConfigAbsPath, err := filepath.Abs(mainConfigPath)
+	if err != nil {
+		return nil
+	}
+	values := c.configuration.getIncludeValues()
+	patterns := make([]string, 0, len(values))
+	for _, value := range values {
+		patterns = append(patterns, filepath.Join(filepath.Dir(mainConfigAbsPath), value))
+	}
+	return patterns
+}
+
+func (c *configManager) load() (conf Configuration, configPaths []string, err error) {
 	ctx, loopPreventer, err := c.loader.LoadFromFilePath(c.mainConfigPath)
 	if err != nil {
 		return nil, nil, err
@@ -283,6 +459,9 @@ func (c *configManager) regularlySave(duration time.Duration, signalChan chan in
 }
 
 func (c *configManager) SaveWithCheck() error {
+	c.syncLocker.Lock()
+	defer c.syncLocker.Unlock()
+
 	// 1) load old configs
 	oldConfig, oldConfigPaths, err := c.load()
 	if err != nil {
@@ -294,38 +473,198 @@ func (c *configManager) SaveWithCheck() error {
 		return errors.WithCode(code.ErrSameConfigFingerprints, "same config fingerprint between files and configuration")
 	}
 
+	// 2) 配置文件已变更时，内存配置未变更则重载文件配置，否则按冲突策略处理
+	if c.configFilesFingerprint.Diff(oldConfig.getConfigFingerprinter()) {
+		if c.configuration.getConfigFingerprinter().Diff(c.configFilesFingerprint) {
+			return c.resolveConflict(oldConfig, oldConfigPaths)
+		}
+		err = c.configuration.renewConfiguration(oldConfig)
+		if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) {
+			return err
+		}
+		c.synchronized(oldConfigPaths)
+		return nil
+	}
+
+	return c.overwrite(oldConfig, oldConfigPaths)
+}
+
+// resolveConflict resolves the conflict between the changed config files and the changed configuration with the
+// conflict policy. The discarded changes are saved to a snapshot file before being discarded, the conflict is left
+// unresolved if the snapshot fails, so that the changes are never lost silently.
+func (c *configManager) resolveConflict(diskConfig Configuration, diskConfigPaths []string) error {
+	event := &v1.ConfigConflict{
+		Time:       time.Now(),
+		Policy:     c.options.ConflictPolicy,
+		Resolution: c.options.ConflictPolicy,
+		Code:       code.ErrConfigConflict,
+	}
+	defer c.recordConflict(event)
+
+	if event.Policy == v1.ConfigConflictMerge {
+		err := c.merge(diskConfig)
+		if err == nil {
+			event.Message = "the changes of the config files and the api are merged"
+			// the merged configuration takes the place of the configuration, and is saved to the config files
+			err = c.configuration.renewConfiguration(diskConfig)
+			if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) {
+				return err
+			}
+			oldConfig, oldConfigPaths, err := c.load()
+			if err != nil {
+				return err
+			}
+			if !c.configuration.getConfigFingerprinter().Diff(oldConfig.getConfigFingerprinter()) {
+				c.synchronized(oldConfigPaths)
+				return nil
+			}
+			return c.overwrite(oldConfig, oldConfigPaths)
+		}
+		event.Resolution = v1.ConfigConflictDiskWins
+		event.Code = code.ErrConfigMergeFailed
+		event.Message = err.Error() + ", "
+		// the merge may leave the loaded config files partially merged
+		diskConfig, diskConfigPaths, err = c.load()
+		if err != nil {
+			return err
+		}
+	}
+
+	switch event.Resolution {
+	case v1.ConfigConflictAPIWins:
+		snapshotPath, err := c.snapshotPath("tar.gz")
+		if err == nil {
+			err = utils.TarGZ(snapshotPath, diskConfigPaths)
+		}
+		if err != nil {
+			event.Message += fmt.Sprintf("failed to snapshot the config files, the conflict is left unresolved. %v", err)
+			return nil
+		}
+		event.SnapshotPath = snapshotPath
+		event.Message += "the changes of the config files are overwritten by the api"
+		return c.overwrite(diskConfig, diskConfigPaths)
+	default:
+		snapshotPath, err := c.snapshotPath("json")
+		if err == nil {
+			err = ioutil.WriteFile(snapshotPath, c.configuration.Json(), 0600)
+		}
+		if err != nil {
+			event.Message += fmt.Sprintf("failed to snapshot the configuration, the conflict is left unresolved. %v", err)
+			return nil
+		}
+		event.SnapshotPath = snapshotPath
+		event.Message += "the changes of the api are discarded by the config files"
+		err = c.configuration.renewConfiguration(diskConfig)
+		if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) {
+			return err
+		}
+		c.synchronized(diskConfigPaths)
+		return nil
+	}
+}
+
+// merge merges the changes of the configuration into the changed config files, since they were last synchronized.
+func (c *configManager) merge(diskConfig Configuration) error {
+	base, isBase := c.baseConfiguration.(*configuration)
+	mine, isMine := c.configuration.(*configuration)
+	theirs, isTheirs := diskConfig.(*configuration)
+	if !isBase || !isMine || !isTheirs {
+		return errors.WithCode(code.ErrConfigMergeFailed, "the last synchronized config files are unknown")
+	}
+	mine.rwLocker.RLock()
+	defer mine.rwLocker.RUnlock()
+	return mergeContext(base.config, mine.config, theirs.config)
+}
+
+// snapshotPath returns the path of the snapshot file in the backup directory.
+func (c *configManager) snapshotPath(ext string) (string, error) {
+	dir := c.backupDir
+	if dir == "" {
+		dir = filepath.Dir(c.mainConfigPath)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s.conflict.%s.%s", filepath.Base(c.mainConfigPath), time.Now().Format("20060102150405.000"), ext)), nil
+}
+
+func (c *configManager) recordConflict(event *v1.ConfigConflict) {
+	log.Warnf("config files '%s' and the configuration are both changed, policy: %s, resolution: %s, code: %d. %s", c.mainConfigPath, event.Policy, event.Resolution, event.Code, event.Message)
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
+	c.conflicts = append(c.conflicts, event)
+	if len(c.conflicts) > maxRecentConflicts {
+		c.conflicts = c.conflicts[len(c.conflicts)-maxRecentConflicts:]
+	}
+}
+
+func (c *configManager) GetConflicts() []*v1.ConfigConflict {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	conflicts := make([]*v1.ConfigConflict, len(c.conflicts))
+	copy(conflicts, c.conflicts)
+	return conflicts
+}
+
+// overwrite saves the configuration to the config files atomically, and removes the old config files which are no
+// longer part of the configuration only after the check passes. The old configs are restored if the save or the check
+// fails.
+func (c *configManager) overwrite(oldConfig Configuration, oldConfigPaths []string) error {
 	// 2) 不一致则save内存配置
-	// remove old configs
-	err = utils.RemoveFiles(oldConfigPaths)
+	configPaths, err := c.save()
+	if err == nil {
+		// 3) check
+		err = c.Check()
+	}
 	if err != nil {
+		// 3) save或check失败则将old配置写入内存和写入本地文件，并清理新增的配置文件
+		if rollbackErr := c.rollback(oldConfig, oldConfigPaths, configPaths); rollbackErr != nil {
+			log.Errorf("failed to restore the config files '%s', %+v", c.mainConfigPath, rollbackErr)
+		}
 		return err
 	}
 
-	configPaths, err := c.save()
+	// 4) check成功则清理不再引用的old配置文件
+	err = utils.RemoveFiles(subtractPaths(oldConfigPaths, configPaths))
 	if err != nil {
+		log.Warnf("failed to remove the config files no longer included, %v", err)
+	}
+
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
+	// 5) 更新manager配置指纹为内存配置指纹
+	c.configFilesFingerprint.Renew(c.configuration.getConfigFingerprinter())
+	c.configPaths = configPaths
+	c.mainConfigPath = c.configuration.getMainConfigPath()
+	c.renewBaseConfiguration()
+	c.state.saved()
+	return nil
+}
+
+// rollback restores the old configs to the configuration and the config files, and removes the new config files which
+// are not part of the old configs.
+func (c *configManager) rollback(oldConfig Configuration, oldConfigPaths, newConfigPaths []string) error {
+	err := c.configuration.renewConfiguration(oldConfig)
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) {
 		return err
 	}
 	defer func() {
-		if err != nil {
-			// 3) check失败则将old配置写入内存和写入本地文件，更新manager配置指纹为old配置指纹
-			err = c.configuration.renewConfiguration(oldConfig)
-			c.configFilesFingerprint.Renew(oldConfig.getConfigFingerprinter())
-			err = utils.RemoveFiles(configPaths)
-			configPaths, err = c.save()
-			err = c.Check()
-		}
 		c.rwLocker.Lock()
 		defer c.rwLocker.Unlock()
-		// 3) check成功则更新manager配置指纹为内存配置指纹
-		c.configFilesFingerprint.Renew(c.configuration.getConfigFingerprinter())
-		c.configPaths = configPaths
+		c.configFilesFingerprint.Renew(oldConfig.getConfigFingerprinter())
+		c.configPaths = oldConfigPaths
 		c.mainConfigPath = c.configuration.getMainConfigPath()
+		c.renewBaseConfiguration()
 	}()
-
-	// 3) check
-	return c.Check()
+	if _, err = c.save(); err != nil {
+		return err
+	}
+	return utils.RemoveFiles(subtractPaths(newConfigPaths, oldConfigPaths))
 }
 
+// save writes the dumps of the configuration to the config files atomically, and returns the paths written, even if it
+// fails halfway.
 func (c configManager) save() ([]string, error) {
 	dumps := c.configuration.Dump()
 	configPaths := make([]string, 0)
@@ -336,21 +675,31 @@ func (c configManager) save() ([]string, error) {
 			fmt.Println(string(bytes))
 			continue
 		}
-		err := ioutil.WriteFile(s, bytes, 0755)
+		err := utils.WriteFileAtomic(s, bytes, c.options.FileMode)
 		if err != nil {
-			return nil, err
+			return configPaths, err
 		}
 
-		/*// debug test
-		fmt.Println(s, ":")
-		fmt.Println(string(bytes))
-		// debug test end*/
-
 		configPaths = append(configPaths, s)
 	}
 	return configPaths, nil
 }
 
+// subtractPaths returns the paths in a but not in b.
+func subtractPaths(a, b []string) []string {
+	exists := make(map[string]bool, len(b))
+	for _, path := range b {
+		exists[path] = true
+	}
+	paths := make([]string, 0)
+	for _, path := range a {
+		if !exists[path] {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
 func (c configManager) Check() error {
 	// 判断是否为单元测试
 	if arrays.ContainsString(os.Args, "-test.v") >= 0 && arrays.ContainsString(os.Args, "-test.run") >= 0 {
@@ -366,35 +715,76 @@ func (c configManager) Check() error {
 	// debug test end*/
 }
 
+func (c configManager) ServerReload() error {
+	// 判断是否为单元测试
+	if arrays.ContainsString(os.Args, "-test.v") >= 0 && arrays.ContainsString(os.Args, "-test.run") >= 0 {
+		return nil
+	}
+	cmd := c.serverBinCMD("-s", "reload")
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// serverBinCMD returns the command of the server binary file with the arguments, which runs with the prefix, the extra
+// arguments and the main config of the server instance.
 func (c configManager) serverBinCMD(arg ...string) *exec.Cmd {
-	arg = append(arg, "-c", c.mainConfigPath)
-	return exec.Command(c.serverBinPath, arg...)
+	args := make([]string, 0, len(arg)+len(c.options.ExtraArgs)+4)
+	if c.options.Prefix != "" {
+		args = append(args, "-p", c.options.Prefix)
+	}
+	args = append(args, c.options.ExtraArgs...)
+	args = append(args, arg...)
+	args = append(args, "-c", c.mainConfigPath)
+	return exec.Command(c.serverBinPath, args...)
+}
+
+// serverPrefix returns the absolute prefix path of the server instance, which the relative pid and log paths are
+// resolved with.
+func (c configManager) serverPrefix() (string, error) {
+	if c.options.Prefix != "" {
+		return filepath.Abs(c.options.Prefix)
+	}
+	svrBinAbs, err := filepath.Abs(c.serverBinPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(filepath.Join(filepath.Dir(svrBinAbs), ".."))
+}
+
+// globalDirective returns the value of the directive in the globals passed with `-g`, e.g. `-g "pid run/nginx.pid;"`.
+func globalDirective(args []string, name string) string {
+	value := ""
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] != "-g" {
+			continue
+		}
+		for _, directive := range strings.Split(args[i+1], ";") {
+			fields := strings.Fields(directive)
+			if len(fields) == 2 && fields[0] == name {
+				value = fields[1]
+			}
+		}
+	}
+	return value
 }
 
 func (c *configManager) Start() error {
 	if c.isRunning {
 		return errors.WithCode(code.ErrConfigManagerIsRunning, "config manager is already running")
 	}
-	c.waitGroup.Add(3)
-	go func() {
-		err := c.regularlyBackup(time.Minute*5, c.backupSignalChan)
-		if err != nil {
-			log.Errorf("regularly backup error. %+v", err)
-		}
-	}()
-	go func() {
-		err := c.regularlyReload(time.Second*30, c.reloadSignalChan)
-		if err != nil {
-			log.Errorf("regularly reload error. %+v", err)
-		}
-	}()
-	go func() {
-		err := c.regularlySave(time.Second*10, c.saveSignalChan)
-		if err != nil {
-			log.Errorf("regularly save error. %+v", err)
-		}
-	}()
+	// set before the goroutines start, as they copy the manager
 	c.isRunning = true
+	c.state.setRunning(true)
+	c.waitGroup.Add(3)
+	go c.supervise("backup", c.backupSignalChan, func() error {
+		return c.regularlyBackup(time.Minute*5, c.backupSignalChan)
+	})
+	go c.supervise("reload", c.reloadSignalChan, func() error {
+		return c.regularlyReload(c.options.ReloadInterval, c.reloadSignalChan)
+	})
+	go c.supervise("save", c.saveSignalChan, func() error {
+		return c.regularlySave(c.options.SaveInterval, c.saveSignalChan)
+	})
 	return nil
 }
 
@@ -425,10 +815,24 @@ func (c *configManager) Stop() error {
 		return errors.New(errorStr)
 	}
 	c.isRunning = false
+	c.state.setRunning(false)
 	return nil
 }
 
+// waitStopSignal blocks until the stop signal is received, so that Stop will not time out on an exited loop.
+func waitStopSignal(signalChan chan int) {
+	for signal := range signalChan {
+		if signal == 9 {
+			return
+		}
+	}
+}
+
 func NewNginxConfigurationManager(loader loader.Loader, configuration Configuration, serverBinPath, backupDir string, backupCycle, backupSaveTime int, rwLocker *sync.RWMutex) ConfigManager {
+	return NewNginxConfigurationManagerWithOptions(loader, configuration, serverBinPath, backupDir, backupCycle, backupSaveTime, ManagerOptions{}, rwLocker)
+}
+
+func NewNginxConfigurationManagerWithOptions(loader loader.Loader, configuration Configuration, serverBinPath, backupDir string, backupCycle, backupSaveTime int, options ManagerOptions, rwLocker *sync.RWMutex) ConfigManager {
 	fingerprinter := utils.NewConfigFingerprinter(make(map[string][]byte))
 	fingerprinter.Renew(configuration.getConfigFingerprinter())
 	cm := &configManager{
@@ -441,6 +845,9 @@ func NewNginxConfigurationManager(loader loader.Loader, configuration Configurat
 		backupDir:              backupDir,
 		backupCycle:            backupCycle,
 		backupSaveTime:         backupSaveTime,
+		options:                options.complete(),
+		state:                  newManagerState(),
+		syncLocker:             new(sync.Mutex),
 		rwLocker:               rwLocker,
 		backupSignalChan:       make(chan int),
 		reloadSignalChan:       make(chan int),
@@ -450,5 +857,6 @@ func NewNginxConfigurationManager(loader loader.Loader, configuration Configurat
 	for s := range cm.configuration.Dump() {
 		cm.configPaths = append(cm.configPaths, s)
 	}
+	cm.renewBaseConfiguration()
 	return cm
 }
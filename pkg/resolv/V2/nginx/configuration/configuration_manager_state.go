@@ -0,0 +1,205 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/utils"
+	"github.com/marmotedu/errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	minLoopBackoff = time.Second
+	maxLoopBackoff = time.Minute
+)
+
+var managerLoops = []string{"backup", "reload", "save"}
+
+// managerState records the running state of the config manager loops, and the last times the configuration was
+// synchronized with the config files.
+type managerState struct {
+	running        bool
+	loops          map[string]*v1.ConfigManagerLoop
+	lastReloadTime time.Time
+	lastSaveTime   time.Time
+	lastBackupTime time.Time
+	lastError      string
+	lastErrorTime  time.Time
+	locker         *sync.RWMutex
+}
+
+func newManagerState() *managerState {
+	loops := make(map[string]*v1.ConfigManagerLoop, len(managerLoops))
+	for _, name := range managerLoops {
+		loops[name] = &v1.ConfigManagerLoop{Name: name}
+	}
+	return &managerState{
+		loops:  loops,
+		locker: new(sync.RWMutex),
+	}
+}
+
+func (s *managerState) setRunning(running bool) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.running = running
+}
+
+func (s *managerState) loopStarted(name string) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.loops[name].Running = true
+	s.loops[name].Backoff = 0
+}
+
+func (s *managerState) loopStopped(name string) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.loops[name].Running = false
+	s.loops[name].Backoff = 0
+}
+
+func (s *managerState) loopFailed(name string, err error, backoff time.Duration) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	now := time.Now()
+	loop := s.loops[name]
+	loop.Running = false
+	loop.Restarts++
+	loop.LastError = err.Error()
+	loop.LastErrorTime = now
+	loop.Backoff = backoff
+	s.lastError = err.Error()
+	s.lastErrorTime = now
+}
+
+func (s *managerState) reloaded() {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.lastReloadTime = time.Now()
+}
+
+func (s *managerState) saved() {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.lastSaveTime = time.Now()
+}
+
+func (s *managerState) backedUp() {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.lastBackupTime = time.Now()
+}
+
+func (s *managerState) get() *v1.ConfigManagerState {
+	s.locker.RLock()
+	defer s.locker.RUnlock()
+	state := &v1.ConfigManagerState{
+		Running:        s.running,
+		Loops:          make([]*v1.ConfigManagerLoop, 0, len(managerLoops)),
+		LastReloadTime: s.lastReloadTime,
+		LastSaveTime:   s.lastSaveTime,
+		LastBackupTime: s.lastBackupTime,
+		LastError:      s.lastError,
+		LastErrorTime:  s.lastErrorTime,
+	}
+	for _, name := range managerLoops {
+		loop := *s.loops[name]
+		state.Loops = append(state.Loops, &loop)
+	}
+	return state
+}
+
+// supervise runs the loop until it returns without error, which means the stop signal is received. The loop is
+// restarted with an exponential backoff if it fails or panics, so that a transient error will not stop the
+// synchronization forever.
+func (c *configManager) supervise(name string, signalChan chan int, loop func() error) {
+	backoff := minLoopBackoff
+	for {
+		c.state.loopStarted(name)
+		startTime := time.Now()
+		err := runLoop(loop)
+		if err == nil {
+			c.state.loopStopped(name)
+			return
+		}
+		// the loop has been running well for a while, restart it as soon as possible
+		if time.Since(startTime) > maxLoopBackoff {
+			backoff = minLoopBackoff
+		}
+		log.Errorf("regularly %s error, restart it after %s. %+v", name, backoff, err)
+		c.state.loopFailed(name, err, backoff)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case signal := <-signalChan:
+			timer.Stop()
+			if signal == 9 {
+				c.state.loopStopped(name)
+				return
+			}
+		}
+		backoff *= 2
+		if backoff > maxLoopBackoff {
+			backoff = maxLoopBackoff
+		}
+	}
+}
+
+func runLoop(loop func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return loop()
+}
+
+// GetState returns the state of the manager, with the fingerprints of the config files in the configuration, when last
+// synchronized, and on the disk now.
+func (c *configManager) GetState() *v1.ConfigManagerState {
+	state := c.state.get()
+
+	c.rwLocker.RLock()
+	synchronized := c.configFilesFingerprint.Fingerprints()
+	c.rwLocker.RUnlock()
+	configuration := c.configuration.getConfigFingerprinter().Fingerprints()
+
+	paths := make([]string, 0, len(configuration))
+	for path := range configuration {
+		paths = append(paths, path)
+	}
+	for path := range synchronized {
+		if _, has := configuration[path]; !has {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	state.InSync = true
+	state.Files = make([]*v1.ConfigFileFingerprint, 0, len(paths))
+	for _, path := range paths {
+		file := &v1.ConfigFileFingerprint{
+			Path:          path,
+			Configuration: configuration[path],
+			Synchronized:  synchronized[path],
+			Disk:          diskFingerprint(path),
+		}
+		if file.Configuration != file.Synchronized || file.Synchronized != file.Disk {
+			state.InSync = false
+		}
+		state.Files = append(state.Files, file)
+	}
+	return state
+}
+
+func diskFingerprint(path string) string {
+	data, err := utils.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return utils.NewConfigFingerprinter(map[string][]byte{path: data}).Fingerprints()[path]
+}
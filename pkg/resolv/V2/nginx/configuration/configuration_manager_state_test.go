@@ -0,0 +1,69 @@
+package configuration
+
+import (
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConfigManager_supervise(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-state-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err = ioutil.WriteFile(confPath, []byte("http {\n    server {\n        listen 80;\n    }\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewNginxConfigurationManager(loader.NewLoader(), c, "/bin/true", "", 0, 0, new(sync.RWMutex)).(*configManager)
+
+	// the loop fails at first, and waits for the stop signal after restarted
+	signalChan := make(chan int)
+	runs := 0
+	done := make(chan struct{})
+	go func() {
+		manager.supervise("reload", signalChan, func() error {
+			runs++
+			if runs == 1 {
+				return errors.New("failed to reload")
+			}
+			waitStopSignal(signalChan)
+			return nil
+		})
+		close(done)
+	}()
+
+	time.Sleep(minLoopBackoff + time.Millisecond*500)
+	loop := manager.GetState().Loops[1]
+	if loop.Name != "reload" || !loop.Running || loop.Restarts != 1 || loop.LastError != "failed to reload" {
+		t.Errorf("the failed loop is not restarted: %+v", loop)
+	}
+	signalChan <- 9
+	<-done
+	if loop = manager.GetState().Loops[1]; loop.Running {
+		t.Errorf("the stopped loop is still running: %+v", loop)
+	}
+
+	state := manager.GetState()
+	if !state.InSync || len(state.Files) != 1 || state.Files[0].Disk == "" {
+		t.Errorf("the configuration should be in sync: %+v", state.Files)
+	}
+	if err = ioutil.WriteFile(confPath, []byte("http {\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	state = manager.GetState()
+	if state.InSync || state.Files[0].Disk == state.Files[0].Synchronized {
+		t.Errorf("the changed config file should be out of sync: %+v", state.Files[0])
+	}
+}
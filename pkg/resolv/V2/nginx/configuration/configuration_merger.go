@@ -0,0 +1,173 @@
+package configuration
+
+import (
+	"fmt"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/marmotedu/errors"
+	"strings"
+)
+
+// mergeContext merges the changes of mine and theirs since the base into theirs, like diff3 over the children of the
+// contexts: the children unchanged on both sides are kept, the children changed on only one side take the change, and
+// the contexts changed on both sides are merged recursively. An error is returned if both sides changed the same
+// directive differently or changed adjacent children, and theirs may be left partially merged.
+func mergeContext(base, mine, theirs parser.Context) error {
+	merged, err := mergeChildren(children(base), children(mine), children(theirs))
+	if err != nil {
+		return errors.WithCode(code.ErrConfigMergeFailed, "failed to merge %s: %v", NodeName(theirs), err)
+	}
+	for theirs.Len() > 0 {
+		if err = theirs.Remove(0); err != nil {
+			return err
+		}
+	}
+	for i, child := range merged {
+		if err = theirs.Insert(child, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeChildren(base, mine, theirs []parser.Parser) ([]parser.Parser, error) {
+	baseSigs, mineSigs, theirsSigs := signatures(base), signatures(mine), signatures(theirs)
+	mineMatches := lcsMatches(baseSigs, mineSigs)
+	theirsMatches := lcsMatches(baseSigs, theirsSigs)
+
+	merged := make([]parser.Parser, 0, len(theirs))
+	b, m, t := 0, 0, 0
+	for i := range base {
+		mi, inMine := mineMatches[i]
+		ti, inTheirs := theirsMatches[i]
+		if !inMine || !inTheirs {
+			continue
+		}
+		// base[i] is stable, resolve the chunk before it
+		chunk, err := mergeChunk(base[b:i], mine[m:mi], theirs[t:ti], baseSigs[b:i], mineSigs[m:mi], theirsSigs[t:ti])
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, chunk...)
+		merged = append(merged, theirs[ti])
+		b, m, t = i+1, mi+1, ti+1
+	}
+	chunk, err := mergeChunk(base[b:], mine[m:], theirs[t:], baseSigs[b:], mineSigs[m:], theirsSigs[t:])
+	if err != nil {
+		return nil, err
+	}
+	return append(merged, chunk...), nil
+}
+
+func mergeChunk(base, mine, theirs []parser.Parser, baseSigs, mineSigs, theirsSigs []string) ([]parser.Parser, error) {
+	switch {
+	case equalSignatures(mineSigs, baseSigs):
+		return theirs, nil
+	case equalSignatures(theirsSigs, baseSigs), equalSignatures(mineSigs, theirsSigs):
+		return mine, nil
+	}
+	// the same context changed on both sides
+	if len(base) == 1 && len(mine) == 1 && len(theirs) == 1 {
+		baseCtx, isBaseCtx := base[0].(parser.Context)
+		mineCtx, isMineCtx := mine[0].(parser.Context)
+		theirsCtx, isTheirsCtx := theirs[0].(parser.Context)
+		if isBaseCtx && isMineCtx && isTheirsCtx && sameContext(baseCtx, mineCtx) && sameContext(baseCtx, theirsCtx) {
+			if err := mergeContext(baseCtx, mineCtx, theirsCtx); err != nil {
+				return nil, err
+			}
+			return theirs, nil
+		}
+	}
+	return nil, errors.Errorf("conflicting changes, the api changed [%s], the files changed [%s]", describe(mine), describe(theirs))
+}
+
+// lcsMatches returns the index pairs of the longest common subsequence of the two signature lists.
+func lcsMatches(a, b []string) map[int]int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	matches := make(map[int]int)
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			matches[i] = j
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+func children(ctx parser.Context) []parser.Parser {
+	parsers := make([]parser.Parser, 0, ctx.Len())
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			break
+		}
+		parsers = append(parsers, child)
+	}
+	return parsers
+}
+
+func signatures(parsers []parser.Parser) []string {
+	sigs := make([]string, len(parsers))
+	for i, p := range parsers {
+		sigs[i] = signature(p)
+	}
+	return sigs
+}
+
+// signature identifies the content of the parser, regardless of its indention.
+func signature(p parser.Parser) string {
+	sig := p.GetType().String() + "\x00" + p.GetValue()
+	if ctx, ok := p.(parser.Context); ok {
+		sig += "{" + strings.Join(signatures(children(ctx)), "\x01") + "}"
+	}
+	return sig
+}
+
+func equalSignatures(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameContext(a, b parser.Context) bool {
+	return a.GetType() == b.GetType() && a.GetValue() == b.GetValue()
+}
+
+func describe(parsers []parser.Parser) string {
+	names := make([]string, len(parsers))
+	for i, p := range parsers {
+		if ctx, ok := p.(parser.Context); ok {
+			names[i] = NodeName(ctx)
+		} else {
+			names[i] = fmt.Sprintf("%s %s", p.GetType(), p.GetValue())
+		}
+	}
+	return strings.Join(names, "; ")
+}
@@ -0,0 +1,156 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const mergeTestConfig = `http {
+    server {
+        listen 80;
+        server_name a.example.com;
+    }
+    server {
+        listen 80;
+        server_name m.example.com;
+    }
+    server {
+        listen 80;
+        server_name b.example.com;
+    }
+}
+`
+
+func writeMergeTestConfig(t *testing.T, dir, conf string) string {
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return confPath
+}
+
+func TestMergeContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-merge-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := writeMergeTestConfig(t, dir, mergeTestConfig)
+	base, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the api changes server a
+	key, err := mine.Query("key:sep: server_name a.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mine.InsertByQueryer(parser.NewKey("client_max_body_size", "10m", key.Self().GetIndention()), key); err != nil {
+		t.Fatal(err)
+	}
+	// the config file changes server b, and adds server c
+	writeMergeTestConfig(t, dir, strings.Replace(mergeTestConfig, "server_name b.example.com;\n    }\n", "server_name b.example.com;\n        root /data;\n    }\n    server {\n        listen 80;\n        server_name c.example.com;\n    }\n", 1))
+	theirs, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = mergeContext(base.(*configuration).config, mine.(*configuration).config, theirs.(*configuration).config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, keyword := range []string{"key:sep: client_max_body_size 10m", "key:sep: root /data", "key:sep: server_name c.example.com"} {
+		if _, err := theirs.Query(keyword); err != nil {
+			t.Errorf("'%s' is not merged:\n%s", keyword, theirs.View())
+		}
+	}
+
+	// both sides change the same directive
+	mine, _ = NewConfigurationFromPath(confPath)
+	theirs, _ = NewConfigurationFromPath(confPath)
+	base, _ = NewConfigurationFromPath(confPath)
+	if err = mine.ModifyByKeyword(parser.NewKey("listen", "8080", key.Self().GetIndention()), "key:sep: listen 80"); err != nil {
+		t.Fatal(err)
+	}
+	if err = theirs.ModifyByKeyword(parser.NewKey("listen", "8081", key.Self().GetIndention()), "key:sep: listen 80"); err != nil {
+		t.Fatal(err)
+	}
+	err = mergeContext(base.(*configuration).config, mine.(*configuration).config, theirs.(*configuration).config)
+	if !errors.IsCode(err, code.ErrConfigMergeFailed) {
+		t.Errorf("got error %v merging the conflicting changes, want ErrConfigMergeFailed", err)
+	}
+}
+
+func TestConfigManager_resolveConflict(t *testing.T) {
+	for _, policy := range []v1.ConfigConflictPolicy{v1.ConfigConflictDiskWins, v1.ConfigConflictAPIWins, v1.ConfigConflictMerge} {
+		func() {
+			dir, err := ioutil.TempDir("", "bifrost-conflict-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			confPath := writeMergeTestConfig(t, dir, mergeTestConfig)
+			c, err := NewConfigurationFromPath(confPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the verify binary always passes the check
+			manager := NewNginxConfigurationManagerWithOptions(loader.NewLoader(), c, "/bin/true", "", 0, 0, ManagerOptions{ConflictPolicy: policy}, new(sync.RWMutex)).(*configManager)
+
+			if err = c.RemoveByKeyword("key:sep: server_name a.example.com"); err != nil {
+				t.Fatal(err)
+			}
+			writeMergeTestConfig(t, dir, strings.Replace(mergeTestConfig, "b.example.com", "b.example.org", 1))
+			if err = manager.SaveWithCheck(); err != nil {
+				t.Fatalf("%s: %+v", policy, err)
+			}
+
+			conflicts := manager.GetConflicts()
+			if len(conflicts) != 1 || conflicts[0].Policy != policy {
+				t.Fatalf("%s: got conflicts %+v", policy, conflicts)
+			}
+			_, err = c.Query("key:sep: server_name a.example.com")
+			apiKept := err != nil
+			_, err = c.Query("key:sep: server_name b.example.org")
+			diskKept := err == nil
+			data, err := ioutil.ReadFile(confPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch policy {
+			case v1.ConfigConflictDiskWins:
+				if apiKept || !diskKept || conflicts[0].SnapshotPath == "" {
+					t.Errorf("%s: the changes of the api are not discarded with a snapshot: %+v", policy, conflicts[0])
+				}
+			case v1.ConfigConflictAPIWins:
+				if !apiKept || diskKept || strings.Contains(string(data), "b.example.org") || conflicts[0].SnapshotPath == "" {
+					t.Errorf("%s: the changes of the config files are not overwritten with a snapshot: %+v", policy, conflicts[0])
+				}
+			case v1.ConfigConflictMerge:
+				if !apiKept || !diskKept || strings.Contains(string(data), "a.example.com") || !strings.Contains(string(data), "b.example.org") {
+					t.Errorf("%s: the changes are not merged: %+v\n%s", policy, conflicts[0], data)
+				}
+			}
+			if conflicts[0].SnapshotPath != "" {
+				if _, err = os.Stat(conflicts[0].SnapshotPath); err != nil {
+					t.Errorf("%s: %v", policy, err)
+				}
+			}
+		}()
+	}
+}
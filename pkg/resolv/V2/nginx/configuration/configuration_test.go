@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -116,3 +120,108 @@ func TestConfiguration_InsertLoopConfig(t *testing.T) {
 
 	fmt.Println(string(config.View()))
 }
+
+func TestConfiguration_AppendSnippetByKeyword(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-snippet-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := "http {\n    server {\n        listen 80;\n        server_name a.example.com;\n    }\n}\n"
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snippet := []byte("server {\n    listen 8080;\n    server_name b.example.com;\n    location / {\n        proxy_pass http://127.0.0.1:8081;\n    }\n}\n")
+	if err = c.AppendSnippetByKeyword(snippet, "http"); err != nil {
+		t.Fatal(err)
+	}
+	// the snippet is appended to the server of the directive queried
+	if err = c.AppendSnippetByKeyword([]byte("access_log off;"), "key:sep: server_name a.example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "http {\n    server {\n        listen 80;\n        server_name a.example.com;\n        access_log off;\n    }\n" +
+		"    server {\n        listen 8080;\n        server_name b.example.com;\n        location / {\n            proxy_pass http://127.0.0.1:8081;\n        }\n    }\n}\n"
+	if got := string(c.View()); got != want {
+		t.Errorf("AppendSnippetByKeyword() view = \n%s\nwant:\n%s", got, want)
+	}
+
+	for _, invalid := range []string{"server {\n    listen 80;\n", "include conf.d/*.conf;", "}"} {
+		if err = c.AppendSnippetByKeyword([]byte(invalid), "http"); err == nil {
+			t.Errorf("AppendSnippetByKeyword(%q) want an error", invalid)
+		}
+	}
+	if err = c.AppendSnippetByKeyword(snippet, "stream"); err == nil {
+		t.Error("AppendSnippetByKeyword() to an absent context want an error")
+	}
+}
+
+// insertFailingContext fails to insert the parsers beyond the length limit.
+type insertFailingContext struct {
+	parser.Context
+	limit int
+}
+
+func (c *insertFailingContext) Insert(p parser.Parser, index int) error {
+	if c.Len() >= c.limit {
+		return parser.ErrIndexOutOfRange
+	}
+	return c.Context.Insert(p, index)
+}
+
+func TestConfiguration_insertAll(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-snippet-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := "http {\n    server {\n        listen 80;\n    }\n}\n"
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := c.(*configuration)
+	keyword, err := parseKeyword("http")
+	if err != nil {
+		t.Fatal(err)
+	}
+	target, idx := config.config.Query(keyword)
+	child, err := target.GetChild(idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := child.(parser.Context)
+	parsers, err := loader.LoadSnippet(confPath, []byte("server_tokens off;\nautoindex off;\ngzip on;\n"), http.GetIndention().NextIndention())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the third parser fails to be inserted, and the first two inserted are removed
+	failing := &insertFailingContext{Context: http, limit: http.Len() + 2}
+	if err = config.insertAll(parsers, failing, failing.Len()); err == nil {
+		t.Fatal("insertAll() beyond the limit want an error")
+	}
+	if got := string(c.View()); got != conf {
+		t.Errorf("insertAll() failed leaves the view = \n%s\nwant:\n%s", got, conf)
+	}
+
+	if err = config.insertAll(parsers, http, http.Len()); err != nil {
+		t.Fatal(err)
+	}
+	want := "http {\n    server {\n        listen 80;\n    }\n    server_tokens off;\n    autoindex off;\n    gzip on;\n}\n"
+	if got := string(c.View()); got != want {
+		t.Errorf("insertAll() view = \n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,208 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"strings"
+)
+
+const (
+	// CombinedLogFormat is the name of the log format predefined by nginx.
+	CombinedLogFormat = "combined"
+	// DefaultAccessLog is the access log of the http block without any `access_log` directive.
+	DefaultAccessLog = "logs/access.log"
+	// DefaultErrorLog is the error log of the main config without any `error_log` directive.
+	DefaultErrorLog = "logs/error.log"
+)
+
+// LogFormats returns the `log_format` directives of the http blocks by their names, the first one of the name is
+// returned, since nginx rejects the duplicate ones. The predefined `combined` format is not included.
+func LogFormats(c Configuration) map[string]*v1.LogFormat {
+	formats := make(map[string]*v1.LogFormat)
+	for _, http := range httpContexts(c) {
+		for _, key := range ContextKeys(http, "log_format") {
+			args := DirectiveArgs(key.Value)
+			if len(args) < 2 {
+				continue
+			}
+			format := &v1.LogFormat{Name: args[0], Position: key.GetPosition()}
+			args = args[1:]
+			if strings.HasPrefix(args[0], "escape=") {
+				format.Escape = strings.TrimPrefix(args[0], "escape=")
+				args = args[1:]
+			}
+			format.Format = strings.Join(args, "")
+			if _, has := formats[format.Name]; !has {
+				formats[format.Name] = format
+			}
+		}
+	}
+	return formats
+}
+
+// AccessLogs returns the `access_log` directives writing to files of the http blocks, servers and locations, the
+// default one is returned for the http block without any `access_log` directive. The format is `combined` if it is
+// not set.
+func AccessLogs(c Configuration) []*v1.AccessLog {
+	logs := make([]*v1.AccessLog, 0)
+	for _, http := range httpContexts(c) {
+		httpKeys := ContextKeys(http, "access_log")
+		if len(httpKeys) == 0 {
+			logs = append(logs, &v1.AccessLog{Path: DefaultAccessLog, Format: CombinedLogFormat, Position: c.getMainConfigPath()})
+		}
+		for _, key := range httpKeys {
+			if log := accessLog(locatedKey{Key: key}, nil); log != nil {
+				logs = append(logs, log)
+			}
+		}
+		for _, server := range ContextChildren(http, parser_type.TypeServer) {
+			serverNames := ServerNames(server)
+			for _, key := range locatedKeys(server, "access_log", "") {
+				if log := accessLog(key, serverNames); log != nil {
+					logs = append(logs, log)
+				}
+			}
+		}
+	}
+	return logs
+}
+
+func accessLog(key locatedKey, serverNames []string) *v1.AccessLog {
+	args := DirectiveArgs(key.Value)
+	if len(args) == 0 || !isLogFile(args[0]) {
+		return nil
+	}
+	log := &v1.AccessLog{Path: args[0], Format: CombinedLogFormat, ServerNames: serverNames, Location: key.location, Position: key.GetPosition()}
+	if len(args) > 1 && !strings.Contains(args[1], "=") {
+		log.Format = args[1]
+	}
+	return log
+}
+
+// ErrorLogs returns the `error_log` directives writing to files of the main config, the http blocks, servers and
+// locations, the default one is returned for the main config without any `error_log` directive.
+func ErrorLogs(c Configuration) []*v1.ErrorLog {
+	logs := make([]*v1.ErrorLog, 0)
+	main, ok := c.Self().(parser.Context)
+	if !ok {
+		return logs
+	}
+	mainKeys := ContextKeys(main, "error_log")
+	if len(mainKeys) == 0 {
+		logs = append(logs, &v1.ErrorLog{Path: DefaultErrorLog, Position: c.getMainConfigPath()})
+	}
+	for _, key := range mainKeys {
+		if log := errorLog(locatedKey{Key: key}, nil); log != nil {
+			logs = append(logs, log)
+		}
+	}
+	for _, http := range httpContexts(c) {
+		for _, key := range ContextKeys(http, "error_log") {
+			if log := errorLog(locatedKey{Key: key}, nil); log != nil {
+				logs = append(logs, log)
+			}
+		}
+		for _, server := range ContextChildren(http, parser_type.TypeServer) {
+			serverNames := ServerNames(server)
+			for _, key := range locatedKeys(server, "error_log", "") {
+				if log := errorLog(key, serverNames); log != nil {
+					logs = append(logs, log)
+				}
+			}
+		}
+	}
+	return logs
+}
+
+func errorLog(key locatedKey, serverNames []string) *v1.ErrorLog {
+	args := DirectiveArgs(key.Value)
+	if len(args) == 0 || !isLogFile(args[0]) || args[0] == "stderr" || strings.HasPrefix(args[0], "memory:") {
+		return nil
+	}
+	log := &v1.ErrorLog{Path: args[0], ServerNames: serverNames, Location: key.location, Position: key.GetPosition()}
+	if len(args) > 1 {
+		log.Level = args[1]
+	}
+	return log
+}
+
+// isLogFile returns false if the logs are disabled, written to syslog or to the paths of variables.
+func isLogFile(path string) bool {
+	return path != "off" && !strings.HasPrefix(path, "syslog:") && !strings.Contains(path, "$")
+}
+
+func httpContexts(c Configuration) []parser.Context {
+	contexts := make([]parser.Context, 0)
+	queriers, err := c.QueryAll(parser_type.TypeHttp.String())
+	if err != nil {
+		return contexts
+	}
+	for _, querier := range queriers {
+		if ctx, ok := querier.Self().(parser.Context); ok {
+			contexts = append(contexts, ctx)
+		}
+	}
+	return contexts
+}
+
+// locatedKey is a key with the name of the innermost location declaring it.
+type locatedKey struct {
+	*parser.Key
+	location string
+}
+
+// locatedKeys returns the keys with the name declared in the context and its nested contexts, with the names of the
+// locations declaring them, e.g. `location /api/`.
+func locatedKeys(ctx parser.Context, name, location string) []locatedKey {
+	keys := make([]locatedKey, 0)
+	for _, key := range ContextKeys(ctx, name) {
+		keys = append(keys, locatedKey{Key: key, location: location})
+	}
+	for _, subCtx := range SubContexts(ctx) {
+		subLocation := location
+		if subCtx.GetType() == parser_type.TypeLocation {
+			subLocation = NodeName(subCtx)
+		}
+		keys = append(keys, locatedKeys(subCtx, name, subLocation)...)
+	}
+	return keys
+}
+
+// DirectiveArgs splits the value of a directive into the arguments, the quotes of the arguments are removed, and the
+// characters escaped by `\` are unescaped.
+func DirectiveArgs(value string) []string {
+	args := make([]string, 0)
+	var arg strings.Builder
+	inArg := false
+	var quote byte
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '\\' && i+1 < len(value):
+			i++
+			arg.WriteByte(value[i])
+			inArg = true
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteByte(c)
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteByte(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args
+}
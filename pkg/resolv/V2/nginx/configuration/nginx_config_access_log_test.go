@@ -0,0 +1,132 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAccessLogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-access-log-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := "http {\n" +
+		"    log_format  main  '$remote_addr - $remote_user [$time_local] \"$request\" '\n" +
+		"                      '$status $body_bytes_sent rt=$request_time';\n" +
+		"    log_format json escape=json '{\"host\":\"$host\"}';\n" +
+		"    access_log  logs/access.log  main buffer=32k;\n" +
+		"    server {\n" +
+		"        server_name shop.example.com;\n" +
+		"        access_log /var/log/nginx/shop.log json;\n" +
+		"        location /static/ {\n" +
+		"            access_log off;\n" +
+		"        }\n" +
+		"        location /api/ {\n" +
+		"            access_log logs/api.log;\n" +
+		"            access_log syslog:server=127.0.0.1 main;\n" +
+		"        }\n" +
+		"    }\n" +
+		"}\n"
+	if err = ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formats := LogFormats(c)
+	if main := formats["main"]; main == nil || main.Format != `$remote_addr - $remote_user [$time_local] "$request" $status $body_bytes_sent rt=$request_time` {
+		t.Errorf("LogFormats() main = %+v", main)
+	}
+	if json := formats["json"]; json == nil || json.Escape != "json" || json.Format != `{"host":"$host"}` {
+		t.Errorf("LogFormats() json = %+v", json)
+	}
+
+	logs := AccessLogs(c)
+	for _, log := range logs {
+		log.Position = ""
+	}
+	want := []*v1.AccessLog{
+		{Path: "logs/access.log", Format: "main"},
+		{Path: "/var/log/nginx/shop.log", Format: "json", ServerNames: []string{"shop.example.com"}},
+		{Path: "logs/api.log", Format: CombinedLogFormat, ServerNames: []string{"shop.example.com"}, Location: "location /api/"},
+	}
+	if !reflect.DeepEqual(logs, want) {
+		t.Errorf("AccessLogs() = %+v, want %+v", logs, want)
+	}
+
+	if err = ioutil.WriteFile(confPath, []byte("http {\n    server {\n        listen 80;\n    }\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = NewConfigurationFromPath(confPath); err != nil {
+		t.Fatal(err)
+	}
+	if logs = AccessLogs(c); len(logs) != 1 || logs[0].Path != DefaultAccessLog || logs[0].Format != CombinedLogFormat {
+		t.Errorf("AccessLogs() without access_log = %+v, want the default one", logs)
+	}
+}
+
+func TestErrorLogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-error-log-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := "error_log logs/error.log warn;\n" +
+		"error_log stderr;\n" +
+		"http {\n" +
+		"    error_log syslog:server=127.0.0.1;\n" +
+		"    server {\n" +
+		"        server_name shop.example.com;\n" +
+		"        location /api/ {\n" +
+		"            error_log /var/log/nginx/api_error.log;\n" +
+		"        }\n" +
+		"    }\n" +
+		"}\n"
+	if err = ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := ErrorLogs(c)
+	for _, log := range logs {
+		log.Position = ""
+	}
+	want := []*v1.ErrorLog{
+		{Path: "logs/error.log", Level: "warn"},
+		{Path: "/var/log/nginx/api_error.log", ServerNames: []string{"shop.example.com"}, Location: "location /api/"},
+	}
+	if !reflect.DeepEqual(logs, want) {
+		t.Errorf("ErrorLogs() = %+v, want %+v", logs, want)
+	}
+
+	if err = ioutil.WriteFile(confPath, []byte("http {\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = NewConfigurationFromPath(confPath); err != nil {
+		t.Fatal(err)
+	}
+	if logs = ErrorLogs(c); len(logs) != 1 || logs[0].Path != DefaultErrorLog {
+		t.Errorf("ErrorLogs() without error_log = %+v, want the default one", logs)
+	}
+}
+
+func TestDirectiveArgs(t *testing.T) {
+	got := DirectiveArgs("main  'a \\'b\\'' \"c d\"\n  e")
+	want := []string{"main", "a 'b'", "c d", "e"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DirectiveArgs() = %q, want %q", got, want)
+	}
+}
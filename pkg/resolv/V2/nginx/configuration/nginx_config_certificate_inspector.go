@@ -0,0 +1,192 @@
+package configuration
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type CertificateInspector interface {
+	Certificates() []*v1.Certificate
+	ExpiryWarnings(threshold time.Duration) []*v1.CertificateWarning
+}
+
+type certificateInspector struct {
+	configuration Configuration
+	now           func() time.Time
+}
+
+// Certificates returns every certificate referenced by `ssl_certificate` directives of the http and stream servers.
+// Relative certificate paths are resolved against the nginx configuration prefix, the directory of the main config.
+func (ci *certificateInspector) Certificates() []*v1.Certificate {
+	confPrefix := filepath.Dir(ci.configuration.getMainConfigPath())
+	certs := make([]*v1.Certificate, 0)
+	for _, blockType := range []parser_type.ParserType{parser_type.TypeHttp, parser_type.TypeStream} {
+		blockQueriers, err := ci.configuration.QueryAll(blockType.String())
+		if err != nil {
+			continue
+		}
+		for _, blockQuerier := range blockQueriers {
+			blockCtx, ok := blockQuerier.Self().(parser.Context)
+			if !ok {
+				continue
+			}
+			inheritedCertKeys := ContextKeys(blockCtx, "ssl_certificate")
+			serverQueriers, err := blockQuerier.QueryAll("server")
+			if err != nil {
+				continue
+			}
+			for _, serverQuerier := range serverQueriers {
+				serverCtx, ok := serverQuerier.Self().(parser.Context)
+				if !ok {
+					continue
+				}
+				certKeys := ContextKeys(serverCtx, "ssl_certificate")
+				if len(certKeys) == 0 {
+					certKeys = inheritedCertKeys
+				}
+				serverNames := ServerNames(serverCtx)
+				for _, certKey := range certKeys {
+					certs = append(certs, inspectCertificate(confPrefix, certKey, serverNames))
+				}
+			}
+		}
+	}
+	return certs
+}
+
+// ExpiryWarnings returns the warnings of certificates which are expired or will expire within the threshold.
+func (ci *certificateInspector) ExpiryWarnings(threshold time.Duration) []*v1.CertificateWarning {
+	now := ci.now()
+	warnings := make([]*v1.CertificateWarning, 0)
+	for _, cert := range ci.Certificates() {
+		if cert.Error != "" {
+			continue
+		}
+		left := cert.NotAfter.Sub(now)
+		switch {
+		case left <= 0:
+			warnings = append(warnings, &v1.CertificateWarning{
+				Level:       v1.CertificateExpired,
+				Message:     fmt.Sprintf("certificate '%s' for %v expired at %s", cert.Path, cert.ServerNames, cert.NotAfter.Format(time.RFC3339)),
+				Certificate: cert,
+			})
+		case left <= threshold:
+			warnings = append(warnings, &v1.CertificateWarning{
+				Level:       v1.CertificateExpiring,
+				Message:     fmt.Sprintf("certificate '%s' for %v expires in %d day(s), at %s", cert.Path, cert.ServerNames, int(left.Hours()/24), cert.NotAfter.Format(time.RFC3339)),
+				Certificate: cert,
+			})
+		}
+	}
+	return warnings
+}
+
+func NewCertificateInspector(c Configuration) CertificateInspector {
+	return &certificateInspector{configuration: c, now: time.Now}
+}
+
+func inspectCertificate(confPrefix string, certKey *parser.Key, serverNames []string) *v1.Certificate {
+	cert := &v1.Certificate{
+		Path:        certKey.Value,
+		Position:    certKey.GetPosition(),
+		ServerNames: serverNames,
+	}
+	if strings.Contains(certKey.Value, "$") || strings.HasPrefix(certKey.Value, "data:") {
+		cert.Error = "certificate is loaded dynamically and cannot be inspected"
+		return cert
+	}
+	if !filepath.IsAbs(cert.Path) {
+		cert.Path = filepath.Join(confPrefix, cert.Path)
+	}
+
+	chain, err := loadCertificateChain(cert.Path)
+	if err != nil {
+		cert.Error = err.Error()
+		return cert
+	}
+	leaf := chain[0]
+	cert.ChainLength = len(chain)
+	cert.Subject = leaf.Subject.String()
+	cert.Issuer = leaf.Issuer.String()
+	cert.NotBefore = leaf.NotBefore
+	cert.NotAfter = leaf.NotAfter
+	cert.SANs = append(cert.SANs, leaf.DNSNames...)
+	for _, ip := range leaf.IPAddresses {
+		cert.SANs = append(cert.SANs, ip.String())
+	}
+
+	dnsNames := leaf.DNSNames
+	if len(dnsNames) == 0 && leaf.Subject.CommonName != "" {
+		dnsNames = []string{leaf.Subject.CommonName}
+	}
+	for _, ip := range leaf.IPAddresses {
+		dnsNames = append(dnsNames, ip.String())
+	}
+	for _, name := range serverNames {
+		if !certificateCovers(dnsNames, name) {
+			cert.UncoveredServerNames = append(cert.UncoveredServerNames, name)
+		}
+	}
+	cert.CoversServerNames = len(cert.UncoveredServerNames) == 0
+	return cert
+}
+
+func loadCertificateChain(path string) ([]*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	chain := make([]*x509.Certificate, 0)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no PEM certificate found in '%s'", path)
+	}
+	return chain, nil
+}
+
+// certificateCovers checks whether a nginx `server_name` is covered by the certificate SANs.
+// Regular expression and catch-all server names can not be checked, and are regarded as covered.
+func certificateCovers(sans []string, serverName string) bool {
+	name := strings.ToLower(serverName)
+	switch {
+	case name == "" || name == "_" || strings.HasPrefix(name, "~") || strings.HasSuffix(name, ".*"):
+		return true
+	case strings.HasPrefix(name, "."):
+		// `.example.com` is the special form of `example.com` and `*.example.com`
+		return certificateCovers(sans, name[1:]) && certificateCovers(sans, "*"+name)
+	}
+	for _, san := range sans {
+		san = strings.ToLower(san)
+		if san == name {
+			return true
+		}
+		if strings.HasPrefix(san, "*.") && !strings.HasPrefix(name, "*.") {
+			if i := strings.Index(name, "."); i > 0 && name[i:] == san[1:] {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package configuration
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestCertificate(t *testing.T, path string, notAfter time.Time, dnsNames ...string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    notAfter.Add(-time.Hour * 24 * 90),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCertificateInspector(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-cert-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	writeTestCertificate(t, filepath.Join(dir, "example.pem"), now.Add(time.Hour*24*10), "example.com", "*.example.com")
+	writeTestCertificate(t, filepath.Join(dir, "expired.pem"), now.Add(-time.Hour), "expired.test")
+	writeTestCertificate(t, filepath.Join(dir, "default.pem"), now.Add(time.Hour*24*365), "default.test")
+
+	conf := `http {
+    ssl_certificate default.pem;
+    server {
+        listen 443 ssl;
+        server_name example.com www.example.com other.test;
+        ssl_certificate example.pem;
+    }
+    server {
+        listen 443 ssl;
+        server_name expired.test;
+        ssl_certificate expired.pem;
+    }
+    server {
+        listen 443 ssl;
+        server_name default.test;
+    }
+    server {
+        listen 443 ssl;
+        server_name missing.test;
+        ssl_certificate missing.pem;
+    }
+}
+`
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inspector := NewCertificateInspector(c)
+	certs := inspector.Certificates()
+	if len(certs) != 4 {
+		t.Fatalf("got %d certificates, want 4", len(certs))
+	}
+
+	if certs[0].CoversServerNames || len(certs[0].UncoveredServerNames) != 1 || certs[0].UncoveredServerNames[0] != "other.test" {
+		t.Errorf("got uncovered server names %v, want [other.test]", certs[0].UncoveredServerNames)
+	}
+	if certs[2].Path != filepath.Join(dir, "default.pem") || !certs[2].CoversServerNames {
+		t.Errorf("inherited certificate is not inspected: %+v", certs[2])
+	}
+	if certs[3].Error == "" {
+		t.Errorf("missing certificate is not reported")
+	}
+
+	warnings := inspector.ExpiryWarnings(time.Hour * 24 * 30)
+	if len(warnings) != 2 {
+		t.Fatalf("got %d expiry warnings, want 2", len(warnings))
+	}
+	if warnings[0].Certificate.Path != certs[0].Path || warnings[0].Level != "expiring" {
+		t.Errorf("got warning %+v, want an expiring warning of %s", warnings[0], certs[0].Path)
+	}
+	if warnings[1].Certificate.Path != certs[1].Path || warnings[1].Level != "expired" {
+		t.Errorf("got warning %+v, want an expired warning of %s", warnings[1], certs[1].Path)
+	}
+}
+
+func TestCertificateCovers(t *testing.T) {
+	sans := []string{"example.com", "*.example.com"}
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"example.com", true},
+		{"www.example.com", true},
+		{"a.b.example.com", false},
+		{"*.example.com", true},
+		{".example.com", true},
+		{"example.org", false},
+		{"_", true},
+		{"~^www\\d+\\.example\\.org$", true},
+	}
+	for _, tt := range tests {
+		if got := certificateCovers(sans, tt.name); got != tt.want {
+			t.Errorf("certificateCovers(%v, %q) = %v, want %v", sans, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package configuration
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const ( // conflict lint rules
+	RuleDuplicateServerName    = "duplicate-server-name"
+	RuleDuplicateDefaultServer = "duplicate-default-server"
+	RuleDuplicateLocation      = "duplicate-location"
+	RuleShadowedLocation       = "shadowed-location"
+)
+
+var regLiteralPrefixPattern = regexp.MustCompile(`^\^((?:[^\\.+*?()|\[\]{}^$]|\\[./\-_~])*)$`)
+
+type ConflictAnalyzer interface {
+	Analyze() []*v1.LintIssue
+}
+
+type conflictAnalyzer struct {
+	configuration Configuration
+}
+
+// Analyze finds the http servers which declare the same `server_name` on the same listen socket, the sockets marked
+// `default_server` more than once, and the locations which are duplicated or can never be matched in their scope.
+func (ca *conflictAnalyzer) Analyze() []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	root, ok := ca.configuration.Self().(parser.Context)
+	if !ok {
+		return issues
+	}
+
+	sockets := make([]string, 0)
+	serverNameServers := make(map[string]map[string][]int)
+	defaultServers := make(map[string][]int)
+	positions := make([]string, 0)
+	for _, http := range ContextChildren(root, parser_type.TypeHttp) {
+		for _, server := range ContextChildren(http, parser_type.TypeServer) {
+			serverIdx := len(positions)
+			serverNames := ServerNames(server)
+			if len(serverNames) == 0 {
+				serverNames = []string{""}
+			}
+			positions = append(positions, fmt.Sprintf("%s (server_name %s)", server.GetPosition(), strings.Join(serverNames, " ")))
+			for _, listen := range Listens(server) {
+				if _, has := serverNameServers[listen.Socket]; !has {
+					sockets = append(sockets, listen.Socket)
+					serverNameServers[listen.Socket] = make(map[string][]int)
+				}
+				for _, name := range serverNames {
+					name = strings.ToLower(name)
+					serverNameServers[listen.Socket][name] = appendServer(serverNameServers[listen.Socket][name], serverIdx)
+				}
+				if listen.DefaultServer {
+					defaultServers[listen.Socket] = appendServer(defaultServers[listen.Socket], serverIdx)
+				}
+			}
+			issues = append(issues, analyzeLocations(server)...)
+		}
+	}
+
+	serverPositions := func(servers []int) []string {
+		ps := make([]string, 0, len(servers))
+		for _, i := range servers {
+			ps = append(ps, positions[i])
+		}
+		return ps
+	}
+	for _, socket := range sockets {
+		names := serverNameServers[socket]
+		for _, name := range sortedServerNames(names) {
+			if len(names[name]) > 1 {
+				issues = append(issues, &v1.LintIssue{
+					Rule:      RuleDuplicateServerName,
+					Message:   fmt.Sprintf("conflicting server name \"%s\" on %s, only the first server will be used", name, socket),
+					Positions: serverPositions(names[name]),
+				})
+			}
+		}
+		if len(defaultServers[socket]) > 1 {
+			issues = append(issues, &v1.LintIssue{
+				Rule:      RuleDuplicateDefaultServer,
+				Message:   fmt.Sprintf("a duplicate default server for %s", socket),
+				Positions: serverPositions(defaultServers[socket]),
+			})
+		}
+	}
+	return issues
+}
+
+func NewConflictAnalyzer(c Configuration) ConflictAnalyzer {
+	return &conflictAnalyzer{configuration: c}
+}
+
+type Listen struct {
+	Socket        string
+	DefaultServer bool
+	SSL           bool
+}
+
+// Listens returns the normalized listen sockets of the server, `*:80` will be returned if no `listen` is declared.
+func Listens(server parser.Context) []Listen {
+	listens := make([]Listen, 0)
+	for _, key := range ContextKeys(server, "listen") {
+		fields := strings.Fields(key.Value)
+		if len(fields) == 0 {
+			continue
+		}
+		listen := Listen{Socket: normalizeSocket(fields[0])}
+		for _, param := range fields[1:] {
+			switch param {
+			case "default_server", "default":
+				listen.DefaultServer = true
+			case "ssl":
+				listen.SSL = true
+			}
+		}
+		listens = append(listens, listen)
+	}
+	if len(listens) == 0 {
+		listens = append(listens, Listen{Socket: "*:80"})
+	}
+	return listens
+}
+
+func normalizeSocket(address string) string {
+	switch {
+	case strings.HasPrefix(address, "unix:"):
+		return address
+	case strings.HasPrefix(address, "["):
+		if strings.Contains(address, "]:") {
+			return address
+		}
+		return address + ":80"
+	}
+	host, port := "*", "80"
+	if i := strings.LastIndex(address, ":"); i >= 0 {
+		host, port = address[:i], address[i+1:]
+	} else if strings.Trim(address, "0123456789") == "" {
+		port = address
+	} else {
+		host = address
+	}
+	if host == "0.0.0.0" {
+		host = "*"
+	}
+	return host + ":" + port
+}
+
+type locationMatcher struct {
+	modifier string
+	pattern  string
+	position string
+}
+
+func (l locationMatcher) String() string {
+	if l.modifier == "" {
+		return "location " + l.pattern
+	}
+	return "location " + l.modifier + " " + l.pattern
+}
+
+func (l locationMatcher) isRegexp() bool {
+	return l.modifier == "~" || l.modifier == "~*"
+}
+
+func newLocationMatcher(location parser.Context) locationMatcher {
+	matcher := locationMatcher{position: location.GetPosition()}
+	fields := strings.Fields(location.GetValue())
+	switch {
+	case len(fields) > 1:
+		matcher.modifier, matcher.pattern = fields[0], strings.Join(fields[1:], " ")
+	case len(fields) == 1:
+		matcher.pattern = fields[0]
+		for _, modifier := range []string{"^~", "~*", "=", "~"} { // e.g. `location =/`
+			if strings.HasPrefix(fields[0], modifier) && len(fields[0]) > len(modifier) {
+				matcher.modifier, matcher.pattern = modifier, fields[0][len(modifier):]
+				break
+			}
+		}
+	}
+	return matcher
+}
+
+// analyzeLocations checks the locations of the context, and of the nested locations recursively.
+func analyzeLocations(ctx parser.Context) []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	locations := ContextChildren(ctx, parser_type.TypeLocation)
+	matchers := make([]locationMatcher, 0, len(locations))
+	shadowed := make(map[int]bool) // the indexes of the shadowed locations, which are reported once
+	shadowedIssue := func(shadowing, location locationMatcher) *v1.LintIssue {
+		return &v1.LintIssue{
+			Rule:      RuleShadowedLocation,
+			Message:   fmt.Sprintf("\"%s\" will never be matched, it is shadowed by \"%s\"", location, shadowing),
+			Positions: []string{shadowing.position, location.position},
+		}
+	}
+	for i, location := range locations {
+		matcher := newLocationMatcher(location)
+		for _, former := range matchers {
+			if duplicateLocation(former, matcher) {
+				issues = append(issues, &v1.LintIssue{
+					Rule:      RuleDuplicateLocation,
+					Message:   fmt.Sprintf("duplicate location \"%s\", \"%s\" is declared before", matcher.pattern, former),
+					Positions: []string{former.position, matcher.position},
+				})
+				break
+			}
+			if shadowedRegexpLocation(former, matcher) || shadowedPrefixLocation(former, matcher) {
+				issues = append(issues, shadowedIssue(former, matcher))
+				shadowed[i] = true
+				break
+			}
+		}
+		// the prefix locations are matched before the regular expression locations, wherever they are declared
+		for j, former := range matchers {
+			if !shadowed[j] && shadowedPrefixLocation(matcher, former) {
+				issues = append(issues, shadowedIssue(matcher, former))
+				shadowed[j] = true
+			}
+		}
+		matchers = append(matchers, matcher)
+		issues = append(issues, analyzeLocations(location)...)
+	}
+	return issues
+}
+
+// duplicateLocation reports the locations which nginx refuses as duplicate: the same named locations, the same exact
+// locations, and the same prefix locations with or without `^~`.
+func duplicateLocation(former, latter locationMatcher) bool {
+	if former.pattern != latter.pattern || former.isRegexp() || latter.isRegexp() {
+		return false
+	}
+	if former.modifier == "=" || latter.modifier == "=" {
+		return former.modifier == latter.modifier
+	}
+	return true
+}
+
+// shadowedRegexpLocation reports the latter regular expression location which can not be matched because of the
+// former one. Regular expression locations are checked in order, so the same expression declared later is shadowed.
+func shadowedRegexpLocation(former, latter locationMatcher) bool {
+	if !former.isRegexp() || !latter.isRegexp() {
+		return false
+	}
+	formerPattern, latterPattern := former.pattern, latter.pattern
+	if former.modifier == "~*" {
+		formerPattern, latterPattern = strings.ToLower(formerPattern), strings.ToLower(latterPattern)
+	}
+	return formerPattern == latterPattern && (former.modifier == latter.modifier || former.modifier == "~*")
+}
+
+// shadowedPrefixLocation reports the prefix location without `^~` whose every URI is matched by the regular expression
+// location like `^/prefix`. The regular expression locations are checked after the prefix locations, so the prefix
+// location is shadowed whether it is declared before or after the regular expression location.
+func shadowedPrefixLocation(regexp, prefix locationMatcher) bool {
+	if !regexp.isRegexp() || prefix.isRegexp() || prefix.modifier == "=" || prefix.modifier == "^~" || strings.HasPrefix(prefix.pattern, "@") {
+		return false
+	}
+	regexpPattern, prefixPattern := regexp.pattern, prefix.pattern
+	if regexp.modifier == "~*" {
+		regexpPattern, prefixPattern = strings.ToLower(regexpPattern), strings.ToLower(prefixPattern)
+	}
+	m := regLiteralPrefixPattern.FindStringSubmatch(regexpPattern)
+	if m == nil {
+		return false
+	}
+	literal := strings.NewReplacer(`\.`, ".", `\/`, "/", `\-`, "-", `\_`, "_", `\~`, "~").Replace(m[1])
+	return strings.HasPrefix(prefixPattern, literal)
+}
+
+func appendServer(servers []int, server int) []int {
+	for _, s := range servers {
+		if s == server {
+			return servers
+		}
+	}
+	return append(servers, server)
+}
+
+func sortedServerNames(m map[string][]int) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,152 @@
+package configuration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConflictAnalyzer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-conflict-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := `http {
+    server {
+        listen 80 default_server;
+        server_name example.com www.example.com;
+        location / {
+        }
+        location ~ ^/api {
+        }
+        location /api/v1 {
+        }
+        location ^~ /static {
+        }
+        location /static {
+        }
+        location ~* \.php$ {
+        }
+        location ~ \.PHP$ {
+        }
+    }
+    server {
+        listen 0.0.0.0:80 default_server;
+        server_name EXAMPLE.com;
+        location = / {
+        }
+        location / {
+        }
+    }
+    server {
+        listen 127.0.0.1:80;
+        server_name example.com;
+    }
+}
+`
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := NewConflictAnalyzer(c).Analyze()
+	wantRules := []string{
+		RuleShadowedLocation,
+		RuleDuplicateLocation,
+		RuleShadowedLocation,
+		RuleDuplicateServerName,
+		RuleDuplicateDefaultServer,
+	}
+	if len(issues) != len(wantRules) {
+		for _, issue := range issues {
+			t.Logf("%s: %s", issue.Rule, issue.Message)
+		}
+		t.Fatalf("got %d issues, want %d", len(issues), len(wantRules))
+	}
+	for i, rule := range wantRules {
+		if issues[i].Rule != rule {
+			t.Errorf("issue %d: got rule %s, want %s (%s)", i, issues[i].Rule, rule, issues[i].Message)
+		}
+	}
+	if len(issues[3].Positions) != 2 {
+		t.Errorf("got positions %v of the duplicate server name, want 2 servers", issues[3].Positions)
+	}
+}
+
+func TestAnalyzeLocations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-conflict-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name      string
+		locations string
+		want      []string // the shadowed locations
+	}{
+		{"regexp before prefix", "location ~ ^/api {}\nlocation /api/v1 {}", []string{"location /api/v1"}},
+		{"prefix before regexp", "location /api/v1 {}\nlocation ~ ^/api {}", []string{"location /api/v1"}},
+		{"prefix before regexps", "location /api/v1 {}\nlocation ~ ^/api {}\nlocation ~* ^/API/v {}", []string{"location /api/v1"}},
+		{"caseless regexp", "location /API/v1 {}\nlocation ~* ^/api {}", []string{"location /API/v1"}},
+		{"prefix with ^~", "location ^~ /api/v1 {}\nlocation ~ ^/api {}", nil},
+		{"exact location", "location = /api/v1 {}\nlocation ~ ^/api {}", nil},
+		{"unrelated prefix", "location /static {}\nlocation ~ ^/api {}", nil},
+		{"same regexp", "location ~ \\.php$ {}\nlocation ~ \\.php$ {}", []string{"location ~ \\.php$"}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confPath := filepath.Join(dir, fmt.Sprintf("nginx-%d.conf", i))
+			conf := "http {\n    server {\n" + tt.locations + "\n    }\n}\n"
+			if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+				t.Fatal(err)
+			}
+			c, err := NewConfigurationFromPath(confPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			issues := NewConflictAnalyzer(c).Analyze()
+			if len(issues) != len(tt.want) {
+				for _, issue := range issues {
+					t.Logf("%s: %s", issue.Rule, issue.Message)
+				}
+				t.Fatalf("got %d issues, want %d", len(issues), len(tt.want))
+			}
+			for j, location := range tt.want {
+				if issues[j].Rule != RuleShadowedLocation || !strings.HasPrefix(issues[j].Message, "\""+location+"\" will never be matched") {
+					t.Errorf("issue %d: got %s: %s, want %s shadowed", j, issues[j].Rule, issues[j].Message, location)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeSocket(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{"80", "*:80"},
+		{"*:8080", "*:8080"},
+		{"0.0.0.0:443", "*:443"},
+		{"127.0.0.1", "127.0.0.1:80"},
+		{"localhost:8000", "localhost:8000"},
+		{"[::]:80", "[::]:80"},
+		{"[::1]", "[::1]:80"},
+		{"unix:/var/run/nginx.sock", "unix:/var/run/nginx.sock"},
+	}
+	for _, tt := range tests {
+		if got := normalizeSocket(tt.address); got != tt.want {
+			t.Errorf("normalizeSocket(%q) = %q, want %q", tt.address, got, tt.want)
+		}
+	}
+}
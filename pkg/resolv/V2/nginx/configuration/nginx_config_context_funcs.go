@@ -0,0 +1,84 @@
+package configuration
+
+import (
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"strings"
+)
+
+// ContextKeys returns the keys with the name declared directly in the context, including the keys brought in by
+// `include` directives, but excluding the keys of nested contexts. All the keys will be returned if the name is empty.
+func ContextKeys(ctx parser.Context, name string) []*parser.Key {
+	keys := make([]*parser.Key, 0)
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			break
+		}
+		switch child.GetType() {
+		case parser_type.TypeKey:
+			if key, ok := child.(*parser.Key); ok && (name == "" || key.Name == name) {
+				keys = append(keys, key)
+			}
+		case parser_type.TypeInclude, parser_type.TypeConfig:
+			if subCtx, ok := child.(parser.Context); ok {
+				keys = append(keys, ContextKeys(subCtx, name)...)
+			}
+		}
+	}
+	return keys
+}
+
+// ServerNames returns the names of `server_name` directives declared in the server context.
+func ServerNames(server parser.Context) []string {
+	names := make([]string, 0)
+	for _, key := range ContextKeys(server, "server_name") {
+		names = append(names, strings.Fields(key.Value)...)
+	}
+	return names
+}
+
+// ContextChildren returns the sub contexts of the parser type declared directly in the context in order, including the
+// contexts brought in by `include` directives.
+func ContextChildren(ctx parser.Context, parserType parser_type.ParserType) []parser.Context {
+	children := make([]parser.Context, 0)
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			break
+		}
+		subCtx, ok := child.(parser.Context)
+		if !ok {
+			continue
+		}
+		switch child.GetType() {
+		case parserType:
+			children = append(children, subCtx)
+		case parser_type.TypeInclude, parser_type.TypeConfig:
+			children = append(children, ContextChildren(subCtx, parserType)...)
+		}
+	}
+	return children
+}
+
+// SubContexts returns the direct sub contexts of the context, `include` and the included configs are transparent.
+func SubContexts(ctx parser.Context) []parser.Context {
+	contexts := make([]parser.Context, 0)
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			break
+		}
+		subCtx, ok := child.(parser.Context)
+		if !ok {
+			continue
+		}
+		switch child.GetType() {
+		case parser_type.TypeInclude, parser_type.TypeConfig:
+			contexts = append(contexts, SubContexts(subCtx)...)
+		default:
+			contexts = append(contexts, subCtx)
+		}
+	}
+	return contexts
+}
@@ -0,0 +1,183 @@
+package configuration
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/marmotedu/errors"
+	"sort"
+	"strings"
+)
+
+// nonInheritedDirectives take effect only in the context they are declared in.
+var nonInheritedDirectives = map[string]bool{
+	"proxy_pass":     true,
+	"fastcgi_pass":   true,
+	"uwsgi_pass":     true,
+	"scgi_pass":      true,
+	"grpc_pass":      true,
+	"memcached_pass": true,
+	"return":         true,
+	"rewrite":        true,
+	"break":          true,
+	"set":            true,
+	"try_files":      true,
+	"listen":         true,
+	"server_name":    true,
+}
+
+// serverRewriteDirectives of the rewrite module, declared in a server, run for every request of the server before the
+// location is selected, so that they take effect in all the locations of the server, besides the ones of the location.
+var serverRewriteDirectives = map[string]bool{
+	"return":  true,
+	"rewrite": true,
+	"break":   true,
+	"set":     true,
+}
+
+type EffectiveConfigResolver interface {
+	// Resolve computes the effective directives of the `server`, `location` or `if` context queried by the querier.
+	Resolve(q Querier) (*v1.EffectiveConfig, error)
+}
+
+type effectiveConfigResolver struct {
+	configuration Configuration
+}
+
+// Resolve applies the nginx inheritance from `http` (or `stream`) down to the target context: the directives declared
+// in the innermost context take effect, and replace all the values of the outer contexts, so the array-type directives
+// like `add_header` and `proxy_set_header` are never merged. The rewrite module directives declared in the server, like
+// `return` and `set`, are kept in its locations, as they run before the location is selected. The directives declared in
+// the `if` blocks of the target context and of its server are reported as the conditional values.
+func (r *effectiveConfigResolver) Resolve(q Querier) (*v1.EffectiveConfig, error) {
+	target, ok := q.Self().(parser.Context)
+	if !ok {
+		return nil, errors.WithCode(code.ErrConfigurationTypeMismatch, "the querier is not a context")
+	}
+	return r.resolve(target)
+}
+
+func (r *effectiveConfigResolver) resolve(target parser.Context) (*v1.EffectiveConfig, error) {
+	switch target.GetType() {
+	case parser_type.TypeServer, parser_type.TypeLocation, parser_type.TypeIf:
+	default:
+		return nil, errors.WithCode(code.ErrConfigurationTypeMismatch, "can not resolve the effective config of a '%s' context", target.GetType())
+	}
+	root, ok := r.configuration.Self().(parser.Context)
+	if !ok {
+		return nil, errors.WithCode(code.ErrInvalidConfig, "invalid configuration")
+	}
+	chain := ContextPath(root, target)
+	if chain == nil {
+		return nil, errors.WithCode(code.ErrParserNotFound, "the queried context is not found in the configuration")
+	}
+	for i, ctx := range chain {
+		if ctx.GetType() == parser_type.TypeHttp || ctx.GetType() == parser_type.TypeStream {
+			chain = chain[i:]
+			break
+		}
+	}
+
+	nodes := make([]string, len(chain))
+	for i, ctx := range chain {
+		nodes[i] = NodeName(ctx)
+		if i > 0 {
+			nodes[i] = nodes[i-1] + " > " + nodes[i]
+		}
+	}
+
+	directives := make(map[string]*v1.EffectiveDirective)
+	directive := func(name string) *v1.EffectiveDirective {
+		if _, has := directives[name]; !has {
+			directives[name] = &v1.EffectiveDirective{Name: name}
+		}
+		return directives[name]
+	}
+
+	innermost := len(chain) - 1
+	for i, ctx := range chain {
+		levelValues := make(map[string][]*v1.DirectiveValue)
+		for _, key := range ContextKeys(ctx, "") {
+			levelValues[key.Name] = append(levelValues[key.Name], &v1.DirectiveValue{
+				Value:    key.Value,
+				Node:     nodes[i],
+				Position: key.GetPosition(),
+			})
+		}
+		for name, values := range levelValues {
+			switch {
+			case serverRewriteDirectives[name] && ctx.GetType() == parser_type.TypeServer:
+				directive(name).Values = values
+			case nonInheritedDirectives[name] && i != innermost:
+				continue
+			case serverRewriteDirectives[name]:
+				// the rewrite directives of the target context run after the ones of its server
+				directive(name).Values = append(directive(name).Values, values...)
+			default:
+				directive(name).Values = values
+			}
+		}
+
+		if i != innermost && ctx.GetType() != parser_type.TypeServer {
+			continue
+		}
+		for _, ifCtx := range ContextChildren(ctx, parser_type.TypeIf) {
+			ifNode := nodes[i] + " > " + NodeName(ifCtx)
+			for _, key := range ContextKeys(ifCtx, "") {
+				d := directive(key.Name)
+				d.Conditional = append(d.Conditional, &v1.DirectiveValue{
+					Value:     key.Value,
+					Node:      ifNode,
+					Position:  key.GetPosition(),
+					Condition: ifCtx.GetValue(),
+				})
+			}
+		}
+	}
+
+	effective := &v1.EffectiveConfig{
+		Node:       nodes[innermost],
+		Position:   target.GetPosition(),
+		Directives: make([]*v1.EffectiveDirective, 0, len(directives)),
+	}
+	for _, d := range directives {
+		if len(d.Values) > 0 || len(d.Conditional) > 0 {
+			effective.Directives = append(effective.Directives, d)
+		}
+	}
+	sort.Slice(effective.Directives, func(i, j int) bool {
+		return effective.Directives[i].Name < effective.Directives[j].Name
+	})
+	return effective, nil
+}
+
+func NewEffectiveConfigResolver(c Configuration) EffectiveConfigResolver {
+	return &effectiveConfigResolver{configuration: c}
+}
+
+// ContextPath returns the contexts from the root down to the target context, excluding the root, `include` and the
+// included configs. Nil will be returned if the target is not in the root context.
+func ContextPath(root parser.Context, target parser.Context) []parser.Context {
+	for _, child := range SubContexts(root) {
+		if child == target {
+			return []parser.Context{child}
+		}
+		if path := ContextPath(child, target); path != nil {
+			return append([]parser.Context{child}, path...)
+		}
+	}
+	return nil
+}
+
+// NodeName returns a readable name of the context, e.g. `server [example.com]`, `location = /` or `if ($x)`.
+func NodeName(ctx parser.Context) string {
+	if ctx.GetType() == parser_type.TypeServer {
+		return fmt.Sprintf("server [%s]", strings.Join(ServerNames(ctx), " "))
+	}
+	if ctx.GetValue() == "" {
+		return ctx.GetType().String()
+	}
+	return ctx.GetType().String() + " " + ctx.GetValue()
+}
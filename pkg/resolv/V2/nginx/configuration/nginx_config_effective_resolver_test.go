@@ -0,0 +1,198 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEffectiveConfigResolver_Resolve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-effective-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := `http {
+    client_max_body_size 1m;
+    add_header X-Frame-Options DENY;
+    add_header X-Content-Type-Options nosniff;
+    include servers.conf;
+}
+`
+	servers := `server {
+    listen 80;
+    server_name api.example.com;
+    client_max_body_size 10m;
+    proxy_pass http://default;
+    location /upload {
+        client_max_body_size 100m;
+        add_header X-Upload 1;
+        if ($request_method = GET) {
+            return 405;
+        }
+    }
+    location / {
+    }
+}
+`
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "servers.conf"), []byte(servers), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := c.Query("server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	location, err := server.Query("location:sep: /upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	effective, err := NewEffectiveConfigResolver(c).Resolve(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if effective.Node != "http > server [api.example.com] > location /upload" {
+		t.Errorf("got node %q", effective.Node)
+	}
+
+	got := make(map[string]*v1.EffectiveDirective)
+	for _, d := range effective.Directives {
+		got[d.Name] = d
+	}
+	if d := got["client_max_body_size"]; d == nil || len(d.Values) != 1 || d.Values[0].Value != "100m" {
+		t.Errorf("got client_max_body_size %+v, want 100m", d)
+	}
+	if d := got["add_header"]; d == nil || len(d.Values) != 1 || d.Values[0].Value != "X-Upload 1" {
+		t.Errorf("got add_header %+v, want the location values replacing the http values", d)
+	}
+	if _, has := got["proxy_pass"]; has {
+		t.Errorf("proxy_pass of the server is inherited")
+	}
+	if d := got["return"]; d == nil || len(d.Values) != 0 || len(d.Conditional) != 1 || d.Conditional[0].Condition != "($request_method = GET)" {
+		t.Errorf("got return %+v, want a conditional value", d)
+	}
+
+	rootLocation, err := server.Query("location:sep: :reg: ^/$")
+	if err != nil {
+		t.Fatal(err)
+	}
+	effective, err = NewEffectiveConfigResolver(c).Resolve(rootLocation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range effective.Directives {
+		switch d.Name {
+		case "client_max_body_size":
+			if d.Values[0].Value != "10m" || d.Values[0].Node != "http > server [api.example.com]" || d.Values[0].Position != filepath.Join(dir, "servers.conf") {
+				t.Errorf("got client_max_body_size %+v, want 10m from the server in servers.conf", d.Values[0])
+			}
+		case "add_header":
+			if len(d.Values) != 2 || d.Values[0].Node != "http" {
+				t.Errorf("got add_header %+v, want the 2 values from http", d.Values)
+			}
+		}
+	}
+
+	if _, err = NewEffectiveConfigResolver(c).Resolve(&querier{Parser: parser.NewComment("test", false, c.Self().GetIndention())}); err == nil {
+		t.Errorf("the effective config of a comment is resolved")
+	}
+}
+
+func TestEffectiveConfigResolver_ResolveServerRewrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-effective-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := `http {
+    server {
+        listen 80;
+        server_name example.com;
+        set $backend web;
+        return 301 https://$host$request_uri;
+        location / {
+            set $cache on;
+            rewrite ^/old/(.*)$ /new/$1 last;
+        }
+        location /api {
+            location /api/v1 {
+            }
+        }
+    }
+}
+`
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := c.Query("server")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		location string
+		want     map[string][]string // the nodes of the values
+	}{
+		{
+			location: "location:sep: :reg: ^/$",
+			want: map[string][]string{
+				"return":  {"http > server [example.com]"},
+				"set":     {"http > server [example.com]", "http > server [example.com] > location /"},
+				"rewrite": {"http > server [example.com] > location /"},
+			},
+		},
+		{
+			location: "location:sep: /api/v1",
+			want: map[string][]string{
+				"return": {"http > server [example.com]"},
+				"set":    {"http > server [example.com]"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		location, err := server.Query(tt.location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		effective, err := NewEffectiveConfigResolver(c).Resolve(location)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string][]string)
+		for _, d := range effective.Directives {
+			for _, value := range d.Values {
+				got[d.Name] = append(got[d.Name], value.Node)
+			}
+		}
+		for _, name := range []string{"return", "set", "rewrite"} {
+			if len(got[name]) != len(tt.want[name]) {
+				t.Errorf("%s: got %s from %v, want from %v", effective.Node, name, got[name], tt.want[name])
+				continue
+			}
+			for i, node := range tt.want[name] {
+				if got[name][i] != node {
+					t.Errorf("%s: got %s from %v, want from %v", effective.Node, name, got[name], tt.want[name])
+					break
+				}
+			}
+		}
+	}
+}
@@ -1,8 +1,14 @@
 package configuration
 
 import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -65,3 +71,101 @@ func TestConfigManager_Backup(t *testing.T) {
 	//	t.Fatal(err)
 	//}
 }
+
+func TestConfigManager_overwrite(t *testing.T) {
+	for _, serverBinPath := range []string{"/bin/true", "/bin/false"} {
+		func() {
+			dir, err := ioutil.TempDir("", "bifrost-overwrite-test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			mainConf := filepath.Join(dir, "nginx.conf")
+			includedConf := filepath.Join(dir, "a.conf")
+			if err = ioutil.WriteFile(mainConf, []byte("http {\n    include a.conf;\n}\n"), 0640); err != nil {
+				t.Fatal(err)
+			}
+			if err = ioutil.WriteFile(includedConf, []byte("server {\n    listen 80;\n}\n"), 0600); err != nil {
+				t.Fatal(err)
+			}
+			c, err := NewConfigurationFromPath(mainConf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			manager := NewNginxConfigurationManager(loader.NewLoader(), c, serverBinPath, "", 0, 0, new(sync.RWMutex)).(*configManager)
+
+			// include is not queryable, remove it from the http context
+			http, err := c.Query("http")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = http.Self().(parser.Context).Remove(0); err != nil {
+				t.Fatal(err)
+			}
+			err = manager.SaveWithCheck()
+			data, readErr := ioutil.ReadFile(mainConf)
+			if readErr != nil {
+				t.Fatal(readErr)
+			}
+			_, statErr := os.Stat(includedConf)
+			if serverBinPath == "/bin/true" {
+				if err != nil || strings.Contains(string(data), "include") || !os.IsNotExist(statErr) {
+					t.Errorf("the configuration is not saved, error: %v, stat error: %v\n%s", err, statErr, data)
+				}
+			} else {
+				if err == nil || !strings.Contains(string(data), "include a.conf") || statErr != nil {
+					t.Errorf("the config files are not restored, error: %v, stat error: %v\n%s", err, statErr, data)
+				}
+				if _, err = c.Query("server"); err != nil {
+					t.Errorf("the configuration is not restored, %v", err)
+				}
+			}
+			if info, err := os.Stat(mainConf); err != nil || info.Mode().Perm() != 0640 {
+				t.Errorf("the mode of the config file is not kept, %v, %v", info.Mode(), err)
+			}
+			if matches, _ := filepath.Glob(filepath.Join(dir, ".*.tmp*")); len(matches) > 0 {
+				t.Errorf("the temporary files %v are left", matches)
+			}
+		}()
+	}
+}
+
+func TestConfigManager_serverPrefix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-prefix-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	mainConf := filepath.Join(dir, "nginx.conf")
+	if err = ioutil.WriteFile(mainConf, []byte("pid logs/nginx.pid;\nhttp {\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(mainConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := ManagerOptions{Prefix: dir, ExtraArgs: []string{"-g", "daemon off; pid run/nginx.pid;"}}
+	manager := NewNginxConfigurationManagerWithOptions(loader.NewLoader(), c, "/usr/sbin/nginx", "", 0, 0, options, new(sync.RWMutex)).(*configManager)
+
+	args := manager.serverBinCMD("-t").Args
+	want := []string{"/usr/sbin/nginx", "-p", dir, "-g", "daemon off; pid run/nginx.pid;", "-t", "-c", mainConf}
+	if strings.Join(args, "|") != strings.Join(want, "|") {
+		t.Errorf("serverBinCMD() = %v, want %v", args, want)
+	}
+
+	// the pid passed with `-g` takes the place of the config, and is resolved with the prefix
+	if status := manager.serverStatus(); status != v1.Abnormal {
+		t.Errorf("serverStatus() = %v without the pid file, want %v", status, v1.Abnormal)
+	}
+	if err = os.MkdirAll(filepath.Join(dir, "run"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "run", "nginx.pid"), []byte(strconv.Itoa(os.Getpid())+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if status := manager.serverStatus(); status != v1.Normal {
+		t.Errorf("serverStatus() = %v, want %v", status, v1.Normal)
+	}
+}
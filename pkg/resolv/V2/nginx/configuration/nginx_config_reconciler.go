@@ -0,0 +1,430 @@
+package configuration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_indention"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/marmotedu/errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	reconcileKindServer   = "server"
+	reconcileKindUpstream = "upstream"
+)
+
+// the blocks managed by the reconciler are marked by the comment as their first child, the hash is of the block when
+// it was last applied, so that the manual edits since then can be told from the changes of the site definitions.
+var regReconcileMarker = regexp.MustCompile(`^bifrost-reconciler: (server|upstream) (\S+) ([0-9a-f]+)$`)
+
+type Reconciler interface {
+	// Plan computes the changes to bring the configuration to the site definitions, without changing it.
+	Plan() (*v1.ReconcilePlan, error)
+	// Apply applies the changes to the configuration at once, and saves it with the save function, e.g.
+	// ConfigManager.SaveWithCheck, which restores the configuration if the web server rejects it. The blocks in conflict
+	// are left untouched.
+	Apply(save func() error) (*v1.ReconcilePlan, error)
+}
+
+type reconciler struct {
+	configuration Configuration
+	definitions   *SiteDefinitions
+}
+
+// liveBlock is a server or upstream block of the configuration, which is managed by the reconciler if the recorded hash
+// is not empty.
+type liveBlock struct {
+	kind     string
+	name     string
+	recorded string
+	querier  *querier
+}
+
+func (b *liveBlock) context() parser.Context {
+	return b.querier.Parser.(parser.Context)
+}
+
+// desiredBlock is a server or upstream block built from the site definitions.
+type desiredBlock struct {
+	kind        string
+	name        string
+	serverNames []string
+	build       func(indention parser_indention.Indention) parser.Context
+}
+
+// reconcileStep is a change of the plan, with the blocks to apply it.
+type reconcileStep struct {
+	change  *v1.ReconcileChange
+	live    *liveBlock
+	desired *desiredBlock
+}
+
+func (r *reconciler) Plan() (*v1.ReconcilePlan, error) {
+	steps, err := r.steps(r.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return newReconcilePlan(r.definitions.WebServer, steps).ReconcilePlan, nil
+}
+
+func (r *reconciler) Apply(save func() error) (*v1.ReconcilePlan, error) {
+	// the changes are applied to a copy of the configuration, which takes the place of the configuration at once, so
+	// that the config manager never saves the changes partially applied
+	staging, err := NewConfigurationFromJsonBytes(r.configuration.Json())
+	if err != nil {
+		return nil, err
+	}
+	steps, err := r.steps(staging)
+	if err != nil {
+		return nil, err
+	}
+	plan := newReconcilePlan(r.definitions.WebServer, steps)
+	if !plan.hasChanges() {
+		return plan.ReconcilePlan, nil
+	}
+	if err = applySteps(staging, steps); err != nil {
+		return nil, err
+	}
+
+	if err = r.configuration.UpdateFromJsonBytes(staging.Json()); err != nil {
+		return nil, err
+	}
+	err = save()
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) && !errors.IsCode(err, code.ErrSameConfigFingerprints) {
+		return nil, err
+	}
+
+	// the configuration is restored by the config manager, if the web server rejects it
+	remains, err := r.steps(r.configuration)
+	if err != nil {
+		return nil, err
+	}
+	if newReconcilePlan(r.definitions.WebServer, remains).hasChanges() {
+		return nil, errors.WithCode(code.ErrReconcileFailed, "the changes of web server '%s' are not applied, they may be rolled back", r.definitions.WebServer)
+	}
+	plan.Applied = true
+	return plan.ReconcilePlan, nil
+}
+
+// steps computes the changes of the upstreams and the sites in the order of the definitions, followed by the deletions.
+func (r *reconciler) steps(c Configuration) ([]*reconcileStep, error) {
+	lives, err := liveBlocks(c)
+	if err != nil {
+		return nil, err
+	}
+	managed := make(map[string][]*liveBlock)
+	for _, live := range lives {
+		if live.recorded != "" {
+			managed[live.kind+" "+live.name] = append(managed[live.kind+" "+live.name], live)
+		}
+	}
+
+	steps := make([]*reconcileStep, 0)
+	desired := r.desiredBlocks()
+	wanted := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		wanted[d.kind+" "+d.name] = true
+		candidates := managed[d.kind+" "+d.name]
+		if len(candidates) == 0 {
+			if conflict := findConflict(lives, d); conflict != nil {
+				steps = append(steps, &reconcileStep{
+					change: &v1.ReconcileChange{
+						Action:   v1.ReconcileActionConflict,
+						Kind:     d.kind,
+						Name:     d.name,
+						Position: conflict.querier.GetPosition(),
+						Diff:     diffBlocks(conflict.context(), d.build(parser_indention.NewIndention())),
+					},
+					live:    conflict,
+					desired: d,
+				})
+				continue
+			}
+			steps = append(steps, &reconcileStep{
+				change: &v1.ReconcileChange{
+					Action: v1.ReconcileActionCreate,
+					Kind:   d.kind,
+					Name:   d.name,
+					Diff:   diffBlocks(nil, d.build(parser_indention.NewIndention())),
+				},
+				desired: d,
+			})
+			continue
+		}
+
+		live := candidates[0]
+		target := d.build(live.context().GetIndention())
+		liveHash, desiredHash := blockHash(live.context()), blockHash(target)
+		if liveHash == live.recorded && desiredHash == live.recorded {
+			continue
+		}
+		steps = append(steps, &reconcileStep{
+			change: &v1.ReconcileChange{
+				Action:   v1.ReconcileActionUpdate,
+				Kind:     d.kind,
+				Name:     d.name,
+				Drifted:  liveHash != live.recorded,
+				Position: live.querier.GetPosition(),
+				Diff:     diffBlocks(live.context(), target),
+			},
+			live:    live,
+			desired: d,
+		})
+	}
+
+	// the managed blocks no longer defined, and the duplicates of the managed blocks, are deleted
+	for _, live := range lives {
+		key := live.kind + " " + live.name
+		if live.recorded == "" || (wanted[key] && managed[key][0] == live) {
+			continue
+		}
+		steps = append(steps, &reconcileStep{
+			change: &v1.ReconcileChange{
+				Action:   v1.ReconcileActionDelete,
+				Kind:     live.kind,
+				Name:     live.name,
+				Drifted:  blockHash(live.context()) != live.recorded,
+				Position: live.querier.GetPosition(),
+				Diff:     diffBlocks(live.context(), nil),
+			},
+			live: live,
+		})
+	}
+	return steps, nil
+}
+
+func (r *reconciler) desiredBlocks() []*desiredBlock {
+	blocks := make([]*desiredBlock, 0, len(r.definitions.Upstreams)+len(r.definitions.Sites))
+	for _, upstream := range r.definitions.Upstreams {
+		blocks = append(blocks, &desiredBlock{kind: reconcileKindUpstream, name: upstream.Name, build: upstream.parser})
+	}
+	for _, site := range r.definitions.Sites {
+		blocks = append(blocks, &desiredBlock{kind: reconcileKindServer, name: site.Name, serverNames: site.ServerNames, build: site.parser})
+	}
+	return blocks
+}
+
+// liveBlocks returns the server and upstream blocks of the configuration.
+func liveBlocks(c Configuration) ([]*liveBlock, error) {
+	blocks := make([]*liveBlock, 0)
+	for _, kind := range []string{reconcileKindUpstream, reconcileKindServer} {
+		// the upstream keyword without a value only matches the upstream blocks without a name
+		queriers, err := c.QueryAll(kind + ":sep: :reg: .*")
+		if err != nil {
+			if errors.IsCode(err, code.ErrParserNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		for _, q := range queriers {
+			qr, ok := q.(*querier)
+			if !ok {
+				continue
+			}
+			ctx, ok := qr.Parser.(parser.Context)
+			if !ok {
+				continue
+			}
+			block := &liveBlock{kind: kind, name: ctx.GetValue(), querier: qr}
+			if first, err := ctx.GetChild(0); err == nil && first.GetType() == parser_type.TypeComment {
+				if match := regReconcileMarker.FindStringSubmatch(first.GetValue()); match != nil && match[1] == kind {
+					block.name, block.recorded = match[2], match[3]
+				}
+			}
+			blocks = append(blocks, block)
+		}
+	}
+	// the queriers of a context are not in order, the blocks are sorted by their positions to make the plan stable
+	sort.SliceStable(blocks, func(i, j int) bool {
+		if blocks[i].querier.GetPosition() != blocks[j].querier.GetPosition() {
+			return blocks[i].querier.GetPosition() < blocks[j].querier.GetPosition()
+		}
+		return blocks[i].querier.selfIndex < blocks[j].querier.selfIndex
+	})
+	return blocks, nil
+}
+
+// findConflict finds the unmanaged block which has the name of the desired upstream, or any server name of the desired
+// server.
+func findConflict(lives []*liveBlock, desired *desiredBlock) *liveBlock {
+	for _, live := range lives {
+		if live.recorded != "" || live.kind != desired.kind {
+			continue
+		}
+		if desired.kind == reconcileKindUpstream {
+			if live.name == desired.name {
+				return live
+			}
+			continue
+		}
+		for _, name := range liveServerNames(live.context()) {
+			for _, desiredName := range desired.serverNames {
+				if strings.EqualFold(name, desiredName) {
+					return live
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func liveServerNames(server parser.Context) []string {
+	names := make([]string, 0)
+	for _, child := range children(server) {
+		if key, ok := child.(*parser.Key); ok && key.Name == "server_name" {
+			names = append(names, strings.Fields(key.Value)...)
+		}
+	}
+	return names
+}
+
+// applySteps applies the changes to the configuration, the deletions are applied from the end of their contexts, so
+// that the indexes of the blocks not applied yet are not changed.
+func applySteps(c Configuration, steps []*reconcileStep) error {
+	conf, ok := c.(*configuration)
+	if !ok {
+		return errors.WithCode(code.ErrReconcileFailed, "unsupported configuration %T", c)
+	}
+	var http parser.Context
+	deletions := make([]*liveBlock, 0)
+	for _, step := range steps {
+		switch step.change.Action {
+		case v1.ReconcileActionCreate:
+			if http == nil {
+				q, err := c.Query("http")
+				if err != nil {
+					return errors.WithCode(code.ErrReconcileFailed, "no http context to create the %s '%s' in", step.desired.kind, step.desired.name)
+				}
+				http = q.Self().(parser.Context)
+			}
+			block := markedBlock(step.desired, http.GetIndention().NextIndention())
+			if err := conf.insertByIndex(block, http, http.Len()); err != nil {
+				return err
+			}
+		case v1.ReconcileActionUpdate:
+			block := markedBlock(step.desired, step.live.context().GetIndention())
+			if err := c.ModifyByQueryer(block, step.live.querier); err != nil {
+				return err
+			}
+		case v1.ReconcileActionDelete:
+			deletions = append(deletions, step.live)
+		}
+	}
+	sort.SliceStable(deletions, func(i, j int) bool {
+		return deletions[i].querier.selfIndex > deletions[j].querier.selfIndex
+	})
+	for _, live := range deletions {
+		if err := c.RemoveByQueryer(live.querier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markedBlock builds the desired block, and marks it with the hash of itself.
+func markedBlock(desired *desiredBlock, indention parser_indention.Indention) parser.Context {
+	block := desired.build(indention)
+	marker := fmt.Sprintf("bifrost-reconciler: %s %s %s", desired.kind, desired.name, blockHash(block))
+	_ = block.Insert(parser.NewComment(marker, false, indention.NextIndention()), 0)
+	return block
+}
+
+// blockHash hashes the directives and contexts of the block, regardless of the comments, the indention and the
+// whitespaces in the values.
+func blockHash(block parser.Context) string {
+	sum := sha256.Sum256([]byte(strings.Join(canonicalLines(block, 0), "\n")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// canonicalLines renders the parser into the config lines without comments, in which the values are normalized.
+func canonicalLines(p parser.Parser, deep int) []string {
+	indents := strings.Repeat(parser_indention.INDENT, deep)
+	switch p.GetType() {
+	case parser_type.TypeComment:
+		return nil
+	case parser_type.TypeKey:
+		return []string{indents + normalizeValue(p.GetValue()) + ";"}
+	}
+	ctx, ok := p.(parser.Context)
+	if !ok {
+		return []string{indents + normalizeValue(p.GetValue())}
+	}
+	head := ctx.GetType().String()
+	if value := normalizeValue(ctx.GetValue()); value != "" {
+		head += " " + value
+	}
+	lines := []string{indents + head + " {"}
+	for _, child := range children(ctx) {
+		lines = append(lines, canonicalLines(child, deep+1)...)
+	}
+	return append(lines, indents+"}")
+}
+
+// diffBlocks returns the line diff from the live block to the desired block, either of which may be nil.
+func diffBlocks(live, desired parser.Context) string {
+	var a, b []string
+	if live != nil {
+		a = canonicalLines(live, 0)
+	}
+	if desired != nil {
+		b = canonicalLines(desired, 0)
+	}
+	matches := lcsMatches(a, b)
+	lines := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if k, matched := matches[i]; matched && i < len(a) {
+			for ; j < k; j++ {
+				lines = append(lines, "+ "+b[j])
+			}
+			lines = append(lines, "  "+a[i])
+			i++
+			j++
+			continue
+		}
+		if i < len(a) {
+			lines = append(lines, "- "+a[i])
+			i++
+			continue
+		}
+		lines = append(lines, "+ "+b[j])
+		j++
+	}
+	return strings.Join(lines, "\n")
+}
+
+type reconcilePlan struct {
+	*v1.ReconcilePlan
+}
+
+func newReconcilePlan(servername string, steps []*reconcileStep) reconcilePlan {
+	plan := &v1.ReconcilePlan{ServerName: servername, Changes: make([]*v1.ReconcileChange, 0, len(steps))}
+	for _, step := range steps {
+		plan.Changes = append(plan.Changes, step.change)
+	}
+	return reconcilePlan{plan}
+}
+
+// hasChanges returns whether the plan has any change to apply, the conflicts are not applied.
+func (p reconcilePlan) hasChanges() bool {
+	for _, change := range p.Changes {
+		if change.Action != v1.ReconcileActionConflict {
+			return true
+		}
+	}
+	return false
+}
+
+func NewReconciler(configuration Configuration, definitions *SiteDefinitions) Reconciler {
+	return &reconciler{
+		configuration: configuration,
+		definitions:   definitions,
+	}
+}
@@ -0,0 +1,188 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadSiteDefinitions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-sites-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"a.yml":  "web-server: test\nsites:\n  - name: www\n    server-names: [www.example.com]\n    listen: [\"80\"]\n",
+		"b.json": `{"web-server": "test", "upstreams": [{"name": "backend", "servers": ["127.0.0.1:8080"]}]}`,
+	}
+	for name, content := range files {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	definitions, err := LoadSiteDefinitions(dir)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if defs, has := definitions["test"]; !has || len(defs.Sites) != 1 || len(defs.Upstreams) != 1 {
+		t.Errorf("LoadSiteDefinitions() = %+v, want the definitions of both files merged", definitions)
+	}
+
+	invalids := map[string]string{
+		"duplicate":   "web-server: test\nsites:\n  - name: www\n    server-names: [a.example.com]\n    listen: [\"80\"]\n",
+		"injection":   "web-server: test\nsites:\n  - name: c\n    server-names: [c.example.com]\n    listen: [\"80\"]\n    directives: [\"root /data; include /etc/passwd\"]\n",
+		"no listen":   "web-server: test\nsites:\n  - name: d\n    server-names: [d.example.com]\n",
+		"no server":   "web-server: test\nupstreams:\n  - name: e\n",
+		"tls no key":  "web-server: test\nsites:\n  - name: f\n    server-names: [f.example.com]\n    tls:\n      certificate: /a.crt\n",
+		"no web":      "sites: []\n",
+		"unknown key": "web-server: test\nsite: []\n",
+	}
+	for name, content := range invalids {
+		path := filepath.Join(dir, "z.yml")
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = LoadSiteDefinitions(dir); !errors.IsCode(err, code.ErrInvalidSiteDefinition) {
+			t.Errorf("LoadSiteDefinitions() with %s error = %v, want ErrInvalidSiteDefinition", name, err)
+		}
+	}
+}
+
+func TestReconciler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-reconcile-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := "http {\n    server {\n        listen 80;\n        server_name a.example.com;\n    }\n}\n"
+	if err = ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definitions := &SiteDefinitions{
+		WebServer: "test",
+		Upstreams: []*UpstreamDefinition{{Name: "backend", Servers: []string{"127.0.0.1:8080  weight=2", "127.0.0.1:8081"}}},
+		Sites: []*SiteDefinition{
+			{
+				Name:        "www",
+				ServerNames: []string{"www.example.com", "example.com"},
+				Listen:      []string{"80"},
+				TLS:         &TLSDefinition{Certificate: "/etc/ssl/www.crt", CertificateKey: "/etc/ssl/www.key"},
+				Locations: []*LocationDefinition{
+					{Path: "/", ProxyPass: "http://backend", Directives: []string{"proxy_set_header Host $host"}},
+					{Path: "^~ /static/", Root: "/data"},
+				},
+			},
+			{Name: "a", ServerNames: []string{"a.example.com"}, Listen: []string{"8080"}},
+		},
+	}
+	saved := 0
+	save := func() error {
+		saved++
+		return nil
+	}
+	actions := func(plan *v1.ReconcilePlan) string {
+		list := make([]string, 0, len(plan.Changes))
+		for _, change := range plan.Changes {
+			action := string(change.Action) + " " + change.Kind + " " + change.Name
+			if change.Drifted {
+				action += " (drifted)"
+			}
+			list = append(list, action)
+		}
+		return strings.Join(list, ", ")
+	}
+	assertPlan := func(step, want string) *v1.ReconcilePlan {
+		t.Helper()
+		plan, err := NewReconciler(c, definitions).Plan()
+		if err != nil {
+			t.Fatalf("%s: %+v", step, err)
+		}
+		if got := actions(plan); got != want {
+			t.Fatalf("%s: Plan() changes = [%s], want [%s]", step, got, want)
+		}
+		return plan
+	}
+
+	// 1) the blocks are created, and the unmanaged server of the same server name is in conflict
+	plan := assertPlan("create", "create upstream backend, create server www, conflict server a")
+	if !strings.Contains(plan.Changes[1].Diff, "+     location ^~ /static/ {") {
+		t.Errorf("create diff = \n%s", plan.Changes[1].Diff)
+	}
+	plan, err = NewReconciler(c, definitions).Apply(save)
+	if err != nil || !plan.Applied || saved != 1 {
+		t.Fatalf("Apply() = %+v, %+v, saved %d times", plan, err, saved)
+	}
+	view := string(c.View())
+	for _, want := range []string{"# bifrost-reconciler: server www ", "server 127.0.0.1:8080 weight=2;", "listen 443 ssl;", "server_name www.example.com example.com;"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Apply() view has no '%s':\n%s", want, view)
+		}
+	}
+	if !strings.HasPrefix(view, "http {\n    server {\n        listen 80;\n        server_name a.example.com;\n    }\n") {
+		t.Errorf("Apply() changed the server in conflict:\n%s", view)
+	}
+	assertPlan("applied", "conflict server a")
+
+	// 2) the manual edits are reported as drift, and reverted by applying
+	www, err := c.Query("key:sep: server_name www.example.com example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.InsertByQueryer(parser.NewKey("client_max_body_size", "1g", www.Self().GetIndention()), www); err != nil {
+		t.Fatal(err)
+	}
+	plan = assertPlan("drifted", "update server www (drifted), conflict server a")
+	if !strings.Contains(plan.Changes[0].Diff, "-     client_max_body_size 1g;") {
+		t.Errorf("drift diff = \n%s", plan.Changes[0].Diff)
+	}
+	if _, err = NewReconciler(c, definitions).Apply(save); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if strings.Contains(string(c.View()), "client_max_body_size") {
+		t.Errorf("Apply() kept the drift:\n%s", c.View())
+	}
+
+	// 3) the changes of the definitions are not drift, and the blocks no longer defined are deleted
+	definitions.Upstreams[0].Directives = []string{"keepalive 16"}
+	definitions.Sites = definitions.Sites[:1]
+	assertPlan("changed", "update upstream backend")
+	definitions.Upstreams = nil
+	definitions.Sites[0].Locations = definitions.Sites[0].Locations[:1]
+	assertPlan("deleted", "update server www, delete upstream backend")
+	if _, err = NewReconciler(c, definitions).Apply(save); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if view = string(c.View()); strings.Contains(view, "upstream") || strings.Contains(view, "/static/") {
+		t.Errorf("Apply() kept the deleted blocks:\n%s", view)
+	}
+	assertPlan("reconciled", "")
+
+	// 4) the changes rolled back by the config manager fail the apply
+	definitions.Sites[0].Listen = []string{"8081"}
+	old := c.Json()
+	_, err = NewReconciler(c, definitions).Apply(func() error {
+		return c.UpdateFromJsonBytes(old)
+	})
+	if !errors.IsCode(err, code.ErrReconcileFailed) {
+		t.Errorf("Apply() rolled back error = %v, want ErrReconcileFailed", err)
+	}
+	rejected := errors.New("nginx -t failed")
+	if _, err = NewReconciler(c, definitions).Apply(func() error { return rejected }); err != rejected {
+		t.Errorf("Apply() rejected error = %v, want %v", err, rejected)
+	}
+}
@@ -0,0 +1,317 @@
+package configuration
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/marmotedu/errors"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type RouteSimulator interface {
+	// Simulate finds the http server and location which handle the request, the port defaults to the port of the scheme.
+	Simulate(host string, port int, scheme, uri string) (*v1.RouteResult, error)
+}
+
+type routeSimulator struct {
+	configuration Configuration
+}
+
+func (s *routeSimulator) Simulate(host string, port int, scheme, uri string) (*v1.RouteResult, error) {
+	scheme = strings.ToLower(scheme)
+	switch scheme {
+	case "":
+		scheme = "http"
+	case "http", "https":
+	default:
+		return nil, errors.WithCode(code.ErrValidation, "unsupported scheme '%s'", scheme)
+	}
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		host = h
+		if port == 0 {
+			port, _ = strconv.Atoi(p)
+		}
+	}
+	host = strings.TrimSuffix(host, ".")
+	if port == 0 {
+		port = 80
+		if scheme == "https" {
+			port = 443
+		}
+	}
+	if port < 0 || port > 65535 {
+		return nil, errors.WithCode(code.ErrValidation, "invalid port %d", port)
+	}
+	if i := strings.IndexAny(uri, "?#"); i >= 0 {
+		uri = uri[:i]
+	}
+	if !strings.HasPrefix(uri, "/") {
+		return nil, errors.WithCode(code.ErrValidation, "invalid uri '%s', it should start with '/'", uri)
+	}
+	root, ok := s.configuration.Self().(parser.Context)
+	if !ok {
+		return nil, errors.WithCode(code.ErrInvalidConfig, "invalid configuration")
+	}
+
+	result := &v1.RouteResult{Trace: make([]string, 0)}
+	trace := func(format string, a ...interface{}) {
+		result.Trace = append(result.Trace, fmt.Sprintf(format, a...))
+	}
+
+	server := selectServer(root, host, port, scheme, trace)
+	if server == nil {
+		return result, nil
+	}
+	result.Server = NodeName(server)
+	result.ServerPosition = server.GetPosition()
+
+	target := server
+	location, _ := selectLocation(server, uri, trace)
+	if location != nil {
+		result.Location = NodeName(location)
+		result.LocationPosition = location.GetPosition()
+		target = location
+		trace("\"%s\" is handled by %s", uri, newLocationMatcher(location))
+	} else {
+		trace("no location matches \"%s\", it is handled by the server level", uri)
+	}
+
+	effective, err := (&effectiveConfigResolver{configuration: s.configuration}).resolve(target)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range effective.Directives {
+		var value **v1.DirectiveValue
+		switch d.Name {
+		case "proxy_pass":
+			value = &result.ProxyPass
+		case "root":
+			value = &result.Root
+		case "alias":
+			value = &result.Alias
+		default:
+			continue
+		}
+		if len(d.Values) > 0 {
+			*value = d.Values[0]
+		}
+		if len(d.Conditional) > 0 {
+			trace("`%s` may be replaced by the value(s) in %d `if` block(s)", d.Name, len(d.Conditional))
+		}
+	}
+	return result, nil
+}
+
+func NewRouteSimulator(c Configuration) RouteSimulator {
+	return &routeSimulator{configuration: c}
+}
+
+// selectServer follows the order nginx tests the `server_name` of the servers listening on the port: the exact name,
+// the longest wildcard name starting with an asterisk, the longest wildcard name ending with an asterisk, the first
+// matching regular expression, and the default server at last.
+func selectServer(root parser.Context, host string, port int, scheme string, trace func(format string, a ...interface{})) parser.Context {
+	candidates := make([]parser.Context, 0)
+	sslServers := make(map[parser.Context]bool)
+	sockets := make(map[string]bool)
+	var defaultServer parser.Context
+	for _, http := range ContextChildren(root, parser_type.TypeHttp) {
+		for _, server := range ContextChildren(http, parser_type.TypeServer) {
+			listening := false
+			for _, listen := range Listens(server) {
+				if listenPort(listen.Socket) != port {
+					continue
+				}
+				listening = true
+				sockets[listen.Socket] = true
+				if listen.SSL {
+					sslServers[server] = true
+				}
+				if listen.DefaultServer && defaultServer == nil {
+					defaultServer = server
+				}
+			}
+			if listening {
+				candidates = append(candidates, server)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		trace("no http server listens on port %d", port)
+		return nil
+	}
+	trace("%d server(s) listen on port %d", len(candidates), port)
+	if len(sockets) > 1 {
+		trace("the servers listen on %d different addresses of port %d, the address the request arrives at is not simulated", len(sockets), port)
+	}
+	if defaultServer == nil {
+		defaultServer = candidates[0]
+		trace("%s is the default server, as the first server listening on port %d", NodeName(defaultServer), port)
+	} else {
+		trace("%s is the default server, marked by `default_server`", NodeName(defaultServer))
+	}
+
+	selected := matchServerName(candidates, host, trace)
+	if selected == nil {
+		selected = defaultServer
+		trace("host \"%s\" matches no server name, it is handled by the default server", host)
+	}
+	if scheme == "https" && !sslServers[selected] {
+		trace("port %d of %s is not marked `ssl`, the https request will fail", port, NodeName(selected))
+	}
+	return selected
+}
+
+func matchServerName(servers []parser.Context, host string, trace func(format string, a ...interface{})) parser.Context {
+	for _, server := range servers {
+		for _, name := range ServerNames(server) {
+			if !strings.ContainsAny(name, "*~") && !strings.HasPrefix(name, ".") && strings.ToLower(name) == host {
+				trace("host \"%s\" exactly matches the server name \"%s\" of %s", host, name, NodeName(server))
+				return server
+			}
+		}
+	}
+
+	var selected parser.Context
+	var selectedName string
+	matched := 0
+	for _, server := range servers {
+		for _, name := range ServerNames(server) {
+			lowerName := strings.ToLower(name)
+			var suffix string
+			var ok bool
+			switch {
+			case strings.HasPrefix(lowerName, "*."):
+				suffix = lowerName[1:]
+				ok = strings.HasSuffix(host, suffix)
+			case strings.HasPrefix(lowerName, "."): // `.example.com` matches both `example.com` and `*.example.com`
+				suffix = lowerName
+				ok = strings.HasSuffix(host, suffix) || host == suffix[1:]
+			default:
+				continue
+			}
+			if ok && len(suffix) > matched {
+				selected, selectedName, matched = server, name, len(suffix)
+			}
+		}
+	}
+	if selected != nil {
+		trace("host \"%s\" matches the longest leading wildcard server name \"%s\" of %s", host, selectedName, NodeName(selected))
+		return selected
+	}
+
+	for _, server := range servers {
+		for _, name := range ServerNames(server) {
+			lowerName := strings.ToLower(name)
+			if !strings.HasSuffix(lowerName, ".*") {
+				continue
+			}
+			if prefix := lowerName[:len(lowerName)-1]; strings.HasPrefix(host, prefix) && len(prefix) > matched {
+				selected, selectedName, matched = server, name, len(prefix)
+			}
+		}
+	}
+	if selected != nil {
+		trace("host \"%s\" matches the longest trailing wildcard server name \"%s\" of %s", host, selectedName, NodeName(selected))
+		return selected
+	}
+
+	for _, server := range servers {
+		for _, name := range ServerNames(server) {
+			if !strings.HasPrefix(name, "~") {
+				continue
+			}
+			re, err := regexp.Compile(name[1:])
+			if err != nil {
+				trace("the server name \"%s\" of %s can not be simulated: %s", name, NodeName(server), err)
+				continue
+			}
+			if re.MatchString(host) {
+				trace("host \"%s\" matches the regular expression server name \"%s\" of %s", host, name, NodeName(server))
+				return server
+			}
+		}
+	}
+	return nil
+}
+
+// selectLocation finds the location of the uri in the context: an exact match stops the search; otherwise the longest
+// prefix match is remembered, and its nested locations are searched; then the regular expressions are checked in
+// order, unless the longest prefix match has the `^~` modifier. The returned bool reports that the search is stopped.
+func selectLocation(ctx parser.Context, uri string, trace func(format string, a ...interface{})) (parser.Context, bool) {
+	var prefix parser.Context
+	var prefixMatcher locationMatcher
+	locations := ContextChildren(ctx, parser_type.TypeLocation)
+	for _, location := range locations {
+		matcher := newLocationMatcher(location)
+		switch matcher.modifier {
+		case "=":
+			if matcher.pattern == uri {
+				trace("%s exactly matches \"%s\"", matcher, uri)
+				return location, true
+			}
+		case "", "^~":
+			if strings.HasPrefix(matcher.pattern, "@") || !strings.HasPrefix(uri, matcher.pattern) {
+				continue
+			}
+			if prefix == nil || len(matcher.pattern) > len(prefixMatcher.pattern) {
+				prefix, prefixMatcher = location, matcher
+			}
+		}
+	}
+
+	if prefix != nil {
+		trace("%s is the longest prefix match of \"%s\"", prefixMatcher, uri)
+		// the `^~` modifier of the longest prefix match is read before its nested locations are searched, as nginx does
+		noRegexp := prefixMatcher.modifier == "^~"
+		nested, stopped := selectLocation(prefix, uri, trace)
+		if stopped {
+			return nested, true
+		}
+		if nested != nil {
+			prefix = nested
+		}
+		if noRegexp {
+			trace("%s has the `^~` modifier, the regular expressions are not checked", prefixMatcher)
+			return prefix, true
+		}
+	}
+
+	for _, location := range locations {
+		matcher := newLocationMatcher(location)
+		if !matcher.isRegexp() {
+			continue
+		}
+		pattern := matcher.pattern
+		if matcher.modifier == "~*" {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			trace("%s can not be simulated: %s", matcher, err)
+			continue
+		}
+		if re.MatchString(uri) {
+			trace("%s matches \"%s\"", matcher, uri)
+			return location, true
+		}
+		trace("%s does not match \"%s\"", matcher, uri)
+	}
+	return prefix, false
+}
+
+func listenPort(socket string) int {
+	if strings.HasPrefix(socket, "unix:") {
+		return 0
+	}
+	port, err := strconv.Atoi(socket[strings.LastIndex(socket, ":")+1:])
+	if err != nil {
+		return 0
+	}
+	return port
+}
@@ -0,0 +1,125 @@
+package configuration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRouteSimulator_Simulate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-route-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := `http {
+    root /usr/share/nginx/html;
+    server {
+        listen 80;
+        server_name _;
+    }
+    server {
+        listen 80 default_server;
+        listen 443 ssl;
+        server_name example.com *.example.com;
+        location = /login {
+            proxy_pass http://auth;
+        }
+        location / {
+            proxy_pass http://web;
+        }
+        location ^~ /static/ {
+            root /data;
+            location /static/css/ {
+                root /css;
+            }
+        }
+        location /api/ {
+            proxy_pass http://api;
+            location /api/v2/ {
+                proxy_pass http://api_v2;
+            }
+            location ~ \.json$ {
+                proxy_pass http://json;
+            }
+        }
+        location ~* \.(png|jpg)$ {
+            expires 30d;
+        }
+        location ~ \.css$ {
+            root /assets;
+        }
+    }
+    server {
+        listen 80;
+        server_name *.static.example.com www.example.*;
+    }
+    server {
+        listen 80;
+        server_name ~^(?P<user>\w+)\.example\.org$;
+    }
+}
+`
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		host      string
+		port      int
+		scheme    string
+		uri       string
+		server    string
+		location  string
+		proxyPass string
+		root      string
+	}{
+		{"example.com", 0, "", "/login?next=/", "server [example.com *.example.com]", "location = /login", "http://auth", "/usr/share/nginx/html"},
+		{"EXAMPLE.com:80", 0, "http", "/index.html", "server [example.com *.example.com]", "location /", "http://web", "/usr/share/nginx/html"},
+		{"a.example.com", 443, "https", "/static/logo.png", "server [example.com *.example.com]", "location ^~ /static/", "", "/data"},
+		{"example.com", 80, "http", "/static/css/site.css", "server [example.com *.example.com]", "location /static/css/", "", "/css"},
+		{"example.com", 80, "http", "/site.css", "server [example.com *.example.com]", "location ~ \\.css$", "", "/assets"},
+		{"img.static.example.com", 80, "http", "/a.png", "server [*.static.example.com www.example.*]", "", "", "/usr/share/nginx/html"},
+		{"www.example.net", 80, "http", "/", "server [*.static.example.com www.example.*]", "", "", "/usr/share/nginx/html"},
+		{"bob.example.org", 80, "http", "/", "server [~^(?P<user>\\w+)\\.example\\.org$]", "", "", "/usr/share/nginx/html"},
+		{"unknown.host", 80, "http", "/a.jpg", "server [example.com *.example.com]", "location ~* \\.(png|jpg)$", "", "/usr/share/nginx/html"},
+		{"example.com", 80, "http", "/api/v2/users", "server [example.com *.example.com]", "location /api/v2/", "http://api_v2", "/usr/share/nginx/html"},
+		{"example.com", 80, "http", "/api/v2/users.json", "server [example.com *.example.com]", "location ~ \\.json$", "http://json", "/usr/share/nginx/html"},
+		{"example.com", 8080, "http", "/", "", "", "", ""},
+	}
+	simulator := NewRouteSimulator(c)
+	for _, tt := range tests {
+		result, err := simulator.Simulate(tt.host, tt.port, tt.scheme, tt.uri)
+		if err != nil {
+			t.Errorf("simulate %s%s: %v", tt.host, tt.uri, err)
+			continue
+		}
+		var proxyPass, root string
+		if result.ProxyPass != nil {
+			proxyPass = result.ProxyPass.Value
+		}
+		if result.Root != nil {
+			root = result.Root.Value
+		}
+		if result.Server != tt.server || result.Location != tt.location || proxyPass != tt.proxyPass || root != tt.root {
+			t.Errorf("simulate %s%s: got server %q, location %q, proxy_pass %q, root %q", tt.host, tt.uri, result.Server, result.Location, proxyPass, root)
+			for _, step := range result.Trace {
+				t.Log(step)
+			}
+		}
+	}
+
+	if _, err := simulator.Simulate("example.com", 0, "ftp", "/"); err == nil {
+		t.Errorf("the ftp scheme is simulated")
+	}
+	if _, err := simulator.Simulate("example.com", 0, "", "index.html"); err == nil {
+		t.Errorf("the uri without the leading '/' is simulated")
+	}
+}
@@ -0,0 +1,268 @@
+package configuration
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_indention"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/marmotedu/errors"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var regDefinitionName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// SiteDefinitions is the desired state of the server and upstream blocks of a web server, which are defined in the
+// yaml or json files, e.g.
+//
+//     web-server: bifrost-test
+//     upstreams:
+//       - name: backend
+//         servers: ["127.0.0.1:8080 weight=2", "127.0.0.1:8081"]
+//     sites:
+//       - name: www
+//         server-names: [www.example.com]
+//         listen: ["80"]
+//         tls:
+//           certificate: /etc/nginx/ssl/www.crt
+//           certificate-key: /etc/nginx/ssl/www.key
+//         locations:
+//           - path: /
+//             proxy-pass: http://backend
+type SiteDefinitions struct {
+	WebServer string                `yaml:"web-server"`
+	Upstreams []*UpstreamDefinition `yaml:"upstreams"`
+	Sites     []*SiteDefinition     `yaml:"sites"`
+}
+
+// SiteDefinition defines a server block, the directives are the extra directives in the form of `name value`.
+type SiteDefinition struct {
+	Name        string                `yaml:"name"`
+	ServerNames []string              `yaml:"server-names"`
+	Listen      []string              `yaml:"listen"`
+	TLS         *TLSDefinition        `yaml:"tls"`
+	Directives  []string              `yaml:"directives"`
+	Locations   []*LocationDefinition `yaml:"locations"`
+}
+
+// TLSDefinition defines the tls directives of the server block, the listen defaults to `443 ssl`.
+type TLSDefinition struct {
+	Listen         []string `yaml:"listen"`
+	Certificate    string   `yaml:"certificate"`
+	CertificateKey string   `yaml:"certificate-key"`
+	Protocols      []string `yaml:"protocols"`
+}
+
+// LocationDefinition defines a location block, the path is the value of the location, e.g. `/`, `= /login` or
+// `~* \.png$`.
+type LocationDefinition struct {
+	Path       string                `yaml:"path"`
+	ProxyPass  string                `yaml:"proxy-pass"`
+	Root       string                `yaml:"root"`
+	Alias      string                `yaml:"alias"`
+	Return     string                `yaml:"return"`
+	Directives []string              `yaml:"directives"`
+	Locations  []*LocationDefinition `yaml:"locations"`
+}
+
+// UpstreamDefinition defines an upstream block, the servers are the values of the `server` directives.
+type UpstreamDefinition struct {
+	Name       string   `yaml:"name"`
+	Servers    []string `yaml:"servers"`
+	Directives []string `yaml:"directives"`
+}
+
+// LoadSiteDefinitions loads the site definitions from the `*.yml`, `*.yaml` and `*.json` files in the directory, and
+// merges them by the web server.
+func LoadSiteDefinitions(dir string) (map[string]*SiteDefinitions, error) {
+	var paths []string
+	for _, pattern := range []string{"*.yml", "*.yaml", "*.json"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, matches...)
+	}
+	sort.Strings(paths)
+
+	definitions := make(map[string]*SiteDefinitions)
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		defs := new(SiteDefinitions)
+		if err = yaml.UnmarshalStrict(data, defs); err != nil {
+			return nil, errors.WithCode(code.ErrInvalidSiteDefinition, "failed to load site definitions file '%s', %v", path, err)
+		}
+		if defs.WebServer == "" {
+			return nil, errors.WithCode(code.ErrInvalidSiteDefinition, "the web server of site definitions file '%s' is empty", path)
+		}
+		merged, has := definitions[defs.WebServer]
+		if !has {
+			merged = &SiteDefinitions{WebServer: defs.WebServer}
+			definitions[defs.WebServer] = merged
+		}
+		merged.Upstreams = append(merged.Upstreams, defs.Upstreams...)
+		merged.Sites = append(merged.Sites, defs.Sites...)
+	}
+
+	for _, defs := range definitions {
+		if err := defs.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return definitions, nil
+}
+
+// Validate checks the names are unique, and the values can not inject directives or contexts.
+func (s *SiteDefinitions) Validate() error {
+	upstreams := make(map[string]bool, len(s.Upstreams))
+	for _, upstream := range s.Upstreams {
+		if !regDefinitionName.MatchString(upstream.Name) || upstreams[upstream.Name] {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "invalid or duplicate upstream name '%s' of web server '%s'", upstream.Name, s.WebServer)
+		}
+		upstreams[upstream.Name] = true
+		if len(upstream.Servers) == 0 {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "upstream '%s' of web server '%s' has no server", upstream.Name, s.WebServer)
+		}
+		if err := checkDefinitionValues(upstream.Servers...); err != nil {
+			return errors.Wrapf(err, "invalid upstream '%s'", upstream.Name)
+		}
+		if err := checkDefinitionValues(upstream.Directives...); err != nil {
+			return errors.Wrapf(err, "invalid upstream '%s'", upstream.Name)
+		}
+	}
+
+	sites := make(map[string]bool, len(s.Sites))
+	for _, site := range s.Sites {
+		if !regDefinitionName.MatchString(site.Name) || sites[site.Name] {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "invalid or duplicate site name '%s' of web server '%s'", site.Name, s.WebServer)
+		}
+		sites[site.Name] = true
+		if len(site.ServerNames) == 0 {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "site '%s' of web server '%s' has no server name", site.Name, s.WebServer)
+		}
+		if len(site.Listen) == 0 && site.TLS == nil {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "site '%s' of web server '%s' listens nothing", site.Name, s.WebServer)
+		}
+		values := append(append(append([]string{}, site.ServerNames...), site.Listen...), site.Directives...)
+		if site.TLS != nil {
+			if site.TLS.Certificate == "" || site.TLS.CertificateKey == "" {
+				return errors.WithCode(code.ErrInvalidSiteDefinition, "the tls of site '%s' needs the certificate and the certificate key", site.Name)
+			}
+			values = append(append(values, site.TLS.Listen...), site.TLS.Protocols...)
+			values = append(values, site.TLS.Certificate, site.TLS.CertificateKey)
+		}
+		if err := checkDefinitionValues(values...); err != nil {
+			return errors.Wrapf(err, "invalid site '%s'", site.Name)
+		}
+		if err := checkLocationDefinitions(site.Locations); err != nil {
+			return errors.Wrapf(err, "invalid site '%s'", site.Name)
+		}
+	}
+	return nil
+}
+
+func checkLocationDefinitions(locations []*LocationDefinition) error {
+	for _, location := range locations {
+		if strings.TrimSpace(location.Path) == "" {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "the path of location cannot be empty")
+		}
+		err := checkDefinitionValues(append([]string{location.Path, location.ProxyPass, location.Root, location.Alias, location.Return}, location.Directives...)...)
+		if err != nil {
+			return err
+		}
+		if err = checkLocationDefinitions(location.Locations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDefinitionValues checks the values have no characters which can end a directive or open a context.
+func checkDefinitionValues(values ...string) error {
+	for _, value := range values {
+		if strings.ContainsAny(value, ";{}#\r\n") {
+			return errors.WithCode(code.ErrInvalidSiteDefinition, "value '%s' cannot contain any of ';{}#' or line breaks", value)
+		}
+	}
+	return nil
+}
+
+// parser builds the server block of the site, indented with the indention.
+func (s *SiteDefinition) parser(indention parser_indention.Indention) parser.Context {
+	server := parser.NewContext("", parser_type.TypeServer, indention)
+	inner := indention.NextIndention()
+	appendKey := func(ctx parser.Context, ind parser_indention.Indention, name, value string) {
+		_ = ctx.Insert(parser.NewKey(name, normalizeValue(value), ind), ctx.Len())
+	}
+	for _, listen := range s.Listen {
+		appendKey(server, inner, "listen", listen)
+	}
+	if s.TLS != nil {
+		listens := s.TLS.Listen
+		if len(listens) == 0 {
+			listens = []string{"443 ssl"}
+		}
+		for _, listen := range listens {
+			appendKey(server, inner, "listen", listen)
+		}
+	}
+	appendKey(server, inner, "server_name", strings.Join(s.ServerNames, " "))
+	if s.TLS != nil {
+		appendKey(server, inner, "ssl_certificate", s.TLS.Certificate)
+		appendKey(server, inner, "ssl_certificate_key", s.TLS.CertificateKey)
+		if len(s.TLS.Protocols) > 0 {
+			appendKey(server, inner, "ssl_protocols", strings.Join(s.TLS.Protocols, " "))
+		}
+	}
+	appendDirectives(server, inner, s.Directives)
+	appendLocations(server, inner, s.Locations)
+	return server
+}
+
+func appendLocations(ctx parser.Context, indention parser_indention.Indention, locations []*LocationDefinition) {
+	for _, l := range locations {
+		location := parser.NewContext(normalizeValue(l.Path), parser_type.TypeLocation, indention)
+		inner := indention.NextIndention()
+		for _, kv := range [][2]string{{"proxy_pass", l.ProxyPass}, {"root", l.Root}, {"alias", l.Alias}, {"return", l.Return}} {
+			if kv[1] != "" {
+				_ = location.Insert(parser.NewKey(kv[0], normalizeValue(kv[1]), inner), location.Len())
+			}
+		}
+		appendDirectives(location, inner, l.Directives)
+		appendLocations(location, inner, l.Locations)
+		_ = ctx.Insert(location, ctx.Len())
+	}
+}
+
+// parser builds the upstream block, indented with the indention.
+func (u *UpstreamDefinition) parser(indention parser_indention.Indention) parser.Context {
+	upstream := parser.NewContext(u.Name, parser_type.TypeUpstream, indention)
+	inner := indention.NextIndention()
+	for _, server := range u.Servers {
+		_ = upstream.Insert(parser.NewKey("server", normalizeValue(server), inner), upstream.Len())
+	}
+	appendDirectives(upstream, inner, u.Directives)
+	return upstream
+}
+
+// appendDirectives appends the directives in the form of `name value` to the context.
+func appendDirectives(ctx parser.Context, indention parser_indention.Indention, directives []string) {
+	for _, directive := range directives {
+		fields := strings.Fields(directive)
+		if len(fields) == 0 {
+			continue
+		}
+		_ = ctx.Insert(parser.NewKey(fields[0], strings.Join(fields[1:], " "), indention), ctx.Len())
+	}
+}
+
+func normalizeValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,320 @@
+package configuration
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_indention"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/marmotedu/errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// UpstreamManager manages the members of the upstream blocks, which are the `server` directives of them. The upstream
+// is the first upstream block of the name, and the member is the first `server` directive of the address in it.
+type UpstreamManager interface {
+	List() []*v1.Upstream
+	AddMember(upstream string, member *v1.UpstreamMember) error
+	RemoveMember(upstream, address string) error
+	SetWeight(upstream, address string, weight int) error
+	SetState(upstream, address string, state v1.UpstreamMemberState) error
+}
+
+type upstreamManager struct {
+	configuration *configuration
+}
+
+// upstreamBlock is an upstream context with its father context and index.
+type upstreamBlock struct {
+	ctx       parser.Context
+	fatherCtx parser.Context
+	index     int
+}
+
+// upstreamMemberKey is a `server` directive of an upstream context, the father context is the upstream context, or the
+// config included by it.
+type upstreamMemberKey struct {
+	key       *parser.Key
+	fatherCtx parser.Context
+	index     int
+}
+
+func (u *upstreamManager) List() []*v1.Upstream {
+	u.configuration.rwLocker.RLock()
+	defer u.configuration.rwLocker.RUnlock()
+	upstreams := make([]*v1.Upstream, 0)
+	for _, block := range u.upstreamBlocks(":reg: .*") {
+		upstream := &v1.Upstream{
+			Name:     block.ctx.GetValue(),
+			Position: block.ctx.GetPosition(),
+			Members:  make([]*v1.UpstreamMember, 0),
+		}
+		for _, member := range upstreamMemberKeys(block.ctx) {
+			m := ParseUpstreamMember(member.key.Value)
+			m.Position = member.fatherCtx.GetPosition()
+			upstream.Members = append(upstream.Members, m)
+		}
+		upstreams = append(upstreams, upstream)
+	}
+	return upstreams
+}
+
+// AddMember appends the member to the upstream, after the existing members.
+func (u *upstreamManager) AddMember(upstream string, member *v1.UpstreamMember) error {
+	if member == nil {
+		return errors.WithCode(code.ErrInvalidUpstreamMember, "the member to add is empty")
+	}
+	value, err := FormatUpstreamMember(member)
+	if err != nil {
+		return err
+	}
+	u.configuration.rwLocker.Lock()
+	defer u.configuration.rwLocker.Unlock()
+	block, err := u.upstreamBlock(upstream)
+	if err != nil {
+		return err
+	}
+	if _, err = findUpstreamMember(block, member.Address); err == nil {
+		return errors.WithCode(code.ErrInvalidUpstreamMember, "member '%s' already exists in upstream '%s'", member.Address, upstream)
+	}
+
+	// the member is appended after the last member, so that it is in the config file of the other members
+	target, index := block.ctx, block.ctx.Len()
+	if members := upstreamMemberKeys(block.ctx); len(members) > 0 {
+		last := members[len(members)-1]
+		target, index = last.fatherCtx, last.index+1
+	}
+	return u.configuration.insertByIndex(parser.NewKey("server", value, memberIndention(target)), target, index)
+}
+
+// RemoveMember removes the member from the upstream, the last member can not be removed, since the upstream without
+// any server is rejected by nginx.
+func (u *upstreamManager) RemoveMember(upstream, address string) error {
+	u.configuration.rwLocker.Lock()
+	defer u.configuration.rwLocker.Unlock()
+	block, err := u.upstreamBlock(upstream)
+	if err != nil {
+		return err
+	}
+	member, err := findUpstreamMember(block, address)
+	if err != nil {
+		return err
+	}
+	if len(upstreamMemberKeys(block.ctx)) == 1 {
+		return errors.WithCode(code.ErrInvalidUpstreamMember, "cannot remove the last member '%s' of upstream '%s'", address, upstream)
+	}
+	return member.fatherCtx.Remove(member.index)
+}
+
+func (u *upstreamManager) SetWeight(upstream, address string, weight int) error {
+	if weight < 1 {
+		return errors.WithCode(code.ErrInvalidUpstreamMember, "invalid weight %d, it should be at least 1", weight)
+	}
+	return u.modifyMember(upstream, address, func(member *v1.UpstreamMember) {
+		member.Weight = weight
+	})
+}
+
+// SetState marks the member up, down or draining.
+func (u *upstreamManager) SetState(upstream, address string, state v1.UpstreamMemberState) error {
+	switch state {
+	case v1.UpstreamMemberUp, v1.UpstreamMemberDown, v1.UpstreamMemberDrain:
+	default:
+		return errors.WithCode(code.ErrInvalidUpstreamMember, "invalid member state '%s'", state)
+	}
+	return u.modifyMember(upstream, address, func(member *v1.UpstreamMember) {
+		member.State = state
+	})
+}
+
+// modifyMember rewrites the `server` directive of the member with the modify function, the parameters not parsed are
+// kept as they are.
+func (u *upstreamManager) modifyMember(upstream, address string, modify func(member *v1.UpstreamMember)) error {
+	u.configuration.rwLocker.Lock()
+	defer u.configuration.rwLocker.Unlock()
+	block, err := u.upstreamBlock(upstream)
+	if err != nil {
+		return err
+	}
+	found, err := findUpstreamMember(block, address)
+	if err != nil {
+		return err
+	}
+	member := ParseUpstreamMember(found.key.Value)
+	modify(member)
+	value, err := FormatUpstreamMember(member)
+	if err != nil {
+		return err
+	}
+	return found.fatherCtx.Modify(parser.NewKey("server", value, memberIndention(found.fatherCtx)), found.index)
+}
+
+func (u *upstreamManager) upstreamBlock(name string) (*upstreamBlock, error) {
+	if name == "" || strings.ContainsAny(name, " \t") {
+		return nil, errors.WithCode(code.ErrUpstreamNotFound, "invalid upstream name '%s'", name)
+	}
+	blocks := u.upstreamBlocks(name)
+	if len(blocks) == 0 {
+		return nil, errors.WithCode(code.ErrUpstreamNotFound, "upstream '%s' not found", name)
+	}
+	return blocks[0], nil
+}
+
+// upstreamBlocks returns the upstream blocks matched by the value of the keyword, in the order of their positions.
+func (u *upstreamManager) upstreamBlocks(value string) []*upstreamBlock {
+	keyword, err := parseKeyword("upstream:sep: " + value)
+	if err != nil {
+		return nil
+	}
+	blocks := make([]*upstreamBlock, 0)
+	for fatherCtx, indexes := range u.configuration.config.QueryAll(keyword) {
+		for _, index := range indexes {
+			child, err := fatherCtx.GetChild(index)
+			if err != nil {
+				continue
+			}
+			if ctx, ok := child.(parser.Context); ok {
+				blocks = append(blocks, &upstreamBlock{ctx: ctx, fatherCtx: fatherCtx, index: index})
+			}
+		}
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		if blocks[i].fatherCtx.GetPosition() != blocks[j].fatherCtx.GetPosition() {
+			return blocks[i].fatherCtx.GetPosition() < blocks[j].fatherCtx.GetPosition()
+		}
+		return blocks[i].index < blocks[j].index
+	})
+	return blocks
+}
+
+// upstreamMemberKeys returns the `server` directives of the context in order, including the ones brought in by
+// `include` directives.
+func upstreamMemberKeys(ctx parser.Context) []*upstreamMemberKey {
+	members := make([]*upstreamMemberKey, 0)
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			break
+		}
+		switch child.GetType() {
+		case parser_type.TypeKey:
+			if key, ok := child.(*parser.Key); ok && key.Name == "server" {
+				members = append(members, &upstreamMemberKey{key: key, fatherCtx: ctx, index: i})
+			}
+		case parser_type.TypeInclude, parser_type.TypeConfig:
+			if subCtx, ok := child.(parser.Context); ok {
+				members = append(members, upstreamMemberKeys(subCtx)...)
+			}
+		}
+	}
+	return members
+}
+
+func findUpstreamMember(block *upstreamBlock, address string) (*upstreamMemberKey, error) {
+	for _, member := range upstreamMemberKeys(block.ctx) {
+		if fields := strings.Fields(member.key.Value); len(fields) > 0 && fields[0] == address {
+			return member, nil
+		}
+	}
+	return nil, errors.WithCode(code.ErrUpstreamMemberNotFound, "member '%s' not found in upstream '%s'", address, block.ctx.GetValue())
+}
+
+// memberIndention returns the indention of the directives in the context, the directives of a config are as deep as
+// the config.
+func memberIndention(ctx parser.Context) parser_indention.Indention {
+	if ctx.GetType() == parser_type.TypeConfig {
+		return ctx.GetIndention()
+	}
+	return ctx.GetIndention().NextIndention()
+}
+
+// ParseUpstreamMember parses the value of the `server` directive of an upstream block, e.g.
+// `127.0.0.1:8080 weight=2 max_fails=3 fail_timeout=10s backup`.
+func ParseUpstreamMember(value string) *v1.UpstreamMember {
+	fields := strings.Fields(value)
+	member := &v1.UpstreamMember{State: v1.UpstreamMemberUp}
+	if len(fields) == 0 {
+		return member
+	}
+	member.Address = fields[0]
+	for _, field := range fields[1:] {
+		kv := strings.SplitN(field, "=", 2)
+		switch {
+		case kv[0] == "weight" && len(kv) == 2:
+			if weight, err := strconv.Atoi(kv[1]); err == nil {
+				member.Weight = weight
+				continue
+			}
+		case kv[0] == "max_fails" && len(kv) == 2:
+			if maxFails, err := strconv.Atoi(kv[1]); err == nil {
+				member.MaxFails = &maxFails
+				continue
+			}
+		case kv[0] == "fail_timeout" && len(kv) == 2:
+			member.FailTimeout = kv[1]
+			continue
+		case field == "backup":
+			member.Backup = true
+			continue
+		case field == "down":
+			member.State = v1.UpstreamMemberDown
+			continue
+		case field == "drain":
+			member.State = v1.UpstreamMemberDrain
+			continue
+		}
+		member.Params = append(member.Params, field)
+	}
+	return member
+}
+
+// FormatUpstreamMember formats the member into the value of the `server` directive, the parsed parameters are followed
+// by the others.
+func FormatUpstreamMember(member *v1.UpstreamMember) (string, error) {
+	if member.Address == "" || strings.ContainsAny(member.Address, " \t") {
+		return "", errors.WithCode(code.ErrInvalidUpstreamMember, "invalid member address '%s'", member.Address)
+	}
+	fields := []string{member.Address}
+	if member.Weight < 0 {
+		return "", errors.WithCode(code.ErrInvalidUpstreamMember, "invalid weight %d of member '%s'", member.Weight, member.Address)
+	}
+	if member.Weight > 0 {
+		fields = append(fields, fmt.Sprintf("weight=%d", member.Weight))
+	}
+	if member.MaxFails != nil {
+		if *member.MaxFails < 0 {
+			return "", errors.WithCode(code.ErrInvalidUpstreamMember, "invalid max fails %d of member '%s'", *member.MaxFails, member.Address)
+		}
+		fields = append(fields, fmt.Sprintf("max_fails=%d", *member.MaxFails))
+	}
+	if member.FailTimeout != "" {
+		fields = append(fields, "fail_timeout="+member.FailTimeout)
+	}
+	if member.Backup {
+		fields = append(fields, "backup")
+	}
+	switch member.State {
+	case "", v1.UpstreamMemberUp:
+	case v1.UpstreamMemberDown:
+		fields = append(fields, "down")
+	case v1.UpstreamMemberDrain:
+		fields = append(fields, "drain")
+	default:
+		return "", errors.WithCode(code.ErrInvalidUpstreamMember, "invalid state '%s' of member '%s'", member.State, member.Address)
+	}
+	fields = append(fields, member.Params...)
+	for _, field := range fields {
+		if strings.ContainsAny(field, ";{}#'\" \t\r\n") {
+			return "", errors.WithCode(code.ErrInvalidUpstreamMember, "invalid parameter '%s' of member '%s'", field, member.Address)
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+func NewUpstreamManager(c Configuration) UpstreamManager {
+	// the configuration interface can not be implemented out of the package
+	return &upstreamManager{configuration: c.(*configuration)}
+}
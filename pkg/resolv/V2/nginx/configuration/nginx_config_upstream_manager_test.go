@@ -0,0 +1,157 @@
+package configuration
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseUpstreamMember(t *testing.T) {
+	maxFails := 3
+	tests := []struct {
+		value string
+		want  *v1.UpstreamMember
+	}{
+		{
+			value: "127.0.0.1:8080",
+			want:  &v1.UpstreamMember{Address: "127.0.0.1:8080", State: v1.UpstreamMemberUp},
+		},
+		{
+			value: "unix:/tmp/backend.sock  weight=2 max_fails=3 fail_timeout=10s backup max_conns=10",
+			want: &v1.UpstreamMember{
+				Address:     "unix:/tmp/backend.sock",
+				Weight:      2,
+				MaxFails:    &maxFails,
+				FailTimeout: "10s",
+				Backup:      true,
+				State:       v1.UpstreamMemberUp,
+				Params:      []string{"max_conns=10"},
+			},
+		},
+		{
+			value: "backend.example.com:8080 down",
+			want:  &v1.UpstreamMember{Address: "backend.example.com:8080", State: v1.UpstreamMemberDown},
+		},
+		{
+			value: "backend.example.com:8080 weight=x drain",
+			want:  &v1.UpstreamMember{Address: "backend.example.com:8080", State: v1.UpstreamMemberDrain, Params: []string{"weight=x"}},
+		},
+	}
+	for _, tt := range tests {
+		got := ParseUpstreamMember(tt.value)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseUpstreamMember(%q) = %+v, want %+v", tt.value, got, tt.want)
+		}
+		value, err := FormatUpstreamMember(got)
+		if err != nil {
+			t.Fatalf("FormatUpstreamMember() error = %+v", err)
+		}
+		if !reflect.DeepEqual(ParseUpstreamMember(value), tt.want) {
+			t.Errorf("FormatUpstreamMember() = %q, which is not parsed back to %+v", value, tt.want)
+		}
+	}
+
+	for _, member := range []*v1.UpstreamMember{
+		{Address: ""},
+		{Address: "127.0.0.1:8080;"},
+		{Address: "127.0.0.1:8080", Weight: -1},
+		{Address: "127.0.0.1:8080", State: "unknown"},
+		{Address: "127.0.0.1:8080", Params: []string{"max_conns=1; include /etc/passwd"}},
+	} {
+		if _, err := FormatUpstreamMember(member); !errors.IsCode(err, code.ErrInvalidUpstreamMember) {
+			t.Errorf("FormatUpstreamMember(%+v) error = %v, want ErrInvalidUpstreamMember", member, err)
+		}
+	}
+}
+
+func TestUpstreamManager(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-upstream-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := "http {\n" +
+		"    upstream backend {\n" +
+		"        server 127.0.0.1:8080 weight=2 max_fails=3 fail_timeout=10s;\n" +
+		"        server 127.0.0.1:8081 max_conns=10;\n" +
+		"        keepalive 16;\n" +
+		"    }\n" +
+		"    upstream single {\n" +
+		"        server 127.0.0.1:9090;\n" +
+		"    }\n" +
+		"}\n"
+	if err = ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := NewUpstreamManager(c)
+
+	upstreams := manager.List()
+	if len(upstreams) != 2 || upstreams[0].Name != "backend" || len(upstreams[0].Members) != 2 || upstreams[1].Name != "single" {
+		t.Fatalf("List() = %+v", upstreams)
+	}
+	if member := upstreams[0].Members[0]; member.Address != "127.0.0.1:8080" || member.Weight != 2 || member.Position != confPath {
+		t.Errorf("List() member = %+v", member)
+	}
+
+	if err = manager.AddMember("backend", &v1.UpstreamMember{Address: "127.0.0.1:8082", Backup: true}); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = manager.SetWeight("backend", "127.0.0.1:8081", 5); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = manager.SetState("backend", "127.0.0.1:8080", v1.UpstreamMemberDown); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = manager.RemoveMember("single", "127.0.0.1:9090"); !errors.IsCode(err, code.ErrInvalidUpstreamMember) {
+		t.Errorf("RemoveMember() of the last member error = %v, want ErrInvalidUpstreamMember", err)
+	}
+	want := "http {\n" +
+		"    upstream backend {\n" +
+		"        server 127.0.0.1:8080 weight=2 max_fails=3 fail_timeout=10s down;\n" +
+		"        server 127.0.0.1:8081 weight=5 max_conns=10;\n" +
+		"        server 127.0.0.1:8082 backup;\n" +
+		"        keepalive 16;\n" +
+		"    }\n"
+	if view := string(c.View()); !strings.HasPrefix(view, want) {
+		t.Errorf("View() = \n%s\nwant the prefix\n%s", view, want)
+	}
+
+	if err = manager.SetState("backend", "127.0.0.1:8080", v1.UpstreamMemberUp); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if err = manager.RemoveMember("backend", "127.0.0.1:8082"); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if members := manager.List()[0].Members; len(members) != 2 || members[0].State != v1.UpstreamMemberUp {
+		t.Errorf("List() members = %+v", members)
+	}
+
+	errTests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"unknown upstream", manager.SetWeight("unknown", "127.0.0.1:8080", 1), code.ErrUpstreamNotFound},
+		{"unknown member", manager.SetWeight("backend", "127.0.0.1:9999", 1), code.ErrUpstreamMemberNotFound},
+		{"zero weight", manager.SetWeight("backend", "127.0.0.1:8080", 0), code.ErrInvalidUpstreamMember},
+		{"invalid state", manager.SetState("backend", "127.0.0.1:8080", "paused"), code.ErrInvalidUpstreamMember},
+		{"duplicate member", manager.AddMember("backend", &v1.UpstreamMember{Address: "127.0.0.1:8080"}), code.ErrInvalidUpstreamMember},
+	}
+	for _, tt := range errTests {
+		if !errors.IsCode(tt.err, tt.code) {
+			t.Errorf("%s: error = %v, want code %d", tt.name, tt.err, tt.code)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package linter
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/marmotedu/errors"
+	"strings"
+)
+
+// RuleOff is the rule setting value used to disable the rule.
+const RuleOff = "off"
+
+type Rule interface {
+	Name() string
+	Description() string
+	DefaultSeverity() v1.LintSeverity
+	Check(c configuration.Configuration) []*v1.LintIssue
+}
+
+type Linter interface {
+	Lint(c configuration.Configuration) []*v1.LintIssue
+}
+
+type linter struct {
+	rules      []Rule
+	severities map[string]v1.LintSeverity
+}
+
+// Lint checks the configuration with the enabled rules, and marks the issues with the rule name and the severity. The
+// conflicts of the configuration are analyzed once for all the enabled conflict rules.
+func (l *linter) Lint(c configuration.Configuration) []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	var conflicts []*v1.LintIssue
+	for _, rule := range l.rules {
+		var ruleIssues []*v1.LintIssue
+		if cr, ok := rule.(conflictRule); ok {
+			if conflicts == nil {
+				conflicts = configuration.NewConflictAnalyzer(c).Analyze()
+			}
+			ruleIssues = cr.filter(conflicts)
+		} else {
+			ruleIssues = rule.Check(c)
+		}
+		for _, issue := range ruleIssues {
+			if issue.Rule == "" {
+				issue.Rule = rule.Name()
+			}
+			issue.Severity = l.severities[rule.Name()]
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// New creates a linter with the rules of the registry. The settings map the rule names to `off` or the severities
+// overriding the default ones, the rules that are not set are enabled with the default severities.
+func New(registry Registry, settings map[string]string) (Linter, error) {
+	if err := ValidateSettings(registry, settings); err != nil {
+		return nil, err
+	}
+	l := &linter{
+		rules:      make([]Rule, 0),
+		severities: make(map[string]v1.LintSeverity),
+	}
+	for _, rule := range registry.Rules() {
+		severity := rule.DefaultSeverity()
+		if setting, has := settings[rule.Name()]; has {
+			if strings.ToLower(setting) == RuleOff {
+				continue
+			}
+			severity = v1.LintSeverity(strings.ToLower(setting))
+		}
+		l.rules = append(l.rules, rule)
+		l.severities[rule.Name()] = severity
+	}
+	return l, nil
+}
+
+// ValidateSettings checks the rule names and the setting values of the settings.
+func ValidateSettings(registry Registry, settings map[string]string) error {
+	var errs []error
+	for name, setting := range settings {
+		if _, has := registry.Rule(name); !has {
+			errs = append(errs, errors.WithCode(code.ErrLintRuleNotFound, "lint rule '%s' not found", name))
+		}
+		if strings.ToLower(setting) != RuleOff && v1.LintSeverity(strings.ToLower(setting)).Rank() == 0 {
+			errs = append(errs, errors.WithCode(code.ErrInvalidLintSeverity, "invalid setting '%s' of lint rule '%s', it can only be `off`, `info`, `warning` or `error`", setting, name))
+		}
+	}
+	return errors.NewAggregate(errs)
+}
@@ -0,0 +1,212 @@
+package linter
+
+import (
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfig = `http {
+    server_tokens on;
+    proxy_set_header X-Real-IP $remote_addr;
+    server {
+        listen 443 ssl;
+        server_name example.com;
+        ssl_protocols TLSv1 TLSv1.1 TLSv1.2;
+        client_max_body_size 10m;
+        location / {
+            proxy_pass http://backend;
+        }
+        location /api {
+            proxy_set_header Host $host;
+            proxy_pass http://api;
+            if ($request_method = POST) {
+                return 405;
+            }
+        }
+        location /files {
+            autoindex on;
+            if ($http_user_agent ~ curl) {
+                rewrite ^ /curl last;
+                add_header X-Curl 1;
+            }
+        }
+    }
+    server {
+        listen 80;
+        server_name example.com;
+        proxy_set_header Host $host;
+        location / {
+            proxy_pass http://backend;
+        }
+    }
+}
+`
+
+func loadTestConfiguration(t *testing.T, dir string) configuration.Configuration {
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err := ioutil.WriteFile(confPath, []byte(testConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := configuration.NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestLinter_Lint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-linter-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := loadTestConfiguration(t, dir)
+	l, err := New(DefaultRegistry(), map[string]string{
+		RuleAutoindexOn:    RuleOff,
+		RuleServerTokensOn: "error",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		rule     string
+		severity v1.LintSeverity
+	}{
+		{RuleServerTokensOn, v1.LintSeverityError},
+		{RuleMissingClientMaxBodySize, v1.LintSeverityInfo},
+		{RuleInsecureSSLProtocols, v1.LintSeverityError},
+		{RuleProxyPassWithoutHost, v1.LintSeverityWarning},
+		{RuleUnsafeIfInLocation, v1.LintSeverityWarning},
+	}
+	issues := l.Lint(c)
+	if len(issues) != len(want) {
+		for _, issue := range issues {
+			t.Logf("[%s] %s: %s", issue.Severity, issue.Rule, issue.Message)
+		}
+		t.Fatalf("got %d issues, want %d", len(issues), len(want))
+	}
+	for i, w := range want {
+		if issues[i].Rule != w.rule || issues[i].Severity != w.severity {
+			t.Errorf("issue %d: got [%s] %s, want [%s] %s", i, issues[i].Severity, issues[i].Rule, w.severity, w.rule)
+		}
+	}
+	report := &v1.LintReport{Issues: issues}
+	if report.MaxSeverity() != v1.LintSeverityError {
+		t.Errorf("got max severity %s, want %s", report.MaxSeverity(), v1.LintSeverityError)
+	}
+}
+
+func TestLinter_LintConflicts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-linter-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	confPath := filepath.Join(dir, "nginx.conf")
+	conf := `http {
+    server {
+        listen 80 default_server;
+        server_name example.com;
+        client_max_body_size 10m;
+        location /api/v1 {
+        }
+        location ~ ^/api {
+        }
+        location /static {
+        }
+        location ^~ /static {
+        }
+    }
+    server {
+        listen 80 default_server;
+        server_name example.com;
+        client_max_body_size 10m;
+    }
+}
+`
+	if err = ioutil.WriteFile(confPath, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := configuration.NewConfigurationFromPath(confPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := New(DefaultRegistry(), map[string]string{
+		RuleDuplicateDefaultServer: RuleOff,
+		RuleShadowedLocation:       "error",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the conflicts are reported by the enabled conflict rules in the order of the rules
+	want := []struct {
+		rule     string
+		severity v1.LintSeverity
+	}{
+		{RuleDuplicateServerName, v1.LintSeverityError},
+		{RuleDuplicateLocation, v1.LintSeverityError},
+		{RuleShadowedLocation, v1.LintSeverityError},
+	}
+	issues := l.Lint(c)
+	if len(issues) != len(want) {
+		for _, issue := range issues {
+			t.Logf("[%s] %s: %s", issue.Severity, issue.Rule, issue.Message)
+		}
+		t.Fatalf("got %d issues, want %d", len(issues), len(want))
+	}
+	for i, w := range want {
+		if issues[i].Rule != w.rule || issues[i].Severity != w.severity {
+			t.Errorf("issue %d: got [%s] %s, want [%s] %s", i, issues[i].Severity, issues[i].Rule, w.severity, w.rule)
+		}
+	}
+
+	// the conflict rule checks the configuration by itself as well
+	rule, _ := DefaultRegistry().Rule(RuleDuplicateLocation)
+	if got := rule.Check(c); len(got) != 1 || got[0].Rule != RuleDuplicateLocation {
+		t.Errorf("Check() of %s = %v, want the duplicate location", RuleDuplicateLocation, got)
+	}
+}
+
+func TestNew_InvalidSettings(t *testing.T) {
+	if _, err := New(DefaultRegistry(), map[string]string{"no-such-rule": "warning"}); err == nil {
+		t.Error("unknown rule is accepted")
+	}
+	if _, err := New(DefaultRegistry(), map[string]string{RuleAutoindexOn: "fatal"}); err == nil {
+		t.Error("invalid severity is accepted")
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-linter-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r := NewRegistry()
+	custom := NewRule("custom", "custom rule", v1.LintSeverityInfo, func(c configuration.Configuration) []*v1.LintIssue {
+		return []*v1.LintIssue{{Message: "custom issue"}}
+	})
+	if err = r.Register(custom); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register(custom); err == nil {
+		t.Error("duplicate rule is registered")
+	}
+	l, err := New(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := l.Lint(loadTestConfiguration(t, dir))
+	if len(issues) != 1 || issues[0].Rule != "custom" || issues[0].Severity != v1.LintSeverityInfo {
+		t.Errorf("got issues %v, want the custom issue", issues)
+	}
+}
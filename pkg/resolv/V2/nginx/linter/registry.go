@@ -0,0 +1,75 @@
+package linter
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"sync"
+)
+
+type Registry interface {
+	Register(rules ...Rule) error
+	Rule(name string) (Rule, bool)
+	// Rules returns the registered rules in the registration order.
+	Rules() []Rule
+}
+
+type registry struct {
+	rules  []Rule
+	index  map[string]Rule
+	locker *sync.RWMutex
+}
+
+func (r *registry) Register(rules ...Rule) error {
+	r.locker.Lock()
+	defer r.locker.Unlock()
+	for _, rule := range rules {
+		if _, has := r.index[rule.Name()]; has {
+			return errors.WithCode(code.ErrLintRuleAlreadyRegistered, "lint rule '%s' is already registered", rule.Name())
+		}
+	}
+	for _, rule := range rules {
+		r.rules = append(r.rules, rule)
+		r.index[rule.Name()] = rule
+	}
+	return nil
+}
+
+func (r *registry) Rule(name string) (Rule, bool) {
+	r.locker.RLock()
+	defer r.locker.RUnlock()
+	rule, has := r.index[name]
+	return rule, has
+}
+
+func (r *registry) Rules() []Rule {
+	r.locker.RLock()
+	defer r.locker.RUnlock()
+	rules := make([]Rule, len(r.rules))
+	copy(rules, r.rules)
+	return rules
+}
+
+func NewRegistry() Registry {
+	return &registry{
+		rules:  make([]Rule, 0),
+		index:  make(map[string]Rule),
+		locker: new(sync.RWMutex),
+	}
+}
+
+var defaultRegistry = NewRegistry()
+
+func init() {
+	if err := defaultRegistry.Register(builtinRules()...); err != nil {
+		panic(err)
+	}
+}
+
+// DefaultRegistry returns the registry of the built-in rules, the custom rules can be registered into it by Register.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
+
+func Register(rules ...Rule) error {
+	return defaultRegistry.Register(rules...)
+}
@@ -0,0 +1,263 @@
+package linter
+
+import (
+	"fmt"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"strings"
+)
+
+const ( // built-in lint rules
+	RuleServerTokensOn           = "server-tokens-on"
+	RuleMissingClientMaxBodySize = "missing-client-max-body-size"
+	RuleInsecureSSLProtocols     = "insecure-ssl-protocols"
+	RuleAutoindexOn              = "autoindex-on"
+	RuleProxyPassWithoutHost     = "proxy-pass-without-host"
+	RuleUnsafeIfInLocation       = "unsafe-if-in-location"
+	RuleDuplicateServerName      = configuration.RuleDuplicateServerName
+	RuleDuplicateDefaultServer   = configuration.RuleDuplicateDefaultServer
+	RuleDuplicateLocation        = configuration.RuleDuplicateLocation
+	RuleShadowedLocation         = configuration.RuleShadowedLocation
+)
+
+type rule struct {
+	name        string
+	description string
+	severity    v1.LintSeverity
+	check       func(c configuration.Configuration) []*v1.LintIssue
+}
+
+func (r rule) Name() string {
+	return r.name
+}
+
+func (r rule) Description() string {
+	return r.description
+}
+
+func (r rule) DefaultSeverity() v1.LintSeverity {
+	return r.severity
+}
+
+func (r rule) Check(c configuration.Configuration) []*v1.LintIssue {
+	return r.check(c)
+}
+
+// NewRule creates a rule with the check function, which is the simplest way to implement a custom rule.
+func NewRule(name, description string, severity v1.LintSeverity, check func(c configuration.Configuration) []*v1.LintIssue) Rule {
+	return rule{
+		name:        name,
+		description: description,
+		severity:    severity,
+		check:       check,
+	}
+}
+
+func builtinRules() []Rule {
+	return []Rule{
+		NewRule(RuleServerTokensOn, "`server_tokens on` exposes the nginx version", v1.LintSeverityWarning, checkKeys(func(key *parser.Key) string {
+			if key.Name == "server_tokens" && strings.ToLower(key.Value) == "on" {
+				return "`server_tokens on` exposes the nginx version in error pages and the `Server` response header"
+			}
+			return ""
+		})),
+		NewRule(RuleMissingClientMaxBodySize, "http server without `client_max_body_size`", v1.LintSeverityInfo, checkMissingClientMaxBodySize),
+		NewRule(RuleInsecureSSLProtocols, "`ssl_protocols` allows SSLv2, SSLv3, TLSv1 or TLSv1.1", v1.LintSeverityError, checkKeys(func(key *parser.Key) string {
+			if key.Name != "ssl_protocols" {
+				return ""
+			}
+			insecure := make([]string, 0)
+			for _, protocol := range strings.Fields(key.Value) {
+				switch protocol {
+				case "SSLv2", "SSLv3", "TLSv1", "TLSv1.1":
+					insecure = append(insecure, protocol)
+				}
+			}
+			if len(insecure) == 0 {
+				return ""
+			}
+			return fmt.Sprintf("`ssl_protocols` allows the insecure protocols: %s", strings.Join(insecure, ", "))
+		})),
+		NewRule(RuleAutoindexOn, "`autoindex on` exposes the directory listing", v1.LintSeverityWarning, checkKeys(func(key *parser.Key) string {
+			if key.Name == "autoindex" && strings.ToLower(key.Value) == "on" {
+				return "`autoindex on` exposes the directory listing"
+			}
+			return ""
+		})),
+		NewRule(RuleProxyPassWithoutHost, "`proxy_pass` without `proxy_set_header Host`", v1.LintSeverityWarning, checkProxyPassWithoutHost),
+		NewRule(RuleUnsafeIfInLocation, "`if` inside a location with directives other than `return` and `rewrite ... last`", v1.LintSeverityWarning, checkUnsafeIfInLocation),
+		newConflictRule(RuleDuplicateServerName, "the same `server_name` on the same listen socket", v1.LintSeverityError),
+		newConflictRule(RuleDuplicateDefaultServer, "more than one `default_server` on the same listen socket", v1.LintSeverityError),
+		newConflictRule(RuleDuplicateLocation, "duplicate locations in the same scope", v1.LintSeverityError),
+		newConflictRule(RuleShadowedLocation, "locations that can never be matched", v1.LintSeverityWarning),
+	}
+}
+
+// conflictRule reports the issues of the rule found by configuration.ConflictAnalyzer. The linter analyzes the
+// configuration once for all the conflict rules, and filters the issues by the rules.
+type conflictRule struct {
+	rule
+}
+
+func (r conflictRule) Check(c configuration.Configuration) []*v1.LintIssue {
+	return r.filter(configuration.NewConflictAnalyzer(c).Analyze())
+}
+
+// filter returns the issues of the rule in the conflicts.
+func (r conflictRule) filter(conflicts []*v1.LintIssue) []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	for _, issue := range conflicts {
+		if issue.Rule == r.name {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func newConflictRule(name, description string, severity v1.LintSeverity) Rule {
+	return conflictRule{rule{
+		name:        name,
+		description: description,
+		severity:    severity,
+	}}
+}
+
+// checkKeys reports an issue for each key, that the match function returns a non-empty message.
+func checkKeys(match func(key *parser.Key) string) func(c configuration.Configuration) []*v1.LintIssue {
+	return func(c configuration.Configuration) []*v1.LintIssue {
+		issues := make([]*v1.LintIssue, 0)
+		root, ok := c.Self().(parser.Context)
+		if !ok {
+			return issues
+		}
+		walk(root, nil, func(p parser.Parser, _ []parser.Context) {
+			if key, ok := p.(*parser.Key); ok {
+				if msg := match(key); msg != "" {
+					issues = append(issues, &v1.LintIssue{Message: msg, Positions: []string{key.GetPosition()}})
+				}
+			}
+		})
+		return issues
+	}
+}
+
+func checkMissingClientMaxBodySize(c configuration.Configuration) []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	root, ok := c.Self().(parser.Context)
+	if !ok {
+		return issues
+	}
+	for _, http := range configuration.ContextChildren(root, parser_type.TypeHttp) {
+		if len(configuration.ContextKeys(http, "client_max_body_size")) > 0 {
+			continue
+		}
+		for _, server := range configuration.ContextChildren(http, parser_type.TypeServer) {
+			if len(configuration.ContextKeys(server, "client_max_body_size")) == 0 {
+				issues = append(issues, &v1.LintIssue{
+					Message:   fmt.Sprintf("server %v does not set `client_max_body_size`, the default 1m is used", configuration.ServerNames(server)),
+					Positions: []string{server.GetPosition()},
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// checkProxyPassWithoutHost follows the inheritance of `proxy_set_header`, which is only inherited from the previous
+// level if no `proxy_set_header` is declared on the current level.
+func checkProxyPassWithoutHost(c configuration.Configuration) []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	root, ok := c.Self().(parser.Context)
+	if !ok {
+		return issues
+	}
+	var check func(ctx parser.Context, inheritedHost bool)
+	check = func(ctx parser.Context, inheritedHost bool) {
+		hasHost := inheritedHost
+		if headers := configuration.ContextKeys(ctx, "proxy_set_header"); len(headers) > 0 {
+			hasHost = false
+			for _, header := range headers {
+				if fields := strings.Fields(header.Value); len(fields) > 0 && strings.ToLower(fields[0]) == "host" {
+					hasHost = true
+				}
+			}
+		}
+		if !hasHost {
+			for _, proxyPass := range configuration.ContextKeys(ctx, "proxy_pass") {
+				issues = append(issues, &v1.LintIssue{
+					Message:   fmt.Sprintf("`proxy_pass %s` without `proxy_set_header Host`, the upstream will receive the proxied host name", proxyPass.Value),
+					Positions: []string{proxyPass.GetPosition()},
+				})
+			}
+		}
+		for _, child := range configuration.SubContexts(ctx) {
+			check(child, hasHost)
+		}
+	}
+	for _, http := range configuration.ContextChildren(root, parser_type.TypeHttp) {
+		check(http, false)
+	}
+	return issues
+}
+
+func checkUnsafeIfInLocation(c configuration.Configuration) []*v1.LintIssue {
+	issues := make([]*v1.LintIssue, 0)
+	root, ok := c.Self().(parser.Context)
+	if !ok {
+		return issues
+	}
+	walk(root, nil, func(p parser.Parser, ancestors []parser.Context) {
+		ifCtx, ok := p.(parser.Context)
+		if !ok || p.GetType() != parser_type.TypeIf || len(ancestors) == 0 || ancestors[len(ancestors)-1].GetType() != parser_type.TypeLocation {
+			return
+		}
+		unsafe := make([]string, 0)
+		walk(ifCtx, nil, func(p parser.Parser, ancestors []parser.Context) {
+			if len(ancestors) > 0 || p.GetType() == parser_type.TypeComment {
+				return
+			}
+			key, ok := p.(*parser.Key)
+			switch {
+			case !ok:
+				unsafe = append(unsafe, p.GetType().String())
+			case key.Name == "return":
+			case key.Name == "rewrite" && strings.HasSuffix(strings.TrimSpace(key.Value), " last"):
+			default:
+				unsafe = append(unsafe, key.Name)
+			}
+		})
+		if len(unsafe) > 0 {
+			issues = append(issues, &v1.LintIssue{
+				Message: fmt.Sprintf("`if %s` in location \"%s\" contains the unsafe directive(s): %s, only `return` and `rewrite ... last` are safe",
+					ifCtx.GetValue(), ancestors[len(ancestors)-1].GetValue(), strings.Join(unsafe, ", ")),
+				Positions: []string{ifCtx.GetPosition()},
+			})
+		}
+	})
+	return issues
+}
+
+// walk visits the children of the context recursively with their ancestor contexts, `include` and the included
+// configs are transparent.
+func walk(ctx parser.Context, ancestors []parser.Context, visit func(p parser.Parser, ancestors []parser.Context)) {
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			return
+		}
+		subCtx, isCtx := child.(parser.Context)
+		switch {
+		case isCtx && (child.GetType() == parser_type.TypeInclude || child.GetType() == parser_type.TypeConfig):
+			walk(subCtx, ancestors, visit)
+		case isCtx:
+			visit(child, ancestors)
+			subAncestors := make([]parser.Context, len(ancestors), len(ancestors)+1)
+			copy(subAncestors, ancestors)
+			walk(subCtx, append(subAncestors, subCtx), visit)
+		default:
+			visit(child, ancestors)
+		}
+	}
+}
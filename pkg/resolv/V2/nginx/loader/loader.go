@@ -27,6 +27,7 @@ type loader struct {
 	workDir string
 	cacher  LoadCacher
 	locker  *sync.RWMutex
+	snippet bool
 	loop_preventer.LoopPreventer
 }
 
@@ -62,6 +63,12 @@ func (l *loader) loadFromConfigPosition(configAbsPath string) (parser.Context, e
 		return nil, err
 	}
 
+	return l.loadFromConfigData(configAbsPath, configData, parser_indention.NewIndention())
+}
+
+// loadFromConfigData parses the config data of the config file, the parsers of the config are indented from the
+// indention.
+func (l *loader) loadFromConfigData(configAbsPath string, configData []byte, indention parser_indention.Indention) (parser.Context, error) {
 	var parseErr error
 	index := 0
 	parsers := make([]parser.Parser, 0)
@@ -69,7 +76,7 @@ func (l *loader) loadFromConfigPosition(configAbsPath string) (parser.Context, e
 	//positions := make([]parser_position.ParserPosition, 0)
 	//positions = append(positions, configPos)
 	indentions := make([]parser_indention.Indention, 0)
-	indentions = append(indentions, parser_indention.NewIndention())
+	indentions = append(indentions, indention)
 
 	config := parser.NewContext(configAbsPath, parser_type.TypeConfig, indentions[0])
 
@@ -159,6 +166,9 @@ func (l *loader) loadFromConfigPosition(configAbsPath string) (parser.Context, e
 				}
 			}
 			configDeep--
+			if l.snippet && configDeep < 0 {
+				parseErr = errors.WithCode(code.ErrParseFailed, "parse failed in %s, unexpected '}'", configAbsPath)
+			}
 			return true
 		}
 		return false
@@ -275,7 +285,11 @@ func (l *loader) loadFromConfigPosition(configAbsPath string) (parser.Context, e
 		}
 		break
 	}
-	err = l.cacher.SetConfig(config.(*parser.Config))
+	// the unclosed contexts are dropped, which is tolerated for the config files as before, but not for the snippets
+	if l.snippet && parseErr == nil && configDeep != 0 {
+		return nil, errors.WithCode(code.ErrParseFailed, "parse failed at the end of %s, the contexts are not closed", configAbsPath)
+	}
+	err := l.cacher.SetConfig(config.(*parser.Config))
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +318,9 @@ func (l loader) GetConfigPaths() []string {
 }
 
 func (l *loader) loadIncludeConfigs(include *parser.Include) error {
+	if l.snippet {
+		return errors.WithCode(code.ErrParseFailed, "including config files '%s' is not allowed", include.GetValue())
+	}
 	configAbsPaths, err := filepath.Glob(filepath.Join(l.workDir, include.GetValue()))
 	if err != nil {
 		return err
@@ -333,6 +350,36 @@ func (l *loader) loadIncludeConfigs(include *parser.Include) error {
 	return nil
 }
 
+// LoadSnippet parses the config data, which is a snippet of the config file of the path, e.g. a server block rendered
+// from a template. The parsers returned are indented from the indention, so that they can be inserted into the context
+// of the same depth, and the config files included are not allowed.
+func LoadSnippet(path string, data []byte, indention parser_indention.Indention) ([]parser.Parser, error) {
+	configAbsPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	l := &loader{
+		workDir:       filepath.Dir(configAbsPath),
+		cacher:        NewLoadCacher(configAbsPath),
+		locker:        new(sync.RWMutex),
+		LoopPreventer: loop_preventer.NewLoopPreverter(configAbsPath),
+		snippet:       true,
+	}
+	ctx, err := l.loadFromConfigData(configAbsPath, data, indention)
+	if err != nil {
+		return nil, err
+	}
+	parsers := make([]parser.Parser, 0, ctx.Len())
+	for i := 0; i < ctx.Len(); i++ {
+		child, err := ctx.GetChild(i)
+		if err != nil {
+			return nil, err
+		}
+		parsers = append(parsers, child)
+	}
+	return parsers, nil
+}
+
 func NewLoader() Loader {
 	return &loader{
 		locker: new(sync.RWMutex),
@@ -2,11 +2,15 @@ package nginx
 
 import (
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
 	log "github.com/ClessLi/bifrost/pkg/log/v1"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
 	"github.com/marmotedu/errors"
+	"os"
+	"sort"
 	"sync"
+	"time"
 )
 
 // ConfigManagerOptions defines options for nginx configuration and manager.
@@ -17,6 +21,13 @@ type ConfigManagerOptions struct {
 	BackupDir      string
 	BackupCycle    int
 	BackupSaveTime int
+	ReloadInterval time.Duration
+	ReloadDebounce time.Duration
+	SaveInterval   time.Duration
+	ConflictPolicy v1.ConfigConflictPolicy
+	FileMode       os.FileMode
+	Prefix         string
+	ExtraArgs      []string
 }
 
 type ConfigsManagerOptions struct {
@@ -29,13 +40,22 @@ func newConfigManager(options ConfigManagerOptions) (configuration.ConfigManager
 		return nil, err
 	}
 	log.Debugf("init nginx config(Size: %d): \n\n%s", len(conf.View()), conf.View())
-	return configuration.NewNginxConfigurationManager(
+	return configuration.NewNginxConfigurationManagerWithOptions(
 		loader.NewLoader(),
 		conf,
 		options.ServerBinPath,
 		options.BackupDir,
 		options.BackupCycle,
 		options.BackupSaveTime,
+		configuration.ManagerOptions{
+			ReloadInterval: options.ReloadInterval,
+			ReloadDebounce: options.ReloadDebounce,
+			SaveInterval:   options.SaveInterval,
+			ConflictPolicy: options.ConflictPolicy,
+			FileMode:       options.FileMode,
+			Prefix:         options.Prefix,
+			ExtraArgs:      options.ExtraArgs,
+		},
 		new(sync.RWMutex),
 	), nil
 }
@@ -43,15 +63,34 @@ func newConfigManager(options ConfigManagerOptions) (configuration.ConfigManager
 type ConfigsManager interface {
 	Start() error
 	Stop() error
+	// Register adds a config manager for the web server, which is started if the configs manager is running.
+	Register(options ConfigManagerOptions) error
+	// Reconfigure replaces the config manager of the web server with a new one built from the options. The changes of
+	// the configuration not saved yet are saved first, and the former config manager is kept if they fail to be saved.
+	Reconfigure(options ConfigManagerOptions) error
+	// Unregister stops and removes the config manager of the web server.
+	Unregister(servername string) error
 	GetConfigs() map[string]configuration.Configuration
 	GetServerInfos() []*v1.WebServerInfo
+	// SaveWithCheck saves the changed configuration of the web server at once, instead of waiting for the regular save.
+	SaveWithCheck(servername string) error
+	// ServerReload signals the web server to reload its config files.
+	ServerReload(servername string) error
+	// GetConflicts returns the recent conflicts between the config files and the configurations of the web servers.
+	GetConflicts() []*v1.ConfigConflict
+	// GetStates returns the states of the config managers of the web servers.
+	GetStates() []*v1.ConfigManagerState
 }
 
 type configsManager struct {
-	cms map[string]configuration.ConfigManager
+	cms       map[string]configuration.ConfigManager
+	isRunning bool
+	rwLocker  *sync.RWMutex
 }
 
 func (c *configsManager) Start() error {
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
 	isStarted := make([]string, 0)
 	var err error
 	defer func() {
@@ -71,10 +110,13 @@ func (c *configsManager) Start() error {
 		}
 		isStarted = append(isStarted, servername)
 	}
+	c.isRunning = true
 	return nil
 }
 
 func (c *configsManager) Stop() error {
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
 	errs := make([]error, 0)
 	for servername, manager := range c.cms {
 		err := manager.Stop()
@@ -82,13 +124,104 @@ func (c *configsManager) Stop() error {
 			errs = append(errs, errors.Wrapf(err, "failed to stop nginx config manager %s", servername))
 		}
 	}
+	c.isRunning = false
 	if len(errs) > 0 {
 		return errors.NewAggregate(errs)
 	}
 	return nil
 }
 
+func (c *configsManager) Register(options ConfigManagerOptions) error {
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
+	if _, has := c.cms[options.ServerName]; has {
+		return errors.WithCode(code.ErrWebServerAlreadyExists, "nginx server '%s' is already registered", options.ServerName)
+	}
+	cm, err := newConfigManager(options)
+	if err != nil {
+		return err
+	}
+	if c.isRunning {
+		err = cm.Start()
+		if err != nil {
+			return err
+		}
+	}
+	c.cms[options.ServerName] = cm
+	log.Infof("nginx server '%s' is registered", options.ServerName)
+	return nil
+}
+
+func (c *configsManager) Reconfigure(options ConfigManagerOptions) error {
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
+	old, has := c.cms[options.ServerName]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "nginx server '%s' is not registered", options.ServerName)
+	}
+	if c.isRunning {
+		err := old.Stop()
+		if err != nil {
+			return errors.Wrapf(err, "failed to stop nginx config manager %s", options.ServerName)
+		}
+	}
+	restore := func() {
+		if !c.isRunning {
+			return
+		}
+		if startErr := old.Start(); startErr != nil {
+			log.Warnf("failed to restart the former nginx config manager %s, err: %v", options.ServerName, startErr)
+		}
+	}
+	// the changes of the configuration not saved yet are flushed, before the new config manager loads the config files
+	err := old.SaveWithCheck()
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) && !errors.IsCode(err, code.ErrSameConfigFingerprints) {
+		restore()
+		return errors.Wrapf(err, "failed to save the configuration of nginx server '%s' before reconfiguring", options.ServerName)
+	}
+	cm, err := newConfigManager(options)
+	if err != nil {
+		restore()
+		return err
+	}
+	if c.isRunning {
+		err = cm.Start()
+		if err != nil {
+			restore()
+			return err
+		}
+	}
+	c.cms[options.ServerName] = cm
+	log.Infof("nginx server '%s' is reconfigured", options.ServerName)
+	return nil
+}
+
+func (c *configsManager) Unregister(servername string) error {
+	c.rwLocker.Lock()
+	defer c.rwLocker.Unlock()
+	cm, has := c.cms[servername]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "nginx server '%s' is not registered", servername)
+	}
+	// the changes of the configuration not saved yet are flushed, and the web server is kept if they fail to be saved
+	err := cm.SaveWithCheck()
+	if err != nil && !errors.IsCode(err, code.ErrSameConfigFingerprint) && !errors.IsCode(err, code.ErrSameConfigFingerprints) {
+		return errors.Wrapf(err, "failed to save the configuration of nginx server '%s' before unregistering", servername)
+	}
+	if c.isRunning {
+		err = cm.Stop()
+		if err != nil {
+			return errors.Wrapf(err, "failed to stop nginx config manager %s", servername)
+		}
+	}
+	delete(c.cms, servername)
+	log.Infof("nginx server '%s' is unregistered", servername)
+	return nil
+}
+
 func (c *configsManager) GetConfigs() map[string]configuration.Configuration {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
 	var configs = make(map[string]configuration.Configuration)
 	for name, manager := range c.cms {
 		configs[name] = manager.GetConfiguration()
@@ -96,7 +229,29 @@ func (c *configsManager) GetConfigs() map[string]configuration.Configuration {
 	return configs
 }
 
+func (c *configsManager) SaveWithCheck(servername string) error {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	cm, has := c.cms[servername]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "nginx server '%s' is not registered", servername)
+	}
+	return cm.SaveWithCheck()
+}
+
+func (c *configsManager) ServerReload(servername string) error {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	cm, has := c.cms[servername]
+	if !has {
+		return errors.WithCode(code.ErrConfigurationNotFound, "nginx server '%s' is not registered", servername)
+	}
+	return cm.ServerReload()
+}
+
 func (c *configsManager) GetServerInfos() []*v1.WebServerInfo {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
 	var infos []*v1.WebServerInfo
 	for name, manager := range c.cms {
 		info := manager.GetServerInfo()
@@ -108,6 +263,37 @@ func (c *configsManager) GetServerInfos() []*v1.WebServerInfo {
 	return infos
 }
 
+func (c *configsManager) GetConflicts() []*v1.ConfigConflict {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	var conflicts []*v1.ConfigConflict
+	for name, manager := range c.cms {
+		for _, conflict := range manager.GetConflicts() {
+			conflict.ServerName = name
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Time.Before(conflicts[j].Time)
+	})
+	return conflicts
+}
+
+func (c *configsManager) GetStates() []*v1.ConfigManagerState {
+	c.rwLocker.RLock()
+	defer c.rwLocker.RUnlock()
+	states := make([]*v1.ConfigManagerState, 0, len(c.cms))
+	for name, manager := range c.cms {
+		state := manager.GetState()
+		state.ServerName = name
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ServerName < states[j].ServerName
+	})
+	return states
+}
+
 func New(options ConfigsManagerOptions) (ConfigsManager, error) {
 	cms := make(map[string]configuration.ConfigManager)
 	for _, opts := range options.Options {
@@ -117,5 +303,8 @@ func New(options ConfigsManagerOptions) (ConfigsManager, error) {
 		}
 		cms[opts.ServerName] = cm
 	}
-	return &configsManager{cms: cms}, nil
+	return &configsManager{
+		cms:      cms,
+		rwLocker: new(sync.RWMutex),
+	}, nil
 }
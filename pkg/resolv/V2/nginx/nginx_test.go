@@ -0,0 +1,183 @@
+package nginx
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigsManager_Register(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-configs-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	newOptions := func(servername string) ConfigManagerOptions {
+		confPath := filepath.Join(dir, servername+".conf")
+		if err := ioutil.WriteFile(confPath, []byte("http {\n    server {\n        listen 80;\n    }\n}\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return ConfigManagerOptions{
+			ServerName:     servername,
+			MainConfigPath: confPath,
+			ServerBinPath:  filepath.Join(dir, "nginx"),
+		}
+	}
+
+	cms, err := New(ConfigsManagerOptions{Options: []ConfigManagerOptions{newOptions("first")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = cms.Register(newOptions("second")); err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Register(newOptions("second")); !errors.IsCode(err, code.ErrWebServerAlreadyExists) {
+		t.Errorf("got error %v registering the same server twice, want ErrWebServerAlreadyExists", err)
+	}
+	if len(cms.GetConfigs()) != 2 {
+		t.Errorf("got %d configs, want 2", len(cms.GetConfigs()))
+	}
+
+	if err = cms.Reconfigure(newOptions("second")); err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Reconfigure(newOptions("third")); !errors.IsCode(err, code.ErrConfigurationNotFound) {
+		t.Errorf("got error %v reconfiguring an unregistered server, want ErrConfigurationNotFound", err)
+	}
+
+	begin := time.Now()
+	if err = cms.Unregister("second"); err != nil {
+		t.Fatal(err)
+	}
+	if _, has := cms.GetConfigs()["second"]; has {
+		t.Errorf("the unregistered server is still managed")
+	}
+	if err = cms.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(begin); elapsed > time.Second {
+		t.Errorf("stopping the config managers took %s, they should stop at once", elapsed)
+	}
+}
+
+func TestConfigsManager_Reconfigure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-configs-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	confPath := filepath.Join(dir, "nginx.conf")
+	if err = ioutil.WriteFile(confPath, []byte("http {\n    server {\n        listen 80;\n    }\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	options := ConfigManagerOptions{
+		ServerName:     "test",
+		MainConfigPath: confPath,
+		ServerBinPath:  "/bin/true",
+		SaveInterval:   time.Hour,
+	}
+
+	cms, err := New(ConfigsManagerOptions{Options: []ConfigManagerOptions{options}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cms.Stop()
+
+	// the change not saved yet by the regular saving survives the reconfiguration
+	if err = cms.GetConfigs()["test"].AppendSnippetByKeyword([]byte("server_tokens off;"), "http"); err != nil {
+		t.Fatal(err)
+	}
+	options.BackupCycle = 2
+	if err = cms.Reconfigure(options); err != nil {
+		t.Fatal(err)
+	}
+	if view := string(cms.GetConfigs()["test"].View()); !strings.Contains(view, "server_tokens off;") {
+		t.Errorf("the change is lost after reconfiguring, config:\n%s", view)
+	}
+
+	// the former config manager is kept if the change fails to be saved
+	options.ServerBinPath = "/bin/false"
+	if err = cms.Reconfigure(options); err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.GetConfigs()["test"].AppendSnippetByKeyword([]byte("autoindex off;"), "http"); err != nil {
+		t.Fatal(err)
+	}
+	former := cms.GetConfigs()["test"]
+	options.ServerBinPath = "/bin/true"
+	if err = cms.Reconfigure(options); err == nil {
+		t.Fatal("reconfiguring with the change failing the check want an error")
+	}
+	if cms.GetConfigs()["test"] != former {
+		t.Errorf("the former config manager is replaced, though the change failed to be saved")
+	}
+}
+
+func TestConfigsManager_Unregister(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-configs-manager-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	newOptions := func(servername, serverBinPath string) ConfigManagerOptions {
+		confPath := filepath.Join(dir, servername+".conf")
+		if err := ioutil.WriteFile(confPath, []byte("http {\n    server {\n        listen 80;\n    }\n}\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return ConfigManagerOptions{
+			ServerName:     servername,
+			MainConfigPath: confPath,
+			ServerBinPath:  serverBinPath,
+			SaveInterval:   time.Hour,
+		}
+	}
+	saved, rejected := newOptions("saved", "/bin/true"), newOptions("rejected", "/bin/false")
+
+	cms, err := New(ConfigsManagerOptions{Options: []ConfigManagerOptions{saved, rejected}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cms.Stop()
+
+	// the change not saved yet by the regular saving is saved by unregistering
+	if err = cms.GetConfigs()["saved"].AppendSnippetByKeyword([]byte("server_tokens off;"), "http"); err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Unregister("saved"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(saved.MainConfigPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "server_tokens off;") {
+		t.Errorf("the change is lost after unregistering, config file:\n%s", data)
+	}
+
+	// the web server is kept if the change fails to be saved
+	if err = cms.GetConfigs()["rejected"].AppendSnippetByKeyword([]byte("server_tokens off;"), "http"); err != nil {
+		t.Fatal(err)
+	}
+	if err = cms.Unregister("rejected"); err == nil {
+		t.Fatal("unregistering with the change failing the check want an error")
+	}
+	if _, has := cms.GetConfigs()["rejected"]; !has {
+		t.Errorf("the web server is unregistered, though the change failed to be saved")
+	}
+}
@@ -0,0 +1,215 @@
+package template
+
+import (
+	"bytes"
+	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration/parser"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/loader"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_indention"
+	"github.com/marmotedu/errors"
+	"regexp"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+)
+
+const ( // parameter types
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeBool   = "bool"
+	// TypeList is a comma separated list, which is rendered as a slice of strings.
+	TypeList = "list"
+)
+
+// DefaultTarget is the keyword of the context which the snippet is appended to, if the template has no target.
+const DefaultTarget = "http"
+
+// unsafeChars can not be in the parameter values, so that the values can not inject directives or contexts.
+const unsafeChars = ";{}#\r\n"
+
+var templateFuncs = texttemplate.FuncMap{
+	"join": strings.Join,
+}
+
+// Template is a named config template, the content is a snippet of nginx config in the syntax of text/template, which
+// is rendered with the typed parameters.
+type Template struct {
+	Name        string       `yaml:"name"`
+	Description string       `yaml:"description"`
+	Target      string       `yaml:"target"`
+	Parameters  []*Parameter `yaml:"parameters"`
+	Content     string       `yaml:"content"`
+
+	tmpl *texttemplate.Template
+}
+
+// Parameter is a typed parameter of the template, the string values and the list items must match the pattern if it is
+// set.
+type Parameter struct {
+	Name        string `yaml:"name"`
+	Type        string `yaml:"type"`
+	Required    bool   `yaml:"required"`
+	Default     string `yaml:"default"`
+	Pattern     string `yaml:"pattern"`
+	Description string `yaml:"description"`
+
+	pattern *regexp.Regexp
+}
+
+// compile validates the template and its parameters, and parses the content.
+func (t *Template) compile() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errors.WithCode(code.ErrInvalidTemplate, "template name cannot be empty")
+	}
+	if t.Target == "" {
+		t.Target = DefaultTarget
+	}
+	names := make(map[string]bool, len(t.Parameters))
+	for _, param := range t.Parameters {
+		if param.Name == "" || names[param.Name] {
+			return errors.WithCode(code.ErrInvalidTemplate, "template '%s' has an empty or duplicate parameter name '%s'", t.Name, param.Name)
+		}
+		names[param.Name] = true
+		if param.Type == "" {
+			param.Type = TypeString
+		}
+		switch param.Type {
+		case TypeString, TypeInt, TypeBool, TypeList:
+		default:
+			return errors.WithCode(code.ErrInvalidTemplate, "parameter '%s' of template '%s' has an unknown type '%s'", param.Name, t.Name, param.Type)
+		}
+		if param.Pattern != "" {
+			pattern, err := regexp.Compile("^(?:" + param.Pattern + ")$")
+			if err != nil {
+				return errors.WithCode(code.ErrInvalidTemplate, "parameter '%s' of template '%s' has an invalid pattern, %v", param.Name, t.Name, err)
+			}
+			param.pattern = pattern
+		}
+		if param.Default != "" {
+			if _, err := param.value(param.Default); err != nil {
+				return errors.WithCode(code.ErrInvalidTemplate, "parameter '%s' of template '%s' has an invalid default value, %v", param.Name, t.Name, err)
+			}
+		}
+	}
+	tmpl, err := texttemplate.New(t.Name).Funcs(templateFuncs).Option("missingkey=error").Parse(t.Content)
+	if err != nil {
+		return errors.WithCode(code.ErrInvalidTemplate, "failed to parse template '%s', %v", t.Name, err)
+	}
+	t.tmpl = tmpl
+	return nil
+}
+
+// value converts the string value to the typed value of the parameter.
+func (p *Parameter) value(s string) (interface{}, error) {
+	switch p.Type {
+	case TypeInt:
+		return strconv.Atoi(strings.TrimSpace(s))
+	case TypeBool:
+		return strconv.ParseBool(strings.TrimSpace(s))
+	case TypeList:
+		items := make([]string, 0)
+		for _, item := range strings.Split(s, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			if strings.ContainsAny(item, unsafeChars+" \t") {
+				return nil, errors.Errorf("list item '%s' cannot contain whitespaces or any of '%s'", item, strings.TrimSpace(unsafeChars))
+			}
+			if err := p.match(item); err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		if strings.ContainsAny(s, unsafeChars) {
+			return nil, errors.Errorf("value '%s' cannot contain any of '%s' or line breaks", s, ";{}#")
+		}
+		return s, p.match(s)
+	}
+}
+
+func (p *Parameter) match(s string) error {
+	if p.pattern != nil && !p.pattern.MatchString(s) {
+		return errors.Errorf("value '%s' does not match the pattern '%s'", s, p.Pattern)
+	}
+	return nil
+}
+
+// Render renders the template into a config snippet with the parameters, the defaults are used for the parameters
+// absent, and an error is returned if a required parameter is absent or a parameter is unknown.
+func (t *Template) Render(params map[string]string) ([]byte, error) {
+	data := make(map[string]interface{}, len(t.Parameters))
+	known := make(map[string]bool, len(t.Parameters))
+	for _, param := range t.Parameters {
+		known[param.Name] = true
+		s, has := params[param.Name]
+		if !has || s == "" {
+			if param.Required {
+				return nil, errors.WithCode(code.ErrInvalidTemplateParameter, "parameter '%s' of template '%s' is required", param.Name, t.Name)
+			}
+			s = param.Default
+		}
+		value, err := param.value(s)
+		if err != nil && s == "" && param.Type != TypeString && param.Type != TypeList {
+			// the optional int and bool parameters without default are rendered as zero values
+			value, err = param.zero(), nil
+		}
+		if err != nil {
+			return nil, errors.WithCode(code.ErrInvalidTemplateParameter, "invalid parameter '%s' of template '%s', %v", param.Name, t.Name, err)
+		}
+		data[param.Name] = value
+	}
+	for name := range params {
+		if !known[name] {
+			return nil, errors.WithCode(code.ErrInvalidTemplateParameter, "template '%s' has no parameter '%s'", t.Name, name)
+		}
+	}
+
+	buff := bytes.NewBuffer(nil)
+	if err := t.tmpl.Execute(buff, data); err != nil {
+		return nil, errors.WithCode(code.ErrInvalidTemplate, "failed to render template '%s', %v", t.Name, err)
+	}
+	return buff.Bytes(), nil
+}
+
+func (p *Parameter) zero() interface{} {
+	if p.Type == TypeBool {
+		return false
+	}
+	return 0
+}
+
+// RenderParsers renders the template with the parameters, and parses the snippet into the parsers, which are indented
+// as the top level of a config file.
+func (t *Template) RenderParsers(params map[string]string) ([]parser.Parser, error) {
+	snippet, err := t.Render(params)
+	if err != nil {
+		return nil, err
+	}
+	return loader.LoadSnippet(t.Name+".conf", snippet, parser_indention.NewIndention())
+}
+
+// Info returns the template as the api object.
+func (t *Template) Info() *v1.WebServerTemplate {
+	info := &v1.WebServerTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		Target:      t.Target,
+		Parameters:  make([]*v1.WebServerTemplateParameter, 0, len(t.Parameters)),
+		Content:     t.Content,
+	}
+	for _, param := range t.Parameters {
+		info.Parameters = append(info.Parameters, &v1.WebServerTemplateParameter{
+			Name:        param.Name,
+			Type:        param.Type,
+			Required:    param.Required,
+			Default:     param.Default,
+			Pattern:     param.Pattern,
+			Description: param.Description,
+		})
+	}
+	return info
+}
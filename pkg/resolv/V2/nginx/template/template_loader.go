@@ -0,0 +1,75 @@
+package template
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/marmotedu/errors"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Load loads the template from the yaml or json file.
+func Load(path string) (*Template, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t := new(Template)
+	if err = yaml.UnmarshalStrict(data, t); err != nil {
+		return nil, errors.WithCode(code.ErrInvalidTemplate, "failed to load template file '%s', %v", path, err)
+	}
+	if err = t.compile(); err != nil {
+		return nil, errors.Wrapf(err, "failed to load template file '%s'", path)
+	}
+	return t, nil
+}
+
+// LoadDir loads the templates from the `*.yml`, `*.yaml` and `*.json` files in the directory, sorted by their names.
+// No template is loaded if the directory does not exist.
+func LoadDir(dir string) ([]*Template, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil
+	}
+	var paths []string
+	for _, pattern := range []string{"*.yml", "*.yaml", "*.json"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, matches...)
+	}
+
+	templates := make([]*Template, 0, len(paths))
+	names := make(map[string]string, len(paths))
+	for _, path := range paths {
+		t, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		if former, has := names[t.Name]; has {
+			return nil, errors.WithCode(code.ErrInvalidTemplate, "template '%s' is defined in both '%s' and '%s'", t.Name, former, path)
+		}
+		names[t.Name] = path
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+// Get loads the templates from the directory, and returns the template of the name.
+func Get(dir, name string) (*Template, error) {
+	templates, err := LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range templates {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, errors.WithCode(code.ErrTemplateNotFound, "template '%s' not found in '%s'", name, dir)
+}
@@ -0,0 +1,133 @@
+package template
+
+import (
+	"github.com/ClessLi/bifrost/internal/pkg/code"
+	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/parser_type"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const builtinTemplatesDir = "../../../../../configs/templates"
+
+func TestLoadDir(t *testing.T) {
+	templates, err := LoadDir(builtinTemplatesDir)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	names := make([]string, 0, len(templates))
+	for _, tmpl := range templates {
+		names = append(names, tmpl.Name)
+	}
+	if got, want := strings.Join(names, ","), "grpc-proxy,reverse-proxy,static-site,tls-redirect"; got != want {
+		t.Errorf("LoadDir() templates = %s, want %s", got, want)
+	}
+
+	params := map[string]map[string]string{
+		"grpc-proxy":    {"server_name": "grpc.example.com", "upstream": "127.0.0.1:9090", "ssl_certificate": "/etc/ssl/a.crt", "ssl_certificate_key": "/etc/ssl/a.key"},
+		"reverse-proxy": {"server_name": "a.example.com, b.example.com", "upstream": "127.0.0.1:8080", "websocket": "true"},
+		"static-site":   {"server_name": "static.example.com", "root": "/var/www/static"},
+		"tls-redirect":  {"server_name": "a.example.com", "https_port": "8443"},
+	}
+	for _, tmpl := range templates {
+		parsers, err := tmpl.RenderParsers(params[tmpl.Name])
+		if err != nil {
+			t.Errorf("template %s RenderParsers() error = %+v", tmpl.Name, err)
+			continue
+		}
+		if len(parsers) != 1 || parsers[0].GetType() != parser_type.TypeServer {
+			t.Errorf("template %s RenderParsers() want a server block, got %d parser(s)", tmpl.Name, len(parsers))
+		}
+	}
+
+	if _, err = Get(builtinTemplatesDir, "absent"); !errors.IsCode(err, code.ErrTemplateNotFound) {
+		t.Errorf("Get() absent template error = %v, want ErrTemplateNotFound", err)
+	}
+	if templates, err = LoadDir(filepath.Join(builtinTemplatesDir, "absent")); err != nil || len(templates) != 0 {
+		t.Errorf("LoadDir() absent dir = %v, %v, want no template", templates, err)
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	tmpl, err := Get(builtinTemplatesDir, "reverse-proxy")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	snippet, err := tmpl.Render(map[string]string{"server_name": "a.example.com,b.example.com", "upstream": "backend:8080"})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, want := range []string{"listen 80;", "server_name a.example.com b.example.com;", "location / {", "proxy_pass http://backend:8080;"} {
+		if !strings.Contains(string(snippet), want) {
+			t.Errorf("Render() snippet has no '%s':\n%s", want, snippet)
+		}
+	}
+	if strings.Contains(string(snippet), "Upgrade") {
+		t.Errorf("Render() snippet want no websocket directives:\n%s", snippet)
+	}
+
+	invalids := []map[string]string{
+		{"server_name": "a.example.com"},                                                             // required upstream absent
+		{"server_name": "a.example.com", "upstream": "backend:8080", "port": "80"},                   // unknown parameter
+		{"server_name": "a.example.com", "upstream": "backend:8080; include /etc/passwd"},            // injection
+		{"server_name": "a.example.com", "upstream": "backend:8080", "location": "/ {\n}\nserver {"}, // injection
+		{"server_name": "a.example.com;", "upstream": "backend:8080"},                                // injection in list
+		{"server_name": "a.example.com", "upstream": "http://backend"},                               // pattern mismatched
+		{"server_name": "a.example.com", "upstream": "backend:8080", "listen": "eighty"},             // invalid int
+		{"server_name": "a.example.com", "upstream": "backend:8080", "websocket": "maybe"},           // invalid bool
+	}
+	for _, params := range invalids {
+		if _, err = tmpl.Render(params); !errors.IsCode(err, code.ErrInvalidTemplateParameter) {
+			t.Errorf("Render(%v) error = %v, want ErrInvalidTemplateParameter", params, err)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bifrost-template-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"valid", "name: valid\nparameters:\n  - name: port\n    type: int\ncontent: \"listen {{ .port }};\"\n", false},
+		{"no name", "content: \"listen 80;\"\n", true},
+		{"unknown type", "name: a\nparameters:\n  - name: port\n    type: float\ncontent: \"\"\n", true},
+		{"duplicate parameter", "name: a\nparameters:\n  - name: port\n  - name: port\ncontent: \"\"\n", true},
+		{"invalid pattern", "name: a\nparameters:\n  - name: port\n    pattern: \"(\"\ncontent: \"\"\n", true},
+		{"invalid default", "name: a\nparameters:\n  - name: port\n    type: int\n    default: x\ncontent: \"\"\n", true},
+		{"invalid content", "name: a\ncontent: \"{{ .port \"\n", true},
+		{"unknown field", "name: a\ncontents: \"\"\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.Replace(tt.name, " ", "-", -1)+".yml")
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			tmpl, err := Load(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tmpl.Target != DefaultTarget {
+				t.Errorf("Load() target = %s, want %s", tmpl.Target, DefaultTarget)
+			}
+			// the optional int parameter without default is rendered as zero
+			snippet, err := tmpl.Render(nil)
+			if err != nil || string(snippet) != "listen 0;" {
+				t.Errorf("Render() = %s, %v", snippet, err)
+			}
+		})
+	}
+}
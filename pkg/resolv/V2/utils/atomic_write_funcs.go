@@ -0,0 +1,83 @@
+package utils
+
+import (
+	log "github.com/ClessLi/bifrost/pkg/log/v1"
+	"github.com/marmotedu/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// DefaultFileMode 新建文件且未指定权限时使用的默认权限
+const DefaultFileMode os.FileMode = 0644
+
+// WriteFileAtomic, 原子写文件函数，先写入同目录下的临时文件并落盘，再重命名覆盖目标文件，
+// 避免写入中途异常导致目标文件缺失或内容不完整
+//
+// 参数:
+//     path: 文件路径
+//     data: 文件数据
+//     perm: 文件权限，为0时保留原文件权限，原文件不存在时使用 DefaultFileMode
+// 返回值:
+//     错误
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) (err error) {
+	dir, name := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+	origin, statErr := os.Stat(path)
+	if statErr != nil && !os.IsNotExist(statErr) {
+		return statErr
+	}
+	if perm == 0 {
+		perm = DefaultFileMode
+		if origin != nil {
+			perm = origin.Mode().Perm()
+		}
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(perm); err != nil {
+		return err
+	}
+	if origin != nil {
+		// 保留原文件属主及属组，无权限修改时仅告警
+		if chownErr := chownAs(tmp, origin); chownErr != nil {
+			log.Warnf("failed to keep the owner of '%s', %v", path, chownErr)
+		}
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrapf(err, "failed to replace '%s'", path)
+	}
+	syncDir(dir)
+	return nil
+}
+
+// syncDir, 目录落盘函数，保证重命名操作持久化，失败时忽略
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	_ = d.Sync()
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+// +build !windows
+
+package utils
+
+import (
+	"os"
+	"syscall"
+)
+
+// chownAs, 将文件属主及属组修改为与参照文件一致
+func chownAs(f *os.File, origin os.FileInfo) error {
+	stat, ok := origin.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	if stat.Uid == uint32(os.Geteuid()) && stat.Gid == uint32(os.Getegid()) {
+		return nil
+	}
+	return f.Chown(int(stat.Uid), int(stat.Gid))
+}
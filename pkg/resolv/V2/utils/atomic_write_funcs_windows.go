@@ -0,0 +1,8 @@
+package utils
+
+import "os"
+
+// chownAs, windows 不支持修改文件属主，直接返回
+func chownAs(f *os.File, origin os.FileInfo) error {
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 type ConfigFingerprinter interface {
 	Diff(fingerprinter ConfigFingerprinter) bool
 	Renew(fingerprinter ConfigFingerprinter)
+	// Fingerprints returns a copy of the fingerprints, which are indexed by the file names.
+	Fingerprints() map[string]string
 }
 
 type configFingerprinter struct {
@@ -41,6 +43,14 @@ func (f *configFingerprinter) Renew(fingerprinter ConfigFingerprinter) {
 	}
 }
 
+func (f *configFingerprinter) Fingerprints() map[string]string {
+	fingerprints := make(map[string]string, len(f.fingerprints))
+	for name, fingerprint := range f.fingerprints {
+		fingerprints[name] = fingerprint
+	}
+	return fingerprints
+}
+
 func (f *configFingerprinter) setFingerprint(filename string, data []byte) {
 	hash := sha256.New()
 	hash.Write(data)
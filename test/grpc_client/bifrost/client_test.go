@@ -6,6 +6,7 @@ import (
 	v1 "github.com/ClessLi/bifrost/api/bifrost/v1"
 	healthzclient_v1 "github.com/ClessLi/bifrost/pkg/client/grpc_health_v1"
 	"github.com/ClessLi/bifrost/pkg/resolv/V2/nginx/configuration"
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -57,6 +58,52 @@ func TestBifrostClient(t *testing.T) {
 		t.Fatalf(err.Error())
 	}
 
+	managedServers, err := client.WebServerManager().List()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	for _, server := range managedServers {
+		t.Logf("managed web server %s: %s", server.ServerName, server.ConfigPath)
+	}
+
+	states, err := client.WebServerManager().GetStates()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	for _, state := range states {
+		t.Logf("config manager of %s: running %v, in sync %v, last save %s, last error: %s", state.ServerName, state.Running, state.InSync, state.LastSaveTime, state.LastError)
+	}
+
+	templates, err := client.WebServerTemplate().List()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	for _, tmpl := range templates {
+		t.Logf("template %s: %s", tmpl.Name, tmpl.Description)
+	}
+	if len(servernames) > 0 {
+		result, err := client.WebServerTemplate().Apply(&v1.WebServerTemplateRequest{
+			ServerName:   servernames[0],
+			TemplateName: "static-site",
+			Params:       map[string]string{"server_name": "static.example.com", "root": "/var/www/static"},
+			DryRun:       true,
+		})
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		t.Logf("template %s rendered for %s:\n%s", result.TemplateName, result.ServerName, result.Snippet)
+	}
+
+	plans, err := client.WebServerReconciler().Plan()
+	if err != nil {
+		t.Log(err.Error())
+	}
+	for _, plan := range plans {
+		for _, change := range plan.Changes {
+			t.Logf("reconcile %s: %s %s %s, drifted %v", plan.ServerName, change.Action, change.Kind, change.Name, change.Drifted)
+		}
+	}
+
 	time.Sleep(time.Second * 10)
 	metrics, err := client.WebServerStatus().Get()
 	if err != nil {
@@ -119,6 +166,157 @@ func TestBifrostClient(t *testing.T) {
 		}
 		t.Logf("statistics %s:\n\n%v", servername, statistics)
 
+		upstreams, err := client.WebServerUpstream().List(servername)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		for _, upstream := range upstreams {
+			for _, member := range upstream.Members {
+				t.Logf("upstream %s of %s: %s weight %d, %s", upstream.Name, servername, member.Address, member.Weight, member.State)
+			}
+		}
+
+		certs, err := client.WebServerCertificate().Get(servername)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		for _, cert := range certs.List {
+			t.Logf("certificate %s: %s, expires at %s", servername, cert.Path, cert.NotAfter)
+		}
+
+		report, err := client.WebServerLinter().Lint(servername)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		for _, issue := range report.Issues {
+			t.Logf("lint %s: [%s] %s %v", servername, issue.Rule, issue.Message, issue.Positions)
+		}
+
+		route, err := client.WebServerRouteSimulator().Simulate(&v1.RouteRequest{
+			ServerName: &v1.ServerName{Name: servername},
+			Host:       "localhost",
+			URI:        "/",
+		})
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		t.Logf("route %s: handled by %s %s, trace: %v", servername, route.Server, route.Location, route.Trace)
+
+		logs, err := client.WebServerLogWatcher().ListLogs(servername)
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		for _, log := range logs {
+			t.Logf("log %s: %s, %d bytes, writers: %d", servername, log.Name, log.Size, len(log.Writers))
+		}
+
+		queryC, qCancel, err := client.WebServerLogWatcher().QueryLogs(&v1.WebServerLogQueryRequest{
+			ServerName:         &v1.ServerName{Name: servername},
+			LogName:            "access.log",
+			Since:              time.Now().Add(-time.Hour),
+			Limit:              10,
+			Reverse:            true,
+			FilteringFieldRule: "status>=200",
+			Structured:         true,
+		})
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		for chunk := range queryC {
+			fmt.Print(string(chunk))
+		}
+		qCancel()
+
+		eventC, eCancel, err := client.WebServerLogWatcher().Watch(&v1.WebServerLogWatchRequest{
+			ServerName:     &v1.ServerName{Name: servername},
+			LogName:        "error.log",
+			MinSeverity:    "error",
+			GroupWindow:    time.Second * 5,
+			UpstreamEvents: true,
+		})
+		if err != nil {
+			t.Logf("watch upstream events %s: %v", servername, err)
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer eCancel()
+				timeout := time.After(time.Second * 10)
+				for {
+					select {
+					case event, ok := <-eventC:
+						if !ok || event == nil {
+							return
+						}
+						t.Logf("upstream event %s: %s", servername, event)
+					case <-timeout:
+						return
+					}
+				}
+			}()
+		}
+
+		// the traffic stats are sent only if they are enabled by `web-server-traffic.enabled`
+		statsC, tsCancel, err := client.WebServerStatus().TrafficStats(servername, time.Second)
+		if err != nil {
+			t.Logf("traffic stats %s: %v", servername, err)
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer tsCancel()
+				timeout := time.After(time.Second * 10)
+				for {
+					select {
+					case <-timeout:
+						return
+					case stats := <-statsC:
+						if stats == nil {
+							return
+						}
+						t.Logf("traffic %s %s %s: %.2f req/s, status %v", stats.ServerName, stats.Scope, stats.Name, stats.RequestRate, stats.StatusClasses)
+					}
+				}
+			}()
+		}
+
+		// the log alerts are sent only if the rules are defined in `web-server-log-alert.rules`
+		alertC, laCancel, err := client.WebServerLogWatcher().Alerts(servername, "")
+		if err != nil {
+			t.Logf("log alerts %s: %v", servername, err)
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer laCancel()
+				timeout := time.After(time.Second * 10)
+				for {
+					select {
+					case <-timeout:
+						return
+					case alert := <-alertC:
+						if alert == nil {
+							return
+						}
+						t.Logf("alert %s on %s: %d lines of %s in %ds", alert.Rule, alert.ServerName, alert.Count, alert.LogName, alert.WindowSeconds)
+					}
+				}
+			}()
+		}
+
+		// download the archive of the access log and the current config, which is saved only if the checksum is verified
+		saved, err := client.WebServerLogWatcher().SaveLog(&v1.WebServerLogDownloadRequest{
+			ServerName: &v1.ServerName{Name: servername},
+			LogNames:   []string{"access.log"},
+			Archive:    true,
+		}, os.TempDir())
+		if err != nil {
+			t.Logf("download logs %s: %v", servername, err)
+		} else {
+			t.Logf("downloaded logs %s: %s, %d bytes, %s", servername, saved.Path, saved.Size, saved.Checksum)
+			os.Remove(saved.Path)
+		}
+
 		logC, lwCancel, err := client.WebServerLogWatcher().Watch(&v1.WebServerLogWatchRequest{
 			ServerName:          &v1.ServerName{Name: servername},
 			LogName:             "access.log",